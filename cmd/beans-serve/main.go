@@ -11,8 +11,9 @@ func main() {
 	root.Use = "beans-serve"
 	commands.RegisterServeCmd(root)
 
-	// Default to "serve" when no subcommand is given
-	if len(os.Args) < 2 || os.Args[1][0] == '-' {
+	// Default to "serve" when no subcommand is given, and let
+	// "beans-serve token ..." stand for "beans-serve serve token ..."
+	if len(os.Args) < 2 || os.Args[1][0] == '-' || os.Args[1] == "token" {
 		os.Args = append([]string{os.Args[0], "serve"}, os.Args[1:]...)
 	}
 
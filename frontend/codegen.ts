@@ -22,8 +22,10 @@ const config: CodegenConfig = {
       ],
       config: {
         // Use 'string' for the Time scalar (ISO 8601 strings from the backend)
+        // and the Date scalar (YYYY-MM-DD)
         scalars: {
           Time: 'string',
+          Date: 'string',
         },
         // Avoid __typename pollution since urql doesn't require it
         skipTypename: true,
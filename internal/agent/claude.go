@@ -163,6 +163,10 @@ func (m *Manager) spawnAndRun(beanID string, session *Session) {
 
 	log.Printf("[agent:%s] spawned claude process (pid=%d, dir=%s)", beanID, cmd.Process.Pid, session.WorkDir)
 
+	if session.SessionID == "" && m.onSessionStart != nil {
+		go m.onSessionStart(beanID)
+	}
+
 	// Send the initial user message, prepending bean context on first spawn
 	// and any file attachment context from @-mentions
 	lastMsg := session.Messages[len(session.Messages)-1]
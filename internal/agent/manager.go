@@ -20,6 +20,11 @@ type SystemPromptProvider func(beanID string) string
 // the user's message text.
 type OnFirstUserMessageFunc func(beanID string, message string)
 
+// OnSessionStartFunc is called when an agent process is spawned for a new
+// conversation (not when resuming one). Receives the beanID (which is the
+// worktree ID for workspace agents).
+type OnSessionStartFunc func(beanID string)
+
 // OnTurnCompleteFunc is called when an agent finishes a turn (receives a result event).
 // Receives the beanID (which is the worktree ID for workspace agents).
 type OnTurnCompleteFunc func(beanID string)
@@ -56,6 +61,7 @@ type Manager struct {
 	contextProvider       ContextProvider
 	systemPromptProvider  SystemPromptProvider
 	onFirstUserMessage    OnFirstUserMessageFunc
+	onSessionStart        OnSessionStartFunc
 	onTurnComplete        OnTurnCompleteFunc
 	quickReplyContext     QuickReplyContextFunc
 	defaultMode   DefaultMode
@@ -109,6 +115,12 @@ func (m *Manager) SetOnFirstUserMessage(fn OnFirstUserMessageFunc) {
 	m.onFirstUserMessage = fn
 }
 
+// SetOnSessionStart registers a callback that fires when an agent process is
+// spawned for a new conversation. Must be called during initialization.
+func (m *Manager) SetOnSessionStart(fn OnSessionStartFunc) {
+	m.onSessionStart = fn
+}
+
 // SetOnTurnComplete registers a callback that fires when an agent finishes a turn.
 // Used to update workspace activity timestamps for sidebar sorting.
 func (m *Manager) SetOnTurnComplete(fn OnTurnCompleteFunc) {
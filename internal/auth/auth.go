@@ -0,0 +1,114 @@
+// Package auth implements token authentication for beans serve.
+//
+// Tokens are random secrets with a scope (read, write, or admin). They are
+// stored as SHA-256 hashes in a user-level file, so one token works for every
+// project served by the same user. When no tokens exist, authentication is
+// disabled and every request has full access.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Scope is what a token may do.
+type Scope string
+
+const (
+	// ScopeRead allows queries and subscriptions.
+	ScopeRead Scope = "read"
+	// ScopeWrite additionally allows mutations that change beans.
+	ScopeWrite Scope = "write"
+	// ScopeAdmin additionally allows terminals, run scripts, worktrees, and
+	// agent control, which run commands on the host.
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes lists the scopes from least to most access.
+var Scopes = []Scope{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// ParseScope parses a scope name.
+func ParseScope(s string) (Scope, error) {
+	scope := Scope(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(Scopes, scope) {
+		return "", fmt.Errorf("invalid scope %q (must be read, write, or admin)", s)
+	}
+	return scope, nil
+}
+
+// Allows reports whether a token with this scope may do what requires the
+// given scope.
+func (s Scope) Allows(required Scope) bool {
+	return slices.Index(Scopes, s) >= slices.Index(Scopes, required)
+}
+
+// adminMutations are the GraphQL mutations that run commands on the host or
+// control agents, and so require admin scope. All other mutations require
+// write scope.
+var adminMutations = []string{
+	"writeTerminalInput",
+	"startRun",
+	"stopRun",
+	"createWorktree",
+	"removeWorktree",
+	"sendAgentMessage",
+	"stopAgent",
+	"setAgentPlanMode",
+	"setAgentActMode",
+	"setAgentEffort",
+	"setAgentPendingInteraction",
+	"clearAgentSession",
+	"executeAgentAction",
+	"discardFileChange",
+	"openInEditor",
+}
+
+// RequiredScope returns the scope needed for a GraphQL operation ("query",
+// "mutation", or "subscription") selecting the given top-level fields.
+func RequiredScope(operation string, fields []string) Scope {
+	if operation != "mutation" {
+		return ScopeRead
+	}
+	for _, f := range fields {
+		if slices.Contains(adminMutations, f) {
+			return ScopeAdmin
+		}
+	}
+	return ScopeWrite
+}
+
+type scopeKey struct{}
+
+// WithScope returns a context carrying the scope of the request's token.
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFrom returns the scope stored by WithScope, if any.
+func ScopeFrom(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok
+}
+
+// CookieName is the cookie that carries the token for browsers, set when the
+// token is passed as a query parameter (e.g. when opening the web UI).
+const CookieName = "beans_token"
+
+// TokenFromRequest returns the token sent with a request, from the
+// Authorization: Bearer header, the token query parameter, or the token
+// cookie, in that order. fromQuery is true if it came from the query.
+func TokenFromRequest(r *http.Request) (token string, fromQuery bool) {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return strings.TrimSpace(token), false
+	}
+	if token := r.URL.Query().Get("token"); token != "" {
+		return token, true
+	}
+	if cookie, err := r.Cookie(CookieName); err == nil {
+		return cookie.Value, false
+	}
+	return "", false
+}
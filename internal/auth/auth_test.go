@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestScopeAllows(t *testing.T) {
+	tests := []struct {
+		scope, required Scope
+		want            bool
+	}{
+		{ScopeRead, ScopeRead, true},
+		{ScopeRead, ScopeWrite, false},
+		{ScopeWrite, ScopeRead, true},
+		{ScopeWrite, ScopeAdmin, false},
+		{ScopeAdmin, ScopeAdmin, true},
+		{Scope("bogus"), ScopeRead, false},
+	}
+	for _, tt := range tests {
+		if got := tt.scope.Allows(tt.required); got != tt.want {
+			t.Errorf("%s.Allows(%s) = %v, want %v", tt.scope, tt.required, got, tt.want)
+		}
+	}
+
+	if _, err := ParseScope("superuser"); err == nil {
+		t.Error("ParseScope(superuser) expected error")
+	}
+	if s, err := ParseScope(" Write "); err != nil || s != ScopeWrite {
+		t.Errorf("ParseScope(Write) = %q, %v", s, err)
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	tests := []struct {
+		operation string
+		fields    []string
+		want      Scope
+	}{
+		{"query", []string{"beans"}, ScopeRead},
+		{"subscription", []string{"beanChanged"}, ScopeRead},
+		{"mutation", []string{"updateBean", "addComment"}, ScopeWrite},
+		{"mutation", []string{"updateBean", "writeTerminalInput"}, ScopeAdmin},
+		{"mutation", []string{"startRun"}, ScopeAdmin},
+	}
+	for _, tt := range tests {
+		if got := RequiredScope(tt.operation, tt.fields); got != tt.want {
+			t.Errorf("RequiredScope(%s, %v) = %s, want %s", tt.operation, tt.fields, got, tt.want)
+		}
+	}
+}
+
+func TestTokenFromRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/graphql?token=from-query", nil)
+	r.Header.Set("Authorization", "Bearer from-header")
+	r.Header.Set("Cookie", CookieName+"=from-cookie")
+	if token, fromQuery := TokenFromRequest(r); token != "from-header" || fromQuery {
+		t.Errorf("TokenFromRequest() = %q, %v; want header token", token, fromQuery)
+	}
+
+	r.Header.Del("Authorization")
+	if token, fromQuery := TokenFromRequest(r); token != "from-query" || !fromQuery {
+		t.Errorf("TokenFromRequest() = %q, %v; want query token", token, fromQuery)
+	}
+
+	r = httptest.NewRequest("GET", "/api/graphql", nil)
+	r.Header.Set("Cookie", CookieName+"=from-cookie")
+	if token, _ := TokenFromRequest(r); token != "from-cookie" {
+		t.Errorf("TokenFromRequest() = %q, want cookie token", token)
+	}
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TokenPrefix starts every token, so that leaked tokens are easy to recognize.
+const TokenPrefix = "beans_"
+
+// ErrNotFound is returned when revoking a token that doesn't exist.
+var ErrNotFound = errors.New("token not found")
+
+// Token is a stored token. The secret itself is only shown once, when the
+// token is created.
+type Token struct {
+	// ID identifies the token for listing and revoking (the start of its hash).
+	ID        string    `yaml:"id"`
+	Name      string    `yaml:"name,omitempty"`
+	Scope     Scope     `yaml:"scope"`
+	Hash      string    `yaml:"hash"`
+	CreatedAt time.Time `yaml:"created_at"`
+}
+
+// tokenFile is the on-disk format of the token store.
+type tokenFile struct {
+	Tokens []Token `yaml:"tokens"`
+}
+
+// DefaultPath returns the path of the token file: $BEANS_TOKENS_FILE if set,
+// otherwise ~/.beans/tokens.yml.
+func DefaultPath() (string, error) {
+	if path := os.Getenv("BEANS_TOKENS_FILE"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".beans", "tokens.yml"), nil
+}
+
+// Store reads and writes the token file. It rereads the file when it changes,
+// so tokens created or revoked while the server runs take effect immediately.
+type Store struct {
+	path string
+
+	mu      sync.Mutex
+	tokens  []Token
+	modTime time.Time
+	size    int64
+}
+
+// NewStore returns a store for the token file at path. The file doesn't need
+// to exist yet.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the path of the token file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// reloadLocked rereads the token file if it changed since it was last read.
+// Must be called with s.mu held.
+func (s *Store) reloadLocked() error {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.tokens, s.modTime, s.size = nil, time.Time{}, 0
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size && s.tokens != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var f tokenFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("parse %s: %w", s.path, err)
+	}
+	s.tokens = f.Tokens
+	if s.tokens == nil {
+		s.tokens = []Token{}
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+	return nil
+}
+
+// saveLocked writes the tokens to the token file, readable only by the user.
+// Must be called with s.mu held.
+func (s *Store) saveLocked(tokens []Token) error {
+	data, err := yaml.Marshal(tokenFile{Tokens: tokens})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+	// Force a reread so the cached state matches the file
+	s.tokens = nil
+	return s.reloadLocked()
+}
+
+// List returns the stored tokens, oldest first.
+func (s *Store) List() ([]Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return nil, err
+	}
+	return append([]Token(nil), s.tokens...), nil
+}
+
+// Enabled reports whether authentication is required, i.e. whether any
+// tokens exist. An unreadable token file counts as enabled, so that a broken
+// file never opens the server up.
+func (s *Store) Enabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return true
+	}
+	return len(s.tokens) > 0
+}
+
+// Create generates and stores a new token, returning its secret.
+func (s *Store) Create(name string, scope Scope) (string, Token, error) {
+	if _, err := ParseScope(string(scope)); err != nil {
+		return "", Token{}, err
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", Token{}, err
+	}
+	secret := TokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	hash := hashToken(secret)
+	token := Token{
+		ID:        hash[:8],
+		Name:      name,
+		Scope:     scope,
+		Hash:      hash,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return "", Token{}, err
+	}
+	if err := s.saveLocked(append(append([]Token(nil), s.tokens...), token)); err != nil {
+		return "", Token{}, err
+	}
+	return secret, token, nil
+}
+
+// Revoke deletes the token with the given ID.
+func (s *Store) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return err
+	}
+	for i, t := range s.tokens {
+		if t.ID == id {
+			tokens := append(append([]Token(nil), s.tokens[:i]...), s.tokens[i+1:]...)
+			return s.saveLocked(tokens)
+		}
+	}
+	return ErrNotFound
+}
+
+// Verify returns the stored token matching a secret.
+func (s *Store) Verify(secret string) (Token, bool) {
+	if secret == "" {
+		return Token{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reloadLocked(); err != nil {
+		return Token{}, false
+	}
+	hash := hashToken(secret)
+	for _, t := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hash)) == 1 {
+			return t, true
+		}
+	}
+	return Token{}, false
+}
+
+// hashToken returns the hex-encoded SHA-256 hash of a token secret.
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
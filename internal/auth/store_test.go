@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beans", "tokens.yml")
+	store := NewStore(path)
+
+	if store.Enabled() {
+		t.Error("Enabled() = true without a token file")
+	}
+
+	secret, token, err := store.Create("ci", ScopeWrite)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(secret, TokenPrefix) || token.Scope != ScopeWrite || token.Name != "ci" {
+		t.Errorf("Create() = %q, %+v", secret, token)
+	}
+	if !store.Enabled() {
+		t.Error("Enabled() = false after creating a token")
+	}
+
+	t.Run("stored hashed and private", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), secret) {
+			t.Error("token file contains the secret")
+		}
+		info, _ := os.Stat(path)
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("token file mode = %o, want 600", perm)
+		}
+	})
+
+	t.Run("verify", func(t *testing.T) {
+		// A second store sees tokens created by the first, as the server
+		// sees tokens created by the CLI
+		other := NewStore(path)
+		got, ok := other.Verify(secret)
+		if !ok || got.ID != token.ID {
+			t.Errorf("Verify() = %+v, %v; want %s", got, ok, token.ID)
+		}
+		if _, ok := other.Verify(secret + "x"); ok {
+			t.Error("Verify() accepted a wrong token")
+		}
+		if _, ok := other.Verify(""); ok {
+			t.Error("Verify() accepted an empty token")
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		if err := store.Revoke("nope"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Revoke(nope) error = %v, want ErrNotFound", err)
+		}
+		if err := store.Revoke(token.ID); err != nil {
+			t.Fatalf("Revoke() error = %v", err)
+		}
+		if _, ok := NewStore(path).Verify(secret); ok {
+			t.Error("Verify() accepted a revoked token")
+		}
+		tokens, err := store.List()
+		if err != nil || len(tokens) != 0 {
+			t.Errorf("List() = %v, %v; want none", tokens, err)
+		}
+	})
+
+	t.Run("broken file stays locked", func(t *testing.T) {
+		if err := os.WriteFile(path, []byte("tokens: [oops"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if !NewStore(path).Enabled() {
+			t.Error("Enabled() = false for an unreadable token file")
+		}
+	})
+}
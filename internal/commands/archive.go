@@ -13,7 +13,7 @@ var archiveJSON bool
 var archiveCmd = &cobra.Command{
 	Use:   "archive",
 	Short: "Move completed/scrapped beans to the archive",
-	Long: `Moves all beans with status "completed" or "scrapped" to the archive directory (.beans/archive/).
+	Long: `Moves all beans with an archive status (by default "completed" or "scrapped") to the archive directory (.beans/archive/).
 Archived beans are preserved for project memory and remain visible in all queries.
 The archive keeps the main .beans directory tidy while preserving project history.
 
@@ -6,7 +6,9 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/internal/ui"
 )
@@ -19,7 +21,9 @@ var (
 type checkResult struct {
 	Success      bool                      `json:"success"`
 	ConfigErrors []string                  `json:"config_errors"`
+	LoadErrors   []beancore.LoadError      `json:"load_errors"`
 	BeanIssues   *beancore.LinkCheckResult `json:"bean_issues,omitempty"`
+	FieldIssues  []beancore.FieldIssue     `json:"field_issues"`
 	Fixed        int                       `json:"fixed,omitempty"`
 }
 
@@ -27,12 +31,15 @@ var checkCmd = &cobra.Command{
 	Use:   "check",
 	Short: "Validate configuration and bean integrity",
 	Long: `Checks configuration and bean integrity, including:
-- Configuration settings (colors, default type)
+- Configuration settings (statuses, types, priorities, parent rules, workflow, colors, defaults)
+- Bean files that cannot be parsed (e.g. broken front matter after a bad merge)
 - Broken links (links to non-existent beans)
 - Self-references (beans linking to themselves)
 - Circular dependencies (cycles in blocks/parent relationships)
+- Custom field declarations and values (type and allowed values)
 
-Use --fix to automatically remove broken links and self-references.
+Use --fix to automatically remove broken links and self-references, and to move
+unparseable bean files into .beans/.quarantine/ for manual repair.
 Note: Cycles cannot be auto-fixed and require manual intervention.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var configErrors []string
@@ -43,23 +50,27 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			fmt.Println(ui.Bold.Render("Configuration"))
 		}
 
-		// 1. Check statuses are defined (always true since hardcoded)
+		// 1. Check statuses, types and priorities (names, parent rules, defaults)
+		definitionErrors := cfg.ValidateDefinitions()
+		configErrors = append(configErrors, definitionErrors...)
+		workflowErrors := cfg.ValidateWorkflow()
+		configErrors = append(configErrors, workflowErrors...)
 		if !checkJSON {
-			fmt.Printf("  %s Statuses defined (%d hardcoded)\n", ui.Success.Render("✓"), len(config.DefaultStatuses))
+			fmt.Printf("  %s Statuses defined (%d)\n", ui.Success.Render("✓"), len(cfg.GetStatuses()))
+			fmt.Printf("  %s Types defined (%d)\n", ui.Success.Render("✓"), len(cfg.GetTypes()))
+			fmt.Printf("  %s Priorities defined (%d)\n", ui.Success.Render("✓"), len(cfg.GetPriorities()))
 		}
 
-		// 2. Check default_status exists in statuses (always true since hardcoded)
-		if !checkJSON {
-			fmt.Printf("  %s Default status '%s' exists\n", ui.Success.Render("✓"), cfg.GetDefaultStatus())
+		if !checkJSON && !cfg.Workflow.IsEmpty() && len(workflowErrors) == 0 {
+			fmt.Printf("  %s Workflow valid\n", ui.Success.Render("✓"))
 		}
 
-		// 2b. Check default_type is a valid hardcoded type
-		if cfg.GetDefaultType() != "" && !cfg.IsValidType(cfg.GetDefaultType()) {
-			configErrors = append(configErrors, fmt.Sprintf("default_type '%s' is not a valid type", cfg.GetDefaultType()))
-		} else if cfg.GetDefaultType() != "" {
-			if !checkJSON {
-				fmt.Printf("  %s Default type '%s' is valid\n", ui.Success.Render("✓"), cfg.GetDefaultType())
-			}
+		// 2. Check default_status and default_type exist
+		if !checkJSON && cfg.IsValidStatus(cfg.GetDefaultStatus()) {
+			fmt.Printf("  %s Default status '%s' exists\n", ui.Success.Render("✓"), cfg.GetDefaultStatus())
+		}
+		if !checkJSON && cfg.GetDefaultType() != "" && cfg.IsValidType(cfg.GetDefaultType()) {
+			fmt.Printf("  %s Default type '%s' is valid\n", ui.Success.Render("✓"), cfg.GetDefaultType())
 		}
 
 		// 2c. Check agent.default_effort is a valid effort level
@@ -71,40 +82,65 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
-		// 3. Check all status colors are valid (hardcoded statuses)
-		for _, s := range config.DefaultStatuses {
-			if !ui.IsValidColor(s.Color) {
-				configErrors = append(configErrors, fmt.Sprintf("invalid color '%s' for status '%s'", s.Color, s.Name))
+		// 3. Check all status and type colors are valid
+		colorErrors := 0
+		checkColor := func(kind, name, color string) {
+			if !ui.IsValidColor(color) {
+				configErrors = append(configErrors, fmt.Sprintf("invalid color '%s' for %s '%s'", color, kind, name))
+				colorErrors++
 			}
 		}
-		if !checkJSON {
-			colorErrors := 0
-			for _, e := range configErrors {
-				if len(e) > 13 && e[:13] == "invalid color" {
-					colorErrors++
+		for _, s := range cfg.GetStatuses() {
+			checkColor("status", s.Name, s.Color)
+		}
+		for _, t := range cfg.GetTypes() {
+			checkColor("type", t.Name, t.Color)
+		}
+		if !checkJSON && colorErrors == 0 {
+			fmt.Printf("  %s All status and type colors valid\n", ui.Success.Render("✓"))
+		}
+
+		// 5. Check custom field declarations
+		fieldConfigErrors := cfg.ValidateFields()
+		configErrors = append(configErrors, fieldConfigErrors...)
+		if !checkJSON && len(cfg.Fields) > 0 && len(fieldConfigErrors) == 0 {
+			fmt.Printf("  %s Custom fields valid (%d declared)\n", ui.Success.Render("✓"), len(cfg.Fields))
+		}
+
+		// 6. Check view declarations. Resolving each view catches bad sorts and
+		// dates; a placeholder user stands in for "me", which depends on the environment.
+		viewConfigErrors := cfg.ValidateViews()
+		if len(viewConfigErrors) == 0 {
+			for i := range cfg.Views {
+				if _, err := beangraph.ViewFilter(cfg, &cfg.Views[i], "me", bean.Today()); err != nil {
+					viewConfigErrors = append(viewConfigErrors, err.Error())
 				}
 			}
-			if colorErrors == 0 {
-				fmt.Printf("  %s All status colors valid\n", ui.Success.Render("✓"))
-			}
+		}
+		configErrors = append(configErrors, viewConfigErrors...)
+		if !checkJSON && len(cfg.Views) > 0 && len(viewConfigErrors) == 0 {
+			fmt.Printf("  %s Views valid (%d declared)\n", ui.Success.Render("✓"), len(cfg.Views))
 		}
 
-		// 4. Check all type colors are valid (hardcoded types)
-		for _, t := range config.DefaultTypes {
-			if !ui.IsValidColor(t.Color) {
-				configErrors = append(configErrors, fmt.Sprintf("invalid color '%s' for type '%s'", t.Color, t.Name))
-			}
+		// 7. Check link type declarations
+		linkTypeConfigErrors := cfg.ValidateLinkTypes()
+		configErrors = append(configErrors, linkTypeConfigErrors...)
+		if !checkJSON && len(cfg.LinkTypes) > 0 && len(linkTypeConfigErrors) == 0 {
+			fmt.Printf("  %s Link types valid (%d declared)\n", ui.Success.Render("✓"), len(cfg.LinkTypes))
 		}
-		if !checkJSON {
-			typeColorErrors := 0
-			for _, e := range configErrors {
-				if len(e) > 13 && e[:13] == "invalid color" {
-					typeColorErrors++
+
+		// 8. Check webhook declarations, resolving each filter like views above
+		webhookConfigErrors := cfg.ValidateWebhooks()
+		if len(webhookConfigErrors) == 0 {
+			for i := range cfg.Webhooks {
+				if _, err := beangraph.ConfigFilter(cfg, &cfg.Webhooks[i].Filter, "me", bean.Today()); err != nil {
+					webhookConfigErrors = append(webhookConfigErrors, fmt.Sprintf("webhook %s: %s", cfg.Webhooks[i].Name, err))
 				}
 			}
-			if typeColorErrors == 0 {
-				fmt.Printf("  %s All type colors valid\n", ui.Success.Render("✓"))
-			}
+		}
+		configErrors = append(configErrors, webhookConfigErrors...)
+		if !checkJSON && len(cfg.Webhooks) > 0 && len(webhookConfigErrors) == 0 {
+			fmt.Printf("  %s Webhooks valid (%d declared)\n", ui.Success.Render("✓"), len(cfg.Webhooks))
 		}
 
 		// Print config errors in human-readable mode
@@ -114,6 +150,37 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
+		// === Bean file checks ===
+		if !checkJSON {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Bean Files"))
+		}
+
+		loadErrors := core.LoadErrors()
+		if checkFix {
+			remaining := []beancore.LoadError{}
+			for _, le := range loadErrors {
+				dest, err := core.Quarantine(le.Path)
+				if err != nil {
+					remaining = append(remaining, beancore.LoadError{Path: le.Path, Error: err.Error()})
+					continue
+				}
+				fixed++
+				if !checkJSON {
+					fmt.Printf("  %s %s: moved unparseable file to %s\n", ui.Success.Render("✓"), le.Path, dest)
+				}
+			}
+			loadErrors = remaining
+		}
+		if !checkJSON {
+			for _, le := range loadErrors {
+				fmt.Printf("  %s %s: %s\n", ui.Danger.Render("✗"), le.Path, le.Error)
+			}
+			if len(loadErrors) == 0 && fixed == 0 {
+				fmt.Printf("  %s All bean files parsed (%d)\n", ui.Success.Render("✓"), len(core.All()))
+			}
+		}
+
 		// === Bean link checks ===
 		if !checkJSON {
 			fmt.Println()
@@ -121,6 +188,7 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 		}
 
 		linkResult := core.CheckAllLinks()
+		linksFixed := 0
 
 		// Handle --fix mode
 		if checkFix && (len(linkResult.BrokenLinks) > 0 || len(linkResult.SelfLinks) > 0) {
@@ -128,7 +196,8 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			if err != nil {
 				return fmt.Errorf("fixing broken links: %w", err)
 			}
-			fixed = fixedCount
+			linksFixed = fixedCount
+			fixed += fixedCount
 
 			if !checkJSON {
 				for _, bl := range linkResult.BrokenLinks {
@@ -164,18 +233,33 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 		}
 
 		// Show success if no issues
-		if !checkJSON && !linkResult.HasIssues() && fixed == 0 {
+		if !checkJSON && !linkResult.HasIssues() && linksFixed == 0 {
 			fmt.Printf("  %s No link issues found\n", ui.Success.Render("✓"))
 		}
 
+		// === Custom field checks ===
+		fieldIssues := core.CheckFields()
+		if !checkJSON && len(cfg.Fields) > 0 {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Custom Fields"))
+			for _, fi := range fieldIssues {
+				fmt.Printf("  %s %s: %s\n", ui.Danger.Render("✗"), fi.BeanID, fi.Error)
+			}
+			if len(fieldIssues) == 0 {
+				fmt.Printf("  %s No field issues found\n", ui.Success.Render("✓"))
+			}
+		}
+
 		// === Summary ===
-		totalIssues := len(configErrors) + linkResult.TotalIssues()
+		totalIssues := len(configErrors) + len(loadErrors) + linkResult.TotalIssues() + len(fieldIssues)
 
 		if checkJSON {
 			result := checkResult{
 				Success:      totalIssues == 0,
 				ConfigErrors: configErrors,
+				LoadErrors:   loadErrors,
 				BeanIssues:   linkResult,
+				FieldIssues:  fieldIssues,
 				Fixed:        fixed,
 			}
 			data, _ := json.MarshalIndent(result, "", "  ")
@@ -207,6 +291,6 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 
 func RegisterCheckCmd(root *cobra.Command) {
 	checkCmd.Flags().BoolVar(&checkJSON, "json", false, "Output as JSON")
-	checkCmd.Flags().BoolVar(&checkFix, "fix", false, "Automatically fix broken links and self-references")
+	checkCmd.Flags().BoolVar(&checkFix, "fix", false, "Automatically fix broken links and self-references, and quarantine unparseable bean files")
 	root.AddCommand(checkCmd)
 }
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commentFile    string
+	commentAuthor  string
+	commentIfMatch string
+	commentJSON    bool
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment <id> [text]",
+	Short: "Add a comment to a bean's discussion thread",
+	Long: `Adds a comment to a bean's discussion thread, keeping conversation separate from the body.
+
+The comment text is markdown. Use '-' to read it from stdin, or --file to read it from a file.
+The author defaults to the BEANS_USER environment variable, or git's user.email.
+
+Comments are shown by 'beans show' and included in full-text search.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &beangraph.CoreResolver{Core: core}
+
+		var text string
+		if len(args) > 1 {
+			text = args[1]
+		}
+		if text != "" && commentFile != "" {
+			return cmdError(commentJSON, output.ErrValidation, "cannot use both comment text and --file")
+		}
+		body, err := resolveContent(text, commentFile)
+		if err != nil {
+			return cmdError(commentJSON, output.ErrFileError, "%s", err)
+		}
+		if body == "" {
+			return cmdError(commentJSON, output.ErrValidation, "comment text is required (pass it as an argument, '-' for stdin, or --file)")
+		}
+
+		b, err := resolver.Bean(ctx, args[0])
+		if err != nil || b == nil {
+			return cmdError(commentJSON, output.ErrNotFound, "bean not found: %s", args[0])
+		}
+
+		var author, ifMatch *string
+		if commentAuthor != "" {
+			author = &commentAuthor
+		}
+		if commentIfMatch != "" {
+			ifMatch = &commentIfMatch
+		}
+
+		b, err = resolver.AddComment(ctx, b.ID, body, author, ifMatch)
+		if err != nil {
+			return mutationError(commentJSON, err)
+		}
+
+		if commentJSON {
+			return output.Success(b, "Comment added")
+		}
+
+		fmt.Println(ui.Success.Render("Commented on ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
+		return nil
+	},
+}
+
+func RegisterCommentCmd(root *cobra.Command) {
+	commentCmd.Flags().StringVar(&commentFile, "file", "", "Read comment text from file")
+	commentCmd.Flags().StringVar(&commentAuthor, "author", "", "Comment author (default: $BEANS_USER or git user.email)")
+	commentCmd.Flags().StringVar(&commentIfMatch, "if-match", "", "Only comment if etag matches (optimistic locking)")
+	commentCmd.Flags().BoolVar(&commentJSON, "json", false, "Output as JSON")
+	root.AddCommand(commentCmd)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/internal/output"
 )
 
@@ -105,3 +106,45 @@ func resolveAppendContent(value string) (string, error) {
 	}
 	return bean.UnescapeBody(value), nil
 }
+
+// withCurrentUser appends the current user (see Core.CurrentUser) to the given
+// assignees if me is true. Returns an error if no identity can be determined.
+func withCurrentUser(assignees []string, me bool) ([]string, error) {
+	if !me {
+		return assignees, nil
+	}
+	user := core.CurrentUser()
+	if user == "" {
+		return nil, fmt.Errorf("cannot determine current user for --me (set BEANS_USER or git user.email)")
+	}
+	return append(assignees, user), nil
+}
+
+// parseFieldAssignments parses --field flag values of the form key=value.
+// An empty value (key=) clears the field.
+func parseFieldAssignments(values []string) ([]*model.FieldInput, error) {
+	fields := make([]*model.FieldInput, 0, len(values))
+	for _, v := range values {
+		name, value, ok := strings.Cut(v, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field %q (expected key=value)", v)
+		}
+		fields = append(fields, &model.FieldInput{Name: name, Value: value})
+	}
+	return fields, nil
+}
+
+// parseLinks parses --link flag values of the form type:id.
+func parseLinks(values []string) ([]*model.LinkInput, error) {
+	links := make([]*model.LinkInput, 0, len(values))
+	for _, v := range values {
+		linkType, target, ok := strings.Cut(v, ":")
+		linkType, target = strings.TrimSpace(linkType), strings.TrimSpace(target)
+		if !ok || linkType == "" || target == "" {
+			return nil, fmt.Errorf("invalid link %q (expected type:id, e.g. duplicates:abc1)", v)
+		}
+		links = append(links, &model.LinkInput{Type: linkType, Target: target})
+	}
+	return links, nil
+}
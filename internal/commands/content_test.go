@@ -303,3 +303,25 @@ func indexOfSubstring(s, substr string) int {
 	}
 	return -1
 }
+
+func TestParseFieldAssignments(t *testing.T) {
+	got, err := parseFieldAssignments([]string{"points=3", "area=", "note=a=b"})
+	if err != nil {
+		t.Fatalf("parseFieldAssignments() error = %v", err)
+	}
+	want := [][2]string{{"points", "3"}, {"area", ""}, {"note", "a=b"}}
+	if len(got) != len(want) {
+		t.Fatalf("parseFieldAssignments() returned %d fields, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Name != w[0] || got[i].Value != w[1] {
+			t.Errorf("field %d = %s=%s, want %s=%s", i, got[i].Name, got[i].Value, w[0], w[1])
+		}
+	}
+
+	for _, bad := range []string{"points", "=3"} {
+		if _, err := parseFieldAssignments([]string{bad}); err == nil {
+			t.Errorf("parseFieldAssignments(%q) expected error", bad)
+		}
+	}
+}
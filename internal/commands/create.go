@@ -2,9 +2,12 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/beangraph/model"
@@ -23,7 +26,16 @@ var (
 	createParent    string
 	createBlocking  []string
 	createBlockedBy []string
+	createLink      []string
+	createAssignee  []string
+	createMe        bool
+	createStart     string
+	createDue       string
+	createEstimate  float64
+	createRecur     string
 	createPrefix    string
+	createField     []string
+	createTemplate  string
 	createJSON      bool
 )
 
@@ -31,7 +43,15 @@ var createCmd = &cobra.Command{
 	Use:     "create [title]",
 	Aliases: []string{"c", "new"},
 	Short:   "Create a new bean",
-	Long:    `Creates a new bean (issue) with a generated ID and optional title.`,
+	Long: `Creates a new bean (issue) with a generated ID and optional title.
+
+New beans start from the template for their type, if there is one: a markdown
+file in .beans/templates/ named after the type (e.g. bug.md). Its front matter
+provides defaults for type, status, priority, tags, assignees, estimate,
+recurrence, and custom fields, and its body is used unless --body is given. The body can use
+the placeholders {{.Title}}, {{.Type}}, {{.Date}}, and {{.User}}.
+
+Use --template to start from another template in .beans/templates/ by name.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		title := strings.Join(args, " ")
 		if title == "" {
@@ -54,19 +74,16 @@ var createCmd = &cobra.Command{
 			return cmdError(createJSON, output.ErrFileError, "%s", err)
 		}
 
-		// Build GraphQL input
+		// Build GraphQL input (the template or config provides the default status and type)
 		input := model.CreateBeanInput{Title: title}
 		if createStatus != "" {
 			input.Status = &createStatus
-		} else {
-			defaultStatus := cfg.GetDefaultStatus()
-			input.Status = &defaultStatus
 		}
 		if createType != "" {
 			input.Type = &createType
-		} else {
-			defaultType := cfg.GetDefaultType()
-			input.Type = &defaultType
+		}
+		if createTemplate != "" {
+			input.Template = &createTemplate
 		}
 		if createPriority != "" {
 			input.Priority = &createPriority
@@ -78,6 +95,40 @@ var createCmd = &cobra.Command{
 			input.Tags = createTag
 		}
 
+		assignees, err := withCurrentUser(createAssignee, createMe)
+		if err != nil {
+			return cmdError(createJSON, output.ErrValidation, "%s", err)
+		}
+		if len(assignees) > 0 {
+			input.Assignees = assignees
+		}
+
+		// Add dates
+		if createStart != "" {
+			d, err := bean.ParseDate(createStart, bean.Today())
+			if err != nil {
+				return cmdError(createJSON, output.ErrValidation, "start: %s", err)
+			}
+			input.Start = &d
+		}
+		if createDue != "" {
+			d, err := bean.ParseDate(createDue, bean.Today())
+			if err != nil {
+				return cmdError(createJSON, output.ErrValidation, "due: %s", err)
+			}
+			input.Due = &d
+		}
+
+		// Add estimate
+		if createEstimate != 0 {
+			input.Estimate = &createEstimate
+		}
+
+		// Add recurrence (validated by the resolver)
+		if createRecur != "" {
+			input.Recur = &createRecur
+		}
+
 		// Add parent
 		if createParent != "" {
 			input.Parent = &createParent
@@ -93,6 +144,24 @@ var createCmd = &cobra.Command{
 			input.BlockedBy = createBlockedBy
 		}
 
+		// Add links
+		if len(createLink) > 0 {
+			links, err := parseLinks(createLink)
+			if err != nil {
+				return cmdError(createJSON, output.ErrValidation, "%s", err)
+			}
+			input.Links = links
+		}
+
+		// Add custom fields
+		if len(createField) > 0 {
+			fields, err := parseFieldAssignments(createField)
+			if err != nil {
+				return cmdError(createJSON, output.ErrValidation, "%s", err)
+			}
+			input.Fields = fields
+		}
+
 		// Add custom prefix
 		if createPrefix != "" {
 			input.Prefix = &createPrefix
@@ -101,6 +170,14 @@ var createCmd = &cobra.Command{
 		// Create via core resolver
 		resolver := &beangraph.CoreResolver{Core: core}
 		b, err := resolver.CreateBean(context.Background(), input)
+		if errors.Is(err, beancore.ErrTemplateNotFound) {
+			names, _ := core.Templates()
+			available := "none in .beans/templates/"
+			if len(names) > 0 {
+				available = strings.Join(names, ", ")
+			}
+			return cmdError(createJSON, output.ErrValidation, "%s (available: %s)", err, available)
+		}
 		if err != nil {
 			return cmdError(createJSON, output.ErrFileError, "failed to create bean: %v", err)
 		}
@@ -115,7 +192,7 @@ var createCmd = &cobra.Command{
 }
 
 func RegisterCreateCmd(root *cobra.Command) {
-	// Build help text with allowed values from hardcoded config
+	// Build help text with the built-in allowed values (projects may declare their own in .beans.yml)
 	statusNames := make([]string, len(config.DefaultStatuses))
 	for i, s := range config.DefaultStatuses {
 		statusNames[i] = s.Name
@@ -138,7 +215,16 @@ func RegisterCreateCmd(root *cobra.Command) {
 	createCmd.Flags().StringVar(&createParent, "parent", "", "Parent bean ID")
 	createCmd.Flags().StringArrayVar(&createBlocking, "blocking", nil, "ID of bean this blocks (can be repeated)")
 	createCmd.Flags().StringArrayVar(&createBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
+	createCmd.Flags().StringArrayVar(&createLink, "link", nil, "Link to another bean as type:id, e.g. related:abc1 (can be repeated)")
+	createCmd.Flags().StringArrayVar(&createAssignee, "assignee", nil, "Assign to user (can be repeated)")
+	createCmd.Flags().BoolVar(&createMe, "me", false, "Assign to yourself ($BEANS_USER or git user.email)")
+	createCmd.Flags().StringVar(&createStart, "start", "", "Planned start date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw)")
+	createCmd.Flags().StringVar(&createDue, "due", "", "Due date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw)")
+	createCmd.Flags().Float64Var(&createEstimate, "estimate", 0, "Estimated effort (e.g. story points or hours)")
+	createCmd.Flags().StringVar(&createRecur, "recur", "", "Repeat when done: daily, weekly, monthly, yearly, or every <n>d/w/m/y")
+	createCmd.Flags().StringArrayVar(&createField, "field", nil, "Set custom field as key=value (can be repeated)")
 	createCmd.Flags().StringVar(&createPrefix, "prefix", "", "Custom ID prefix (overrides config prefix)")
+	createCmd.Flags().StringVar(&createTemplate, "template", "", "Start from a template in .beans/templates/ (default: the template for the type, if any)")
 	createCmd.Flags().BoolVar(&createJSON, "json", false, "Output as JSON")
 	createCmd.MarkFlagsMutuallyExclusive("body", "body-file")
 	root.AddCommand(createCmd)
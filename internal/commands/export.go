@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/exporter"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormat  string
+	exportFilter  beanFilterFlags
+	exportColumns []string
+	exportSort    string
+	exportOutput  string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export beans as CSV, JSONL, iCalendar, or a markdown table",
+	Long: `Exports beans for use in other tools. Takes the same filter flags as
+'beans list' and writes to stdout, or to a file with --output.
+
+Formats (--format):
+  csv             header row and one row per bean
+  jsonl           one JSON bean per line (including the body)
+  ics             iCalendar with one VTODO per bean (due date, priority, and
+                  status mapped to DUE, PRIORITY, and STATUS)
+  markdown-table  a markdown table
+
+Columns (--columns, for csv and markdown-table):
+  ` + strings.Join(exporter.Columns, ", ") + `,
+  or the name of a custom field. Defaults to ` + strings.Join(exporter.DefaultColumns, ", ") + `.
+
+Examples:
+  beans export --format csv --columns id,title,status,assignees > beans.csv
+  beans export --format ics --no-status completed --no-status scrapped -o beans.ics
+  beans export --format markdown-table --type bug --status todo`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(exporter.Formats, exportFormat) {
+			return cmdError(false, output.ErrValidation, "invalid --format: %q (must be %s)", exportFormat, strings.Join(exporter.Formats, ", "))
+		}
+		if _, err := bean.ParseSort(exportSort); err != nil {
+			return cmdError(false, output.ErrValidation, "%s", err)
+		}
+
+		filter := &model.BeanFilter{}
+		if err := exportFilter.apply(filter); err != nil {
+			return cmdError(false, output.ErrValidation, "%s", err)
+		}
+
+		resolver := &beangraph.CoreResolver{Core: core}
+		beans, err := resolver.Beans(context.Background(), filter)
+		if err != nil {
+			return fmt.Errorf("querying beans: %w", err)
+		}
+		sortBeans(beans, exportSort, cfg)
+
+		// Render before writing, so errors don't leave a partial file behind
+		var buf bytes.Buffer
+		if err := exporter.Write(&buf, exportFormat, beans, exporter.Options{Columns: exportColumns, Config: cfg}); err != nil {
+			return cmdError(false, output.ErrValidation, "%s", err)
+		}
+
+		if exportOutput == "" || exportOutput == "-" {
+			_, err := os.Stdout.Write(buf.Bytes())
+			return err
+		}
+		if err := os.WriteFile(exportOutput, buf.Bytes(), 0644); err != nil {
+			return cmdError(false, output.ErrFileError, "failed to write output file: %s", err)
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d beans to %s\n", len(beans), exportOutput)
+		return nil
+	},
+}
+
+func RegisterExportCmd(root *cobra.Command) {
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", "", "Export format: "+strings.Join(exporter.Formats, ", "))
+	exportCmd.Flags().StringSliceVar(&exportColumns, "columns", nil, "Columns to export, comma-separated (csv and markdown-table)")
+	exportCmd.Flags().StringVar(&exportSort, "sort", "", sortFlagUsage)
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to file instead of stdout")
+	exportFilter.register(exportCmd.Flags())
+	_ = exportCmd.MarkFlagRequired("format")
+	root.AddCommand(exportCmd)
+}
@@ -0,0 +1,296 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/spf13/pflag"
+)
+
+// beanFilterFlags holds the bean filter flags shared by `beans list` and
+// `beans update --where`.
+type beanFilterFlags struct {
+	search      string
+	status      []string
+	noStatus    []string
+	typ         []string
+	noType      []string
+	priority    []string
+	noPriority  []string
+	tag         []string
+	noTag       []string
+	field       []string
+	assignee    []string
+	me          bool
+	unassigned  bool
+	dueBefore   string
+	dueAfter    string
+	overdue     bool
+	hasParent   bool
+	noParent    bool
+	parentID    string
+	hasBlocking bool
+	noBlocking  bool
+	hasLink     []string
+	noLink      []string
+	isBlocked   bool
+	ready       bool
+}
+
+// register adds the filter flags to the given flag set.
+func (f *beanFilterFlags) register(fs *pflag.FlagSet) {
+	fs.StringVarP(&f.search, "search", "S", "", "Full-text search in title, body, and comments")
+	fs.StringArrayVarP(&f.status, "status", "s", nil, "Filter by status (can be repeated)")
+	fs.StringArrayVar(&f.noStatus, "no-status", nil, "Exclude by status (can be repeated)")
+	fs.StringArrayVarP(&f.typ, "type", "t", nil, "Filter by type (can be repeated)")
+	fs.StringArrayVar(&f.noType, "no-type", nil, "Exclude by type (can be repeated)")
+	fs.StringArrayVarP(&f.priority, "priority", "p", nil, "Filter by priority (can be repeated)")
+	fs.StringArrayVar(&f.noPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
+	fs.StringArrayVar(&f.tag, "tag", nil, "Filter by tag (can be repeated, OR logic)")
+	fs.StringArrayVar(&f.noTag, "no-tag", nil, "Exclude beans with tag (can be repeated)")
+	fs.StringArrayVar(&f.assignee, "assignee", nil, "Filter by assignee (can be repeated, OR logic)")
+	fs.BoolVar(&f.me, "me", false, "Filter beans assigned to you ($BEANS_USER or git user.email)")
+	fs.BoolVar(&f.unassigned, "unassigned", false, "Filter beans without assignees")
+	fs.StringVar(&f.dueBefore, "due-before", "", "Filter beans due on or before date (YYYY-MM-DD, today, +Nd, ...)")
+	fs.StringVar(&f.dueAfter, "due-after", "", "Filter beans due on or after date (YYYY-MM-DD, today, +Nd, ...)")
+	fs.BoolVar(&f.overdue, "overdue", false, "Filter beans past their due date that aren't completed or scrapped")
+	fs.StringArrayVar(&f.field, "field", nil, "Filter by custom field as key=value, or key to require it is set (can be repeated)")
+	fs.BoolVar(&f.hasParent, "has-parent", false, "Filter beans with a parent")
+	fs.BoolVar(&f.noParent, "no-parent", false, "Filter beans without a parent")
+	fs.StringVar(&f.parentID, "parent", "", "Filter by parent ID")
+	fs.BoolVar(&f.hasBlocking, "has-blocking", false, "Filter beans that are blocking others")
+	fs.BoolVar(&f.noBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
+	fs.StringArrayVar(&f.hasLink, "has-link", nil, "Filter beans with links of a type, e.g. related or duplicated_by (can be repeated, OR logic)")
+	fs.StringArrayVar(&f.noLink, "no-link", nil, "Exclude beans with links of a type (can be repeated)")
+	fs.BoolVar(&f.isBlocked, "is-blocked", false, "Filter beans that are blocked by others")
+	fs.BoolVar(&f.ready, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/draft and archive statuses)")
+}
+
+// apply adds the filters from the flags to the given filter. The filter may
+// already hold a view's filters; flags only ever narrow it, so a flag that
+// can't be combined with the view is an error.
+func (f *beanFilterFlags) apply(filter *model.BeanFilter) error {
+	var err error
+	if filter.Status, err = narrowList("--status", filter.Status, f.status); err != nil {
+		return err
+	}
+	filter.ExcludeStatus = append(filter.ExcludeStatus, f.noStatus...)
+	if filter.Type, err = narrowList("--type", filter.Type, f.typ); err != nil {
+		return err
+	}
+	filter.ExcludeType = append(filter.ExcludeType, f.noType...)
+	if filter.Priority, err = narrowList("--priority", filter.Priority, f.priority); err != nil {
+		return err
+	}
+	filter.ExcludePriority = append(filter.ExcludePriority, f.noPriority...)
+	if filter.Tags, err = narrowList("--tag", filter.Tags, f.tag); err != nil {
+		return err
+	}
+	filter.ExcludeTags = append(filter.ExcludeTags, f.noTag...)
+
+	// Add assignee filters
+	assignees, err := withCurrentUser(f.assignee, f.me)
+	if err != nil {
+		return err
+	}
+	if filter.Assignee, err = narrowList("--assignee", filter.Assignee, assignees); err != nil {
+		return err
+	}
+	if f.unassigned {
+		filter.NoAssignee = &f.unassigned
+	}
+
+	// Add date filters
+	if f.dueBefore != "" {
+		d, err := bean.ParseDate(f.dueBefore, bean.Today())
+		if err != nil {
+			return fmt.Errorf("--due-before: %w", err)
+		}
+		if filter.DueBefore == nil || d.Before(filter.DueBefore.Time) {
+			filter.DueBefore = &d
+		}
+	}
+	if f.dueAfter != "" {
+		d, err := bean.ParseDate(f.dueAfter, bean.Today())
+		if err != nil {
+			return fmt.Errorf("--due-after: %w", err)
+		}
+		if filter.DueAfter == nil || d.After(filter.DueAfter.Time) {
+			filter.DueAfter = &d
+		}
+	}
+	if f.overdue {
+		filter.IsOverdue = &f.overdue
+	}
+
+	// Add custom field filters
+	if len(f.field) > 0 {
+		fields, err := beangraph.ParseFieldFilters(f.field)
+		if err != nil {
+			return err
+		}
+		filter.Fields = append(filter.Fields, fields...)
+	}
+
+	// Add search filter if provided, requiring both terms if the view has one
+	if f.search != "" {
+		search := f.search
+		if filter.Search != nil && *filter.Search != "" {
+			search = "+(" + *filter.Search + ") +(" + f.search + ")"
+		}
+		filter.Search = &search
+	}
+
+	// Add parent/blocks filters
+	if f.hasParent {
+		filter.HasParent = &f.hasParent
+	}
+	if f.noParent {
+		filter.NoParent = &f.noParent
+	}
+	if f.parentID != "" {
+		if filter.ParentID != nil && *filter.ParentID != f.parentID {
+			return fmt.Errorf("--parent %s conflicts with the view's parent %s", f.parentID, *filter.ParentID)
+		}
+		filter.ParentID = &f.parentID
+	}
+	if f.hasBlocking {
+		filter.HasBlocking = &f.hasBlocking
+	}
+	if f.noBlocking {
+		filter.NoBlocking = &f.noBlocking
+	}
+	if filter.HasLink, err = narrowList("--has-link", filter.HasLink, f.hasLink); err != nil {
+		return err
+	}
+	filter.NoLink = append(filter.NoLink, f.noLink...)
+	// --ready and --is-blocked are mutually exclusive
+	if f.ready && f.isBlocked {
+		return fmt.Errorf("--ready and --is-blocked are mutually exclusive")
+	}
+
+	if f.isBlocked {
+		if filter.IsBlocked != nil && !*filter.IsBlocked {
+			return fmt.Errorf("--is-blocked conflicts with the view, which excludes blocked beans")
+		}
+		filter.IsBlocked = &f.isBlocked
+	}
+
+	// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft,
+	// and excludes beans with implicit terminal status from a scrapped/completed ancestor)
+	if f.ready {
+		if filter.IsBlocked != nil && *filter.IsBlocked {
+			return fmt.Errorf("--ready conflicts with the view, which only includes blocked beans")
+		}
+		beangraph.ApplyReadyFilter(filter, cfg)
+	}
+
+	return nil
+}
+
+// narrowList combines a list filter (matching any of its values) with the
+// values given for flag. If both are set, only values in both are kept, and
+// it's an error if there are none.
+func narrowList(flag string, current, values []string) ([]string, error) {
+	if len(values) == 0 {
+		return current, nil
+	}
+	if len(current) == 0 {
+		return values, nil
+	}
+	var result []string
+	for _, c := range current {
+		if slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(c, v) }) {
+			result = append(result, c)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%s %s matches none of the view's values (%s)", flag, strings.Join(values, ", "), strings.Join(current, ", "))
+	}
+	return result, nil
+}
+
+// parseWhere parses a --where expression (list filter flags, e.g.
+// "--status todo --tag backend") into a bean filter.
+func parseWhere(where string) (*model.BeanFilter, error) {
+	args, err := splitArgs(where)
+	if err != nil {
+		return nil, fmt.Errorf("--where: %w", err)
+	}
+
+	var f beanFilterFlags
+	fs := pflag.NewFlagSet("where", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("--where: %w", err)
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("--where: unexpected argument %q (expected filter flags like --status todo)", fs.Arg(0))
+	}
+
+	filter := &model.BeanFilter{}
+	if err := f.apply(filter); err != nil {
+		return nil, fmt.Errorf("--where: %w", err)
+	}
+	return filter, nil
+}
+
+// splitArgs splits a command line into arguments, honoring single and double
+// quotes and backslash escapes (outside single quotes).
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	var quote rune
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\\':
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			cur.WriteRune(runes[i])
+			inArg = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/spf13/pflag"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"--status todo --tag backend", []string{"--status", "todo", "--tag", "backend"}, false},
+		{"  --status   todo  ", []string{"--status", "todo"}, false},
+		{`-S "user login"`, []string{"-S", "user login"}, false},
+		{`-S 'it\'s'`, nil, true},
+		{`-S 'a "b"'`, []string{"-S", `a "b"`}, false},
+		{`-S a\ b`, []string{"-S", "a b"}, false},
+		{`--field ""`, []string{"--field", ""}, false},
+		{`-S "open`, nil, true},
+		{`-S open\`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := splitArgs(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitArgs(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("splitArgs(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWhere(t *testing.T) {
+	filter, err := parseWhere("--status todo -t bug --no-tag later --field team=core --has-parent")
+	if err != nil {
+		t.Fatalf("parseWhere() error = %v", err)
+	}
+	if !slices.Equal(filter.Status, []string{"todo"}) || !slices.Equal(filter.Type, []string{"bug"}) ||
+		!slices.Equal(filter.ExcludeTags, []string{"later"}) || len(filter.Fields) != 1 ||
+		filter.HasParent == nil || !*filter.HasParent {
+		t.Errorf("parseWhere() = %+v, want status, type, exclude tag, field, and has-parent filters", filter)
+	}
+
+	for _, where := range []string{"--bogus", "todo", "--due-before someday", `--status "todo`} {
+		if _, err := parseWhere(where); err == nil {
+			t.Errorf("parseWhere(%q) should fail", where)
+		}
+	}
+}
+
+func TestFilterFlagsNarrowView(t *testing.T) {
+	parse := func(t *testing.T, args ...string) *beanFilterFlags {
+		t.Helper()
+		var f beanFilterFlags
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		f.register(fs)
+		if err := fs.Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return &f
+	}
+	search, parent, blocked := "login", "beans-p1", true
+	view := func() *model.BeanFilter {
+		return &model.BeanFilter{
+			Status:   []string{"todo", "draft"},
+			Type:     []string{"bug"},
+			Search:   &search,
+			ParentID: &parent,
+		}
+	}
+
+	t.Run("lists are intersected", func(t *testing.T) {
+		filter := view()
+		if err := parse(t, "--status", "todo", "--status", "in-progress", "--tag", "backend").apply(filter); err != nil {
+			t.Fatalf("apply() error = %v", err)
+		}
+		if !slices.Equal(filter.Status, []string{"todo"}) || !slices.Equal(filter.Type, []string{"bug"}) || !slices.Equal(filter.Tags, []string{"backend"}) {
+			t.Errorf("apply() = status %v, type %v, tags %v", filter.Status, filter.Type, filter.Tags)
+		}
+	})
+
+	t.Run("search terms are both required", func(t *testing.T) {
+		filter := view()
+		if err := parse(t, "-S", "broken").apply(filter); err != nil {
+			t.Fatalf("apply() error = %v", err)
+		}
+		if want := "+(login) +(broken)"; filter.Search == nil || *filter.Search != want {
+			t.Errorf("apply() search = %v, want %q", filter.Search, want)
+		}
+	})
+
+	t.Run("earlier due-before wins", func(t *testing.T) {
+		filter := view()
+		d := bean.DateOf(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+		filter.DueBefore = &d
+		if err := parse(t, "--due-before", "2026-06-01").apply(filter); err != nil {
+			t.Fatalf("apply() error = %v", err)
+		}
+		if filter.DueBefore.String() != "2026-03-01" {
+			t.Errorf("apply() due before = %s, want the view's 2026-03-01", filter.DueBefore)
+		}
+	})
+
+	conflicts := [][]string{
+		{"--status", "in-progress"},
+		{"-t", "feature"},
+		{"--parent", "beans-p2"},
+	}
+	for _, args := range conflicts {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			if err := parse(t, args...).apply(view()); err == nil {
+				t.Errorf("apply(%v) on view should fail", args)
+			}
+		})
+	}
+	t.Run("--ready on a blocked view", func(t *testing.T) {
+		if err := parse(t, "--ready").apply(&model.BeanFilter{IsBlocked: &blocked}); err == nil {
+			t.Error("apply(--ready) on a view of blocked beans should fail")
+		}
+	})
+}
@@ -143,6 +143,7 @@ func executeQuery(query string, variables map[string]any, operationName string)
 	})
 
 	exec := executor.New(es)
+	exec.AroundResponses(shareProgress)
 
 	ctx := graphql.StartOperationTrace(context.Background())
 	params := &graphql.RawParams{
@@ -167,6 +168,12 @@ func executeQuery(query string, variables map[string]any, operationName string)
 	return resp.Data, nil
 }
 
+// shareProgress is a response middleware that computes bean progress once
+// per response, rather than once for every bean that selects a progress field.
+func shareProgress(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(beangraph.WithProgressCache(ctx))
+}
+
 // formatGraphQLErrors formats GraphQL errors into a single error.
 func formatGraphQLErrors(errs gqlerror.List) error {
 	if len(errs) == 0 {
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/importer"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importFrom   string
+	importDryRun bool
+	importJSON   bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import beans from GitHub issues, CSV, or JSONL",
+	Long: `Imports issues exported from other trackers as beans.
+
+Formats (--from):
+  github-json  JSON array of GitHub issues, e.g. from
+               gh issue list --state all --limit 1000 \
+                 --json number,url,title,body,state,stateReason,labels,milestone,assignees
+  csv          CSV with a header row
+  jsonl        one JSON object per line
+
+CSV and JSONL columns: id, title, body, status, type, priority, tags, due,
+milestone, blocked_by, assignees. Only title is required; without an id, the
+title identifies the record.
+
+Labels naming a type or priority set it, all others become tags. Open/closed
+states map to todo/completed. Milestones become milestone beans that are set as
+parents where the type rules allow it, and "blocked by #N" references in issue
+bodies become blocked_by links.
+
+Each imported bean records its source in the imported_from field, so importing
+the same file again updates the existing beans instead of duplicating them.
+Use --dry-run to preview the changes; beans it would create are shown with
+placeholder IDs (new:1, new:2, ...), as their real IDs are only generated when
+they are created. Pass - as file to read from stdin.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(importer.Formats, importFrom) {
+			return cmdError(importJSON, output.ErrValidation, "invalid --from: %q (must be %s)", importFrom, strings.Join(importer.Formats, ", "))
+		}
+
+		var r io.Reader = os.Stdin
+		if args[0] != "-" {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return cmdError(importJSON, output.ErrFileError, "failed to open import file: %s", err)
+			}
+			defer f.Close()
+			r = f
+		}
+
+		records, err := importer.Parse(importFrom, r)
+		if err != nil {
+			return cmdError(importJSON, output.ErrValidation, "%s", err)
+		}
+
+		newID := importIDGenerator()
+		if importDryRun {
+			newID = importer.PlaceholderIDs()
+		}
+		plan, err := importer.NewPlan(records, core.All(), cfg, newID)
+		if err != nil {
+			return cmdError(importJSON, output.ErrValidation, "%s", err)
+		}
+		for _, w := range plan.Warnings {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+		}
+
+		if !importDryRun {
+			if err := plan.Apply(core); err != nil {
+				return cmdError(importJSON, output.ErrFileError, "%s", err)
+			}
+		}
+
+		created, updated := plan.Count(importer.ActionCreate), plan.Count(importer.ActionUpdate)
+		msg := fmt.Sprintf("Imported %d records: %d created, %d updated", len(plan.Actions), created, updated)
+		if importDryRun {
+			msg = fmt.Sprintf("Dry run: %d records, %d would be created, %d updated", len(plan.Actions), created, updated)
+			if created > 0 {
+				msg += " (new beans get their IDs when created)"
+			}
+		}
+
+		if importJSON {
+			return output.SuccessResults(plan.Actions, msg)
+		}
+
+		for _, a := range plan.Actions {
+			label := ui.Muted.Render("Unchanged ")
+			switch {
+			case a.Kind == importer.ActionCreate && importDryRun:
+				label = ui.Warning.Render("Would create ")
+			case a.Kind == importer.ActionCreate:
+				label = ui.Success.Render("Created ")
+			case a.Kind == importer.ActionUpdate && importDryRun:
+				label = ui.Warning.Render("Would update ")
+			case a.Kind == importer.ActionUpdate:
+				label = ui.Success.Render("Updated ")
+			}
+			fmt.Println(label + ui.ID.Render(a.Bean.ID) + " " + a.Bean.Title + " " + ui.Muted.Render("("+a.Key+")"))
+			for _, ch := range a.Changes {
+				fmt.Print(formatFieldChange(ch))
+			}
+		}
+		fmt.Println(ui.Muted.Render(msg))
+		return nil
+	},
+}
+
+// importIDGenerator returns a function generating IDs for imported beans that
+// don't collide with existing beans or with each other.
+func importIDGenerator() func() (string, error) {
+	length := 4
+	if cfg.Beans.IDLength > 0 {
+		length = cfg.Beans.IDLength
+	}
+	used := make(map[string]bool)
+	for _, b := range core.All() {
+		used[b.ID] = true
+	}
+	return func() (string, error) {
+		for {
+			id, err := bean.NewID(cfg.Beans.Prefix, length)
+			if err != nil {
+				return "", fmt.Errorf("generating bean ID: %w", err)
+			}
+			if !used[id] {
+				used[id] = true
+				return id, nil
+			}
+		}
+	}
+}
+
+func RegisterImportCmd(root *cobra.Command) {
+	importCmd.Flags().StringVar(&importFrom, "from", "", "Import format: "+strings.Join(importer.Formats, ", "))
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without writing any beans")
+	importCmd.Flags().BoolVar(&importJSON, "json", false, "Output as JSON")
+	_ = importCmd.MarkFlagRequired("from")
+	root.AddCommand(importCmd)
+}
@@ -12,12 +12,20 @@ import (
 	"github.com/hmans/beans/pkg/config"
 )
 
-var initJSON bool
+var (
+	initJSON           bool
+	initGitMergeDriver bool
+)
 
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize a beans project",
-	Long:  `Creates a .beans directory and .beans.yml config file in the current directory.`,
+	Long: `Creates a .beans directory and .beans.yml config file in the current directory.
+
+With --git-merge-driver, also registers 'beans merge-driver' for bean files in
+.gitattributes and the local git config, so that concurrent edits to the same
+bean on different branches are merged field by field. Run it again in fresh
+clones to set up the local git config; an existing .beans.yml is kept.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var projectDir string
 		var beansDir string
@@ -68,11 +76,24 @@ var initCmd = &cobra.Command{
 			if baseRef, ok := gitutil.DefaultRemoteBranch(projectDir, "origin"); ok {
 				defaultCfg.Worktree.BaseRef = baseRef
 			}
-			if err := defaultCfg.Save(projectDir); err != nil {
+			// With --git-merge-driver, keep an existing config (e.g. in a fresh clone)
+			_, statErr := os.Stat(filepath.Join(projectDir, config.ConfigFileName))
+			if !initGitMergeDriver || statErr != nil {
+				if err := defaultCfg.Save(projectDir); err != nil {
+					if initJSON {
+						return output.Error(output.ErrFileError, err.Error())
+					}
+					return fmt.Errorf("failed to create config: %w", err)
+				}
+			}
+		}
+
+		if initGitMergeDriver {
+			if err := installMergeDriver(beansDir); err != nil {
 				if initJSON {
 					return output.Error(output.ErrFileError, err.Error())
 				}
-				return fmt.Errorf("failed to create config: %w", err)
+				return fmt.Errorf("failed to install merge driver: %w", err)
 			}
 		}
 
@@ -81,11 +102,15 @@ var initCmd = &cobra.Command{
 		}
 
 		fmt.Println("Initialized beans project")
+		if initGitMergeDriver {
+			fmt.Println("Registered beans merge driver in .gitattributes and git config")
+		}
 		return nil
 	},
 }
 
 func RegisterInitCmd(root *cobra.Command) {
 	initCmd.Flags().BoolVar(&initJSON, "json", false, "Output as JSON")
+	initCmd.Flags().BoolVar(&initGitMergeDriver, "git-merge-driver", false, "Register the beans merge driver for bean files in .gitattributes and git config")
 	root.AddCommand(initCmd)
 }
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"sort"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/config"
@@ -17,26 +17,12 @@ import (
 )
 
 var (
-	listJSON       bool
-	listSearch     string
-	listStatus     []string
-	listNoStatus   []string
-	listType       []string
-	listNoType     []string
-	listPriority   []string
-	listNoPriority []string
-	listTag        []string
-	listNoTag      []string
-	listHasParent   bool
-	listNoParent    bool
-	listParentID    string
-	listHasBlocking bool
-	listNoBlocking  bool
-	listIsBlocked   bool
-	listReady      bool
-	listQuiet      bool
-	listSort       string
-	listFull       bool
+	listJSON   bool
+	listFilter beanFilterFlags
+	listQuiet  bool
+	listSort   string
+	listView   string
+	listFull   bool
 )
 
 var listCmd = &cobra.Command{
@@ -57,58 +43,56 @@ Search Syntax (--search/-S):
   user OR login  Either term matches
   slug:auth      Search only in slug field
   title:login    Search only in title field
-  body:auth      Search only in body field`,
+  body:auth      Search only in body field
+
+Views (--view):
+  Named views declared under 'views' in .beans.yml bundle a filter, sort order,
+  and output format. Other filter flags narrow a view further:
+
+  views:
+    - name: triage
+      filter:
+        status: [todo]
+        exclude_tags: [later]
+        priority: [high, critical]
+        ready: true
+      sort: priority
+      format: tree   # tree, json, or ids`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// Build GraphQL filter from CLI flags
-		filter := &model.BeanFilter{
-			Status:          listStatus,
-			ExcludeStatus:   listNoStatus,
-			Type:            listType,
-			ExcludeType:     listNoType,
-			Priority:        listPriority,
-			ExcludePriority: listNoPriority,
-			Tags:            listTag,
-			ExcludeTags:     listNoTag,
-		}
-
-		// Add search filter if provided
-		if listSearch != "" {
-			filter.Search = &listSearch
-		}
-
-		// Add parent/blocks filters
-		if listHasParent {
-			filter.HasParent = &listHasParent
-		}
-		if listNoParent {
-			filter.NoParent = &listNoParent
-		}
-		if listParentID != "" {
-			filter.ParentID = &listParentID
-		}
-		if listHasBlocking {
-			filter.HasBlocking = &listHasBlocking
-		}
-		if listNoBlocking {
-			filter.NoBlocking = &listNoBlocking
-		}
-		// --ready and --is-blocked are mutually exclusive
-		if listReady && listIsBlocked {
-			return fmt.Errorf("--ready and --is-blocked are mutually exclusive")
+		// Start from the named view, if any; flags narrow it further
+		filter := &model.BeanFilter{}
+		sortBy, asJSON, quiet := listSort, listJSON, listQuiet
+		if listView != "" {
+			view := cfg.GetView(listView)
+			if view == nil {
+				if len(cfg.Views) == 0 {
+					return cmdError(listJSON, output.ErrValidation, "unknown view: %s (no views are declared in %s)", listView, config.ConfigFileName)
+				}
+				return cmdError(listJSON, output.ErrValidation, "unknown view: %s (must be %s)", listView, cfg.ViewList())
+			}
+			var err error
+			filter, err = beangraph.ViewFilter(cfg, view, core.CurrentUser(), bean.Today())
+			if err != nil {
+				return cmdError(listJSON, output.ErrValidation, "%s", err)
+			}
+			if !cmd.Flags().Changed("sort") {
+				sortBy = view.Sort
+			}
+			switch view.GetFormat() {
+			case config.ViewFormatJSON:
+				asJSON = asJSON || !quiet
+			case config.ViewFormatIDs:
+				quiet = quiet || !asJSON
+			}
 		}
 
-		if listIsBlocked {
-			filter.IsBlocked = &listIsBlocked
+		if _, err := bean.ParseSort(sortBy); err != nil {
+			return cmdError(listJSON, output.ErrValidation, "%s", err)
 		}
 
-		// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft,
-		// and excludes beans with implicit terminal status from a scrapped/completed ancestor)
-		if listReady {
-			isBlocked := false
-			excludeImplicitTerminal := true
-			filter.IsBlocked = &isBlocked
-			filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "completed", "scrapped", "draft")
-			filter.ExcludeImplicitTerminal = &excludeImplicitTerminal
+		// Add filters from CLI flags
+		if err := listFilter.apply(filter); err != nil {
+			return cmdError(listJSON, output.ErrValidation, "%s", err)
 		}
 
 		// Execute query via core resolver
@@ -119,10 +103,10 @@ Search Syntax (--search/-S):
 		}
 
 		// Sort beans
-		sortBeans(beans, listSort, cfg)
+		sortBeans(beans, sortBy, cfg)
 
 		// JSON output (flat list)
-		if listJSON {
+		if asJSON {
 			if !listFull {
 				for _, b := range beans {
 					b.Body = ""
@@ -132,7 +116,7 @@ Search Syntax (--search/-S):
 		}
 
 		// Quiet mode: just IDs (flat)
-		if listQuiet {
+		if quiet {
 			for _, b := range beans {
 				fmt.Println(b.ID)
 			}
@@ -156,7 +140,7 @@ Search Syntax (--search/-S):
 
 		// Create sort function for tree building
 		sortFn := func(b []*bean.Bean) {
-			sortBeans(b, listSort, cfg)
+			sortBeans(b, sortBy, cfg)
 		}
 
 		// Build tree
@@ -167,6 +151,15 @@ Search Syntax (--search/-S):
 			return nil
 		}
 
+		// Show where search hits matched, below each bean
+		if filter.Search != nil && *filter.Search != "" {
+			results, err := core.SearchResults(*filter.Search, 0)
+			if err != nil {
+				return fmt.Errorf("searching beans: %w", err)
+			}
+			setSearchSnippets(tree, results)
+		}
+
 		// Calculate max ID width from all beans in tree
 		maxIDWidth := 2
 		for _, b := range allBeans {
@@ -196,91 +189,33 @@ Search Syntax (--search/-S):
 	},
 }
 
-func sortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) {
-	statusNames := cfg.StatusNames()
-	priorityNames := cfg.PriorityNames()
-	typeNames := cfg.TypeNames()
-
-	switch sortBy {
-	case "created":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].CreatedAt == nil && beans[j].CreatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].CreatedAt == nil {
-				return false
-			}
-			if beans[j].CreatedAt == nil {
-				return true
-			}
-			return beans[i].CreatedAt.After(*beans[j].CreatedAt)
-		})
-	case "updated":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].UpdatedAt == nil && beans[j].UpdatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].UpdatedAt == nil {
-				return false
-			}
-			if beans[j].UpdatedAt == nil {
-				return true
-			}
-			return beans[i].UpdatedAt.After(*beans[j].UpdatedAt)
-		})
-	case "status":
-		// Build status order from configured statuses
-		statusOrder := make(map[string]int)
-		for i, s := range statusNames {
-			statusOrder[s] = i
-		}
-		sort.Slice(beans, func(i, j int) bool {
-			oi, oj := statusOrder[beans[i].Status], statusOrder[beans[j].Status]
-			if oi != oj {
-				return oi < oj
-			}
-			return beans[i].ID < beans[j].ID
-		})
-	case "priority":
-		// Build priority order from configured priorities
-		priorityOrder := make(map[string]int)
-		for i, p := range priorityNames {
-			priorityOrder[p] = i
-		}
-		// Find normal priority index for beans without priority
-		normalIdx := len(priorityNames)
-		for i, p := range priorityNames {
-			if p == "normal" {
-				normalIdx = i
+// setSearchSnippets attaches the best snippet of each search hit to its tree
+// node. Title matches are skipped, since the title is shown anyway.
+func setSearchSnippets(nodes []*ui.TreeNode, results *beancore.SearchResults) {
+	snippets := make(map[string]string)
+	for _, hit := range results.Hits {
+		for _, s := range hit.Snippets {
+			if s.Field != "title" {
+				snippets[hit.Bean.ID] = s.Text
 				break
 			}
 		}
-		sort.Slice(beans, func(i, j int) bool {
-			pi := normalIdx
-			if beans[i].Priority != "" {
-				if order, ok := priorityOrder[beans[i].Priority]; ok {
-					pi = order
-				}
-			}
-			pj := normalIdx
-			if beans[j].Priority != "" {
-				if order, ok := priorityOrder[beans[j].Priority]; ok {
-					pj = order
-				}
-			}
-			if pi != pj {
-				return pi < pj
+	}
+
+	var walk func(nodes []*ui.TreeNode)
+	walk = func(nodes []*ui.TreeNode) {
+		for _, n := range nodes {
+			if n.Matched {
+				n.Snippet = snippets[n.Bean.ID]
 			}
-			return beans[i].ID < beans[j].ID
-		})
-	case "id":
-		sort.Slice(beans, func(i, j int) bool {
-			return beans[i].ID < beans[j].ID
-		})
-	default:
-		// Default: sort by status order, then priority, then type order, then title (same as TUI)
-		bean.SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+			walk(n.Children)
+		}
 	}
+	walk(nodes)
+}
+
+func sortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) {
+	bean.SortBy(beans, sortBy, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
 }
 
 func truncate(s string, maxLen int) string {
@@ -290,26 +225,15 @@ func truncate(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// sortFlagUsage is the help text of the --sort flags of list and export.
+const sortFlagUsage = "Sort by: created, updated, due, status, priority, type, order, id, optionally with :asc or :desc (default: status, priority, type, title)"
+
 func RegisterListCmd(root *cobra.Command) {
 	listCmd.Flags().BoolVar(&listJSON, "json", false, "Output as JSON")
-	listCmd.Flags().StringVarP(&listSearch, "search", "S", "", "Full-text search in title and body")
-	listCmd.Flags().StringArrayVarP(&listStatus, "status", "s", nil, "Filter by status (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoStatus, "no-status", nil, "Exclude by status (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listType, "type", "t", nil, "Filter by type (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoType, "no-type", nil, "Exclude by type (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listPriority, "priority", "p", nil, "Filter by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listTag, "tag", nil, "Filter by tag (can be repeated, OR logic)")
-	listCmd.Flags().StringArrayVar(&listNoTag, "no-tag", nil, "Exclude beans with tag (can be repeated)")
-	listCmd.Flags().BoolVar(&listHasParent, "has-parent", false, "Filter beans with a parent")
-	listCmd.Flags().BoolVar(&listNoParent, "no-parent", false, "Filter beans without a parent")
-	listCmd.Flags().StringVar(&listParentID, "parent", "", "Filter by parent ID")
-	listCmd.Flags().BoolVar(&listHasBlocking, "has-blocking", false, "Filter beans that are blocking others")
-	listCmd.Flags().BoolVar(&listNoBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
-	listCmd.Flags().BoolVar(&listIsBlocked, "is-blocked", false, "Filter beans that are blocked by others")
-	listCmd.Flags().BoolVar(&listReady, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)")
+	listFilter.register(listCmd.Flags())
 	listCmd.Flags().BoolVarP(&listQuiet, "quiet", "q", false, "Only output IDs (one per line)")
-	listCmd.Flags().StringVar(&listSort, "sort", "", "Sort by: created, updated, status, priority, id (default: status, priority, type, title)")
+	listCmd.Flags().StringVar(&listView, "view", "", "Use a named view from .beans.yml (filter, sort, and format)")
+	listCmd.Flags().StringVar(&listSort, "sort", "", sortFlagUsage)
 	listCmd.Flags().BoolVar(&listFull, "full", false, "Include bean body in JSON output")
 	root.AddCommand(listCmd)
 }
@@ -74,6 +74,23 @@ func TestSortBeans(t *testing.T) {
 		}
 	})
 
+	t.Run("sort by due", func(t *testing.T) {
+		soon := bean.DateOf(now)
+		later := soon.AddDays(7)
+		beans := []*bean.Bean{
+			{ID: "none"},
+			{ID: "later", Due: &later},
+			{ID: "soon", Due: &soon},
+		}
+		sortBeans(beans, "due", testCfg)
+
+		// Earliest due first, beans without a due date last
+		if beans[0].ID != "soon" || beans[1].ID != "later" || beans[2].ID != "none" {
+			t.Errorf("sort by due: got [%s, %s, %s], want [soon, later, none]",
+				beans[0].ID, beans[1].ID, beans[2].ID)
+		}
+	})
+
 	t.Run("sort by status", func(t *testing.T) {
 		beans := []*bean.Bean{
 			{ID: "c1", Status: "completed"},
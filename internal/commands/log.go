@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logJSON   bool
+	logLimit  int
+	logNoBody bool
+)
+
+var logCmd = &cobra.Command{
+	Use:   "log <id>",
+	Short: "Show a bean's change history",
+	Long: `Shows who changed a bean and when, reconstructed from the git history of its file in .beans/.
+
+Each entry lists the field-level changes made in that commit (status, priority, type,
+parent, tags, relationships, custom fields, and body diffs), newest first. Changes that
+haven't been committed yet are not included.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := core.History(args[0])
+		if err != nil {
+			if errors.Is(err, beancore.ErrNotFound) {
+				return cmdError(logJSON, output.ErrNotFound, "bean not found: %s", args[0])
+			}
+			if errors.Is(err, beancore.ErrNoHistory) {
+				return cmdError(logJSON, output.ErrFileError, "no history available: %s is not in a git repository", core.Root())
+			}
+			return cmdError(logJSON, output.ErrFileError, "failed to read history: %s", err)
+		}
+
+		if logLimit > 0 && logLimit < len(entries) {
+			entries = entries[:logLimit]
+		}
+		if logNoBody {
+			for i := range entries {
+				entries[i].Changes = withoutBodyChanges(entries[i].Changes)
+			}
+		}
+
+		if logJSON {
+			if entries == nil {
+				entries = []beancore.HistoryEntry{}
+			}
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(entries)
+		}
+
+		if len(entries) == 0 {
+			fmt.Println(ui.Muted.Render("No committed history for this bean yet."))
+			return nil
+		}
+
+		for i, entry := range entries {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Print(formatHistoryEntry(entry))
+		}
+		return nil
+	},
+}
+
+// withoutBodyChanges returns the changes with body diffs removed.
+func withoutBodyChanges(changes []beancore.FieldChange) []beancore.FieldChange {
+	result := make([]beancore.FieldChange, 0, len(changes))
+	for _, ch := range changes {
+		if ch.Field != "body" {
+			result = append(result, ch)
+		}
+	}
+	return result
+}
+
+// formatHistoryEntry renders a single history entry for terminal output.
+func formatHistoryEntry(entry beancore.HistoryEntry) string {
+	var sb strings.Builder
+
+	commit := entry.Commit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	sb.WriteString(ui.Warning.Render(commit))
+	sb.WriteString("  ")
+	sb.WriteString(entry.Date.Local().Format("2006-01-02 15:04"))
+	sb.WriteString("  ")
+	sb.WriteString(entry.Author)
+	if entry.Email != "" {
+		sb.WriteString(ui.Muted.Render(" <" + entry.Email + ">"))
+	}
+	sb.WriteString("\n")
+	sb.WriteString("  " + ui.Muted.Render(entry.Message) + "\n")
+
+	switch {
+	case entry.Deleted:
+		sb.WriteString("  " + ui.Danger.Render("deleted") + "\n")
+		return sb.String()
+	case entry.Created:
+		sb.WriteString("  " + ui.Success.Render("created") + "\n")
+	}
+
+	for _, ch := range entry.Changes {
+		sb.WriteString(formatFieldChange(ch))
+	}
+	return sb.String()
+}
+
+// formatFieldChange renders a single field change, indented for inclusion in
+// a history entry.
+func formatFieldChange(ch beancore.FieldChange) string {
+	label := ui.Bold.Render(ch.Field + ":")
+
+	if ch.Field == "body" {
+		var sb strings.Builder
+		sb.WriteString("  " + label + "\n")
+		for _, line := range strings.Split(ch.Diff, "\n") {
+			switch {
+			case strings.HasPrefix(line, "+"):
+				line = ui.Success.Render(line)
+			case strings.HasPrefix(line, "-"):
+				line = ui.Danger.Render(line)
+			default:
+				line = ui.Muted.Render(line)
+			}
+			sb.WriteString("    " + line + "\n")
+		}
+		return sb.String()
+	}
+
+	if len(ch.Added) > 0 || len(ch.Removed) > 0 {
+		var parts []string
+		for _, v := range ch.Added {
+			parts = append(parts, ui.Success.Render("+"+v))
+		}
+		for _, v := range ch.Removed {
+			parts = append(parts, ui.Danger.Render("-"+v))
+		}
+		return fmt.Sprintf("  %s %s\n", label, strings.Join(parts, " "))
+	}
+
+	to := ch.To
+	if to == "" {
+		to = "(none)"
+	}
+	if ch.From == "" {
+		return fmt.Sprintf("  %s %s\n", label, to)
+	}
+	return fmt.Sprintf("  %s %s → %s\n", label, ui.Muted.Render(ch.From), to)
+}
+
+func RegisterLogCmd(root *cobra.Command) {
+	logCmd.Flags().BoolVar(&logJSON, "json", false, "Output as JSON")
+	logCmd.Flags().IntVarP(&logLimit, "limit", "n", 0, "Show at most this many entries")
+	logCmd.Flags().BoolVar(&logNoBody, "no-body", false, "Omit body diffs")
+	root.AddCommand(logCmd)
+}
@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hmans/beans/internal/gitutil"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/spf13/cobra"
+)
+
+// mergeDriverName is the name of the merge driver in .gitattributes and git config.
+const mergeDriverName = "beans"
+
+var mergeDriverCmd = &cobra.Command{
+	Use:   "merge-driver <base> <ours> <theirs> [path]",
+	Short: "Merge diverged versions of a bean file (git merge driver)",
+	Long: `Performs a structured three-way merge of a bean file. This is meant to be
+called by git; register it with 'beans init --git-merge-driver'.
+
+The merged bean is written to <ours>:
+- Fields changed on one side only take that side's value
+- Fields changed on both sides take the value with the newest updated_at
+- Tags, blocking, blocked_by, and assignees keep additions from both sides
+- Comment threads are combined
+- The body is merged line by line
+
+Conflict markers are only written to the body when both sides changed the same
+lines, in which case the command exits with status 1. Files that can't be
+parsed as beans fall back to git's line-based merge.`,
+	Args: cobra.RangeArgs(3, 4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := ""
+		if len(args) == 4 {
+			path = args[3]
+		}
+
+		conflict, err := mergeBeanFiles(args[0], args[1], args[2], path)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			if path == "" {
+				path = args[1]
+			}
+			fmt.Fprintf(os.Stderr, "beans: merge conflict in %s\n", path)
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// mergeBeanFiles merges the bean files at basePath and theirsPath into
+// oursPath. path is the bean's path in the repository (may be empty), used to
+// restore the ID comment. Returns true if conflicts remain.
+func mergeBeanFiles(basePath, oursPath, theirsPath, path string) (bool, error) {
+	base, baseErr := readBeanFile(basePath)
+	ours, oursErr := readBeanFile(oursPath)
+	theirs, theirsErr := readBeanFile(theirsPath)
+	if baseErr != nil || oursErr != nil || theirsErr != nil || ours == nil || theirs == nil {
+		return gitutil.MergeFile(oursPath, basePath, theirsPath)
+	}
+
+	merged, conflict := bean.Merge(base, ours, theirs)
+	if path != "" {
+		merged.ID, _ = bean.ParseFilename(filepath.Base(path))
+	}
+
+	content, err := merged.Render()
+	if err != nil {
+		return false, fmt.Errorf("rendering merged bean: %w", err)
+	}
+	if err := os.WriteFile(oursPath, content, 0644); err != nil {
+		return false, fmt.Errorf("writing merged bean: %w", err)
+	}
+	return conflict, nil
+}
+
+// readBeanFile parses a bean file, returning nil for an empty file (git passes
+// an empty base when both sides added the file).
+func readBeanFile(path string) (*bean.Bean, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, nil
+	}
+	return bean.Parse(strings.NewReader(string(data)))
+}
+
+// installMergeDriver registers the bean merge driver for all bean files in
+// beansDir: the attribute goes into .gitattributes at the repository root
+// (tracked, so it applies for everyone), the driver command into the local git
+// config (which every clone needs to set up once).
+func installMergeDriver(beansDir string) error {
+	root, err := gitutil.TopLevel(beansDir)
+	if err != nil {
+		return err
+	}
+	absBeansDir, err := filepath.Abs(beansDir)
+	if err != nil {
+		return err
+	}
+	if resolved, err := filepath.EvalSymlinks(absBeansDir); err == nil {
+		absBeansDir = resolved
+	}
+	rel, err := filepath.Rel(root, absBeansDir)
+	if err != nil {
+		return err
+	}
+
+	pattern := "*.md"
+	if rel != "." {
+		pattern = filepath.ToSlash(rel) + "/**/*.md"
+	}
+	if err := ensureLine(filepath.Join(root, ".gitattributes"), pattern+" merge="+mergeDriverName); err != nil {
+		return fmt.Errorf("updating .gitattributes: %w", err)
+	}
+
+	if err := gitutil.SetConfig(root, "merge."+mergeDriverName+".name", "beans three-way merge for bean files"); err != nil {
+		return err
+	}
+	return gitutil.SetConfig(root, "merge."+mergeDriverName+".driver", "beans merge-driver %O %A %B %P")
+}
+
+// ensureLine appends line to the file at path unless it's already present,
+// creating the file if needed.
+func ensureLine(path, line string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	content := string(data)
+	for _, l := range strings.Split(content, "\n") {
+		if strings.TrimSpace(l) == line {
+			return nil
+		}
+	}
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return os.WriteFile(path, []byte(content+line+"\n"), 0644)
+}
+
+func RegisterMergeDriverCmd(root *cobra.Command) {
+	root.AddCommand(mergeDriverCmd)
+}
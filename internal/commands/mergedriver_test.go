@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeBeanFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	base := write("base", "---\ntitle: Login\nstatus: todo\n---\n\nBody\n")
+	ours := write("ours", "---\ntitle: Login\nstatus: in-progress\n---\n\nBody\n")
+	theirs := write("theirs", "---\ntitle: Login\nstatus: todo\ntags:\n    - api\n---\n\nBody\n\nMore\n")
+
+	conflict, err := mergeBeanFiles(base, ours, theirs, ".beans/abc1--login.md")
+	if err != nil {
+		t.Fatalf("mergeBeanFiles() error = %v", err)
+	}
+	if conflict {
+		t.Error("mergeBeanFiles() reported a conflict")
+	}
+	data, _ := os.ReadFile(ours)
+	got := string(data)
+	for _, want := range []string{"# abc1\n", "status: in-progress", "- api", "Body\n\nMore\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("merged file missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestEnsureLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".gitattributes")
+	if err := os.WriteFile(path, []byte("*.png binary"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	for range 2 {
+		if err := ensureLine(path, ".beans/**/*.md merge=beans"); err != nil {
+			t.Fatalf("ensureLine() error = %v", err)
+		}
+	}
+	data, _ := os.ReadFile(path)
+	if want := "*.png binary\n.beans/**/*.md merge=beans\n"; string(data) != want {
+		t.Errorf("file = %q, want %q", data, want)
+	}
+}
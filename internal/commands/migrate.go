@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateDryRun bool
+	migrateJSON   bool
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade the beans data to the current format version",
+	Long: `Applies the migrations needed to bring this project's beans data up to the
+format version of this version of beans, and records the new version as
+beans.format_version in .beans.yml. Without a .beans.yml (e.g. with
+--beans-path), the format version is detected from the beans directory.
+
+Use --dry-run to see which migrations are pending and what they would change.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from := core.FormatVersion()
+		results, err := core.Migrate(migrateDryRun)
+		if err != nil {
+			return cmdError(migrateJSON, output.ErrFileError, "%s", err)
+		}
+
+		var message string
+		switch {
+		case len(results) == 0:
+			message = fmt.Sprintf("Beans data is up to date (format version %d)", from)
+		case migrateDryRun:
+			message = fmt.Sprintf("Would migrate beans data from format version %d to %d", from, config.FormatVersion)
+		default:
+			message = fmt.Sprintf("Migrated beans data from format version %d to %d", from, config.FormatVersion)
+		}
+
+		if migrateJSON {
+			return output.SuccessResults(results, message)
+		}
+
+		for _, r := range results {
+			fmt.Printf("%s %s\n", ui.Bold.Render(fmt.Sprintf("%d:", r.Version)), r.Description)
+			if len(r.Changes) == 0 {
+				fmt.Println(ui.Muted.Render("  nothing to change"))
+			}
+			for _, c := range r.Changes {
+				fmt.Printf("  %s\n", c)
+			}
+		}
+		fmt.Println(message)
+		return nil
+	},
+}
+
+func RegisterMigrateCmd(root *cobra.Command) {
+	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Show pending migrations without applying them")
+	migrateCmd.Flags().BoolVar(&migrateJSON, "json", false, "Output as JSON")
+	root.AddCommand(migrateCmd)
+}
@@ -18,6 +18,10 @@ type promptData struct {
 	Types         []config.TypeConfig
 	Statuses      []config.StatusConfig
 	Priorities    []config.PriorityConfig
+	Fields        []config.FieldConfig
+	Workflow      config.WorkflowConfig
+	Views         []config.ViewConfig
+	LinkTypes     []config.LinkTypeConfig
 }
 
 var primeCmd = &cobra.Command{
@@ -45,11 +49,27 @@ var primeCmd = &cobra.Command{
 			return err
 		}
 
+		// Load the project config so configured statuses, types and priorities are listed
+		primeCfg := config.Default()
+		if configPath != "" {
+			if loaded, err := config.Load(configPath); err == nil {
+				primeCfg = loaded
+			}
+		} else if cwd, err := os.Getwd(); err == nil {
+			if loaded, err := config.LoadFromDirectory(cwd); err == nil {
+				primeCfg = loaded
+			}
+		}
+
 		data := promptData{
 			GraphQLSchema: GetGraphQLSchema(),
-			Types:         config.DefaultTypes,
-			Statuses:      config.DefaultStatuses,
-			Priorities:    config.DefaultPriorities,
+			Types:         primeCfg.GetTypes(),
+			Statuses:      primeCfg.GetStatuses(),
+			Priorities:    primeCfg.GetPriorities(),
+			Fields:        primeCfg.Fields,
+			Workflow:      primeCfg.Workflow,
+			Views:         primeCfg.Views,
+			LinkTypes:     primeCfg.GetLinkTypes(),
 		}
 
 		return tmpl.Execute(os.Stdout, data)
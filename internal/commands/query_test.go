@@ -530,7 +530,8 @@ func TestGetGraphQLSchema(t *testing.T) {
 	// Verify schema contains expected fields
 	expectedFields := []string{
 		"bean(id: ID!)",
-		"beans(filter: BeanFilter)",
+		"beans(filter: BeanFilter, orderBy: [BeanOrder!], first: Int, after: String)",
+		"beansConnection(",
 		"blockedBy",
 		"blocking",
 		"parent",
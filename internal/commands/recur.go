@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/spf13/cobra"
+)
+
+var (
+	recurDue    bool
+	recurDryRun bool
+	recurJSON   bool
+)
+
+// recurResult is the JSON output for a missed occurrence: the finished bean
+// and the next occurrence created for it (omitted in dry runs).
+type recurResult struct {
+	ID   string     `json:"id"`
+	Next *bean.Bean `json:"next,omitempty"`
+}
+
+var recurCmd = &cobra.Command{
+	Use:   "recur",
+	Short: "List recurring beans and create missed occurrences",
+	Long: `Lists recurring beans (those with a recurrence rule, set with --recur).
+
+When a recurring bean is completed with 'beans update', its next occurrence is
+created right away. Beans completed some other way (e.g. by editing their file)
+are listed as missed; use --due to create their next occurrences. Scrapping a
+recurring bean (or giving it another cancelled status) ends its series.
+
+  beans recur
+  beans recur --due --dry-run
+  beans recur --due`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if recurDryRun && !recurDue {
+			return cmdError(recurJSON, output.ErrValidation, "--dry-run can only be used with --due")
+		}
+
+		missed := core.MissedOccurrences()
+		if recurDue {
+			return createMissedOccurrences(missed)
+		}
+
+		var recurring []*bean.Bean
+		for _, b := range core.All() {
+			if b.Recur != "" && !cfg.IsArchiveStatus(b.Status) {
+				recurring = append(recurring, b)
+			}
+		}
+		sort.Slice(recurring, func(i, j int) bool { return recurring[i].ID < recurring[j].ID })
+
+		if recurJSON {
+			return output.SuccessMultiple(append(recurring, missed...))
+		}
+
+		if len(recurring) == 0 && len(missed) == 0 {
+			fmt.Println(ui.Muted.Render("No recurring beans."))
+			return nil
+		}
+		for _, b := range recurring {
+			line := ui.ID.Render(b.ID) + " " + b.Title + " " + ui.Muted.Render("("+b.Recur)
+			if b.Due != nil {
+				line += ui.Muted.Render(", due " + b.Due.String())
+			}
+			fmt.Println(line + ui.Muted.Render(")"))
+		}
+		if len(missed) > 0 {
+			if len(recurring) > 0 {
+				fmt.Println()
+			}
+			fmt.Println(ui.Warning.Render(fmt.Sprintf("%d finished bean(s) without a next occurrence:", len(missed))))
+			for _, b := range missed {
+				fmt.Println("  " + ui.ID.Render(b.ID) + " " + b.Title + " " + ui.Muted.Render("("+b.Recur+")"))
+			}
+			fmt.Println(ui.Muted.Render("Run 'beans recur --due' to create them."))
+		}
+		return nil
+	},
+}
+
+// createMissedOccurrences creates the next occurrence of each missed bean, or
+// lists them with --dry-run.
+func createMissedOccurrences(missed []*bean.Bean) error {
+	today := bean.Today()
+	var results []recurResult
+	for _, b := range missed {
+		result := recurResult{ID: b.ID}
+		if !recurDryRun {
+			next, err := core.Recur(b.ID, today)
+			if err != nil {
+				return cmdError(recurJSON, output.ErrValidation, "failed to create next occurrence of %s: %s", b.ID, err)
+			}
+			result.Next = next
+		}
+		results = append(results, result)
+	}
+
+	msg := fmt.Sprintf("Created %d occurrence(s)", len(results))
+	if recurDryRun {
+		msg = fmt.Sprintf("Dry run: would create %d occurrence(s)", len(results))
+	}
+	if recurJSON {
+		return output.SuccessResults(results, msg)
+	}
+
+	if len(results) == 0 {
+		fmt.Println(ui.Muted.Render("No missed occurrences."))
+		return nil
+	}
+	for i, r := range results {
+		if r.Next == nil {
+			fmt.Println(ui.Warning.Render("Would create next occurrence of ") + ui.ID.Render(r.ID) + " " + missed[i].Title)
+			continue
+		}
+		fmt.Println(ui.Success.Render("Created ") + ui.ID.Render(r.Next.ID) + " " + ui.Muted.Render("(next occurrence of "+r.ID+")"))
+	}
+	fmt.Println(ui.Muted.Render(msg))
+	return nil
+}
+
+func RegisterRecurCmd(root *cobra.Command) {
+	recurCmd.Flags().BoolVar(&recurDue, "due", false, "Create the next occurrence of completed recurring beans that don't have one")
+	recurCmd.Flags().BoolVar(&recurDryRun, "dry-run", false, "With --due, show which occurrences would be created")
+	recurCmd.Flags().BoolVar(&recurJSON, "json", false, "Output as JSON")
+	root.AddCommand(recurCmd)
+}
@@ -11,16 +11,25 @@ import (
 func RegisterCoreCommands(root *cobra.Command) {
 	RegisterArchiveCmd(root)
 	RegisterCheckCmd(root)
+	RegisterCommentCmd(root)
 	RegisterCreateCmd(root)
 	RegisterDeleteCmd(root)
+	RegisterExportCmd(root)
 	RegisterGraphqlCmd(root)
+	RegisterImportCmd(root)
 	RegisterInitCmd(root)
 	RegisterListCmd(root)
+	RegisterLogCmd(root)
+	RegisterMergeDriverCmd(root)
+	RegisterMigrateCmd(root)
 	RegisterPrimeCmd(root)
+	RegisterRecurCmd(root)
 	RegisterRoadmapCmd(root)
 	RegisterShowCmd(root)
+	RegisterStatsCmd(root)
 	RegisterUpdateCmd(root)
 	RegisterVersionCmd(root)
+	RegisterWatchCmd(root)
 
 	// Deprecated placeholders for commands that moved to separate binaries
 	registerDeprecatedCmd(root, "serve", "beans-serve")
@@ -9,10 +9,13 @@ import (
 	"path/filepath"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/hmans/beans/internal/ui"
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +34,8 @@ var (
 
 // roadmapData holds the structured roadmap for JSON output.
 type roadmapData struct {
+	// Overdue lists open beans past their due date, earliest first.
+	Overdue     []*bean.Bean      `json:"overdue,omitempty"`
 	Milestones  []milestoneGroup `json:"milestones"`
 	Unscheduled *unscheduledGroup `json:"unscheduled,omitempty"`
 }
@@ -43,15 +48,17 @@ type unscheduledGroup struct {
 
 // milestoneGroup represents a milestone and its contents.
 type milestoneGroup struct {
-	Milestone *bean.Bean   `json:"milestone"`
-	Epics     []epicGroup  `json:"epics,omitempty"`
-	Other     []*bean.Bean `json:"other,omitempty"`
+	Milestone *bean.Bean         `json:"milestone"`
+	Progress  *beancore.Progress `json:"progress,omitempty"`
+	Epics     []epicGroup        `json:"epics,omitempty"`
+	Other     []*bean.Bean       `json:"other,omitempty"`
 }
 
 // epicGroup represents an epic and its child items.
 type epicGroup struct {
-	Epic  *bean.Bean   `json:"epic"`
-	Items []*bean.Bean `json:"items,omitempty"`
+	Epic     *bean.Bean         `json:"epic"`
+	Progress *beancore.Progress `json:"progress,omitempty"`
+	Items    []*bean.Bean       `json:"items,omitempty"`
 }
 
 
@@ -68,6 +75,7 @@ var roadmapCmd = &cobra.Command{
 
 		// Build the roadmap
 		data := buildRoadmap(allBeans, roadmapIncludeDone, roadmapStatus, roadmapNoStatus)
+		attachProgress(data, core.AllProgress())
 
 		// JSON output
 		if roadmapJSON {
@@ -122,8 +130,9 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 		milestones = append(milestones, b)
 	}
 
-	// Sort milestones by status order, then by created date
+	// Sort milestones by due date (dated first), then status order and created date
 	sortByStatusThenCreated(milestones, cfg)
+	sortByDueDate(milestones)
 
 	// Build milestone groups
 	var milestoneGroups []milestoneGroup
@@ -207,7 +216,18 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 		}
 	}
 
+	// Collect overdue beans
+	today := bean.Today()
+	var overdue []*bean.Bean
+	for _, b := range allBeans {
+		if !cfg.IsArchiveStatus(b.Status) && b.IsOverdue(today) {
+			overdue = append(overdue, b)
+		}
+	}
+	sortByDueDate(overdue)
+
 	return &roadmapData{
+		Overdue:     overdue,
 		Milestones:  milestoneGroups,
 		Unscheduled: unscheduled,
 	}
@@ -306,6 +326,18 @@ func sortByStatusThenCreated(beans []*bean.Bean, cfg interface{ StatusNames() []
 	})
 }
 
+// sortByDueDate stably sorts beans by due date, earliest first. Beans without
+// a due date keep their relative order and come last.
+func sortByDueDate(beans []*bean.Bean) {
+	sort.SliceStable(beans, func(i, j int) bool {
+		di, dj := beans[i].Due, beans[j].Due
+		if di == nil || dj == nil {
+			return di != nil && dj == nil
+		}
+		return di.Before(dj.Time)
+	})
+}
+
 // sortByTypeThenStatus sorts beans by type order, then status order, then by ID.
 func sortByTypeThenStatus(beans []*bean.Bean, cfg interface {
 	StatusNames() []string
@@ -335,6 +367,31 @@ func sortByTypeThenStatus(beans []*bean.Bean, cfg interface {
 	})
 }
 
+// attachProgress sets the rolled-up progress of every milestone and epic that
+// has children.
+func attachProgress(data *roadmapData, progress map[string]beancore.Progress) {
+	lookup := func(id string) *beancore.Progress {
+		p, ok := progress[id]
+		if !ok || p.TotalChildren == 0 {
+			return nil
+		}
+		return &p
+	}
+	attachEpics := func(epics []epicGroup) {
+		for i := range epics {
+			epics[i].Progress = lookup(epics[i].Epic.ID)
+		}
+	}
+
+	for i := range data.Milestones {
+		data.Milestones[i].Progress = lookup(data.Milestones[i].Milestone.ID)
+		attachEpics(data.Milestones[i].Epics)
+	}
+	if data.Unscheduled != nil {
+		attachEpics(data.Unscheduled.Epics)
+	}
+}
+
 // renderRoadmapMarkdown renders the roadmap as Markdown using the template.
 func renderRoadmapMarkdown(data *roadmapData, links bool, linkPrefix string) string {
 	// Create template with closures that capture link settings
@@ -342,6 +399,11 @@ func renderRoadmapMarkdown(data *roadmapData, links bool, linkPrefix string) str
 		template.New("roadmap").Funcs(template.FuncMap{
 			"firstParagraph": firstParagraph,
 			"typeBadge":      typeBadge,
+			"dateRange":      dateRange,
+			"progressLine":   progressLine,
+			"isOverdue": func(b *bean.Bean) bool {
+				return !cfg.IsArchiveStatus(b.Status) && b.IsOverdue(bean.Today())
+			},
 			"beanRef": func(b *bean.Bean) string {
 				return renderBeanRef(b, links, linkPrefix)
 			},
@@ -355,6 +417,34 @@ func renderRoadmapMarkdown(data *roadmapData, links bool, linkPrefix string) str
 	return sb.String()
 }
 
+// dateRange describes a bean's start and due dates, e.g. "2024-03-01 → 2024-04-15",
+// "due 2024-04-15", or "starts 2024-03-01". Returns "" if neither is set.
+func dateRange(b *bean.Bean) string {
+	switch {
+	case b.Start != nil && b.Due != nil:
+		return b.Start.String() + " → " + b.Due.String()
+	case b.Due != nil:
+		return "due " + b.Due.String()
+	case b.Start != nil:
+		return "starts " + b.Start.String()
+	}
+	return ""
+}
+
+// progressLine describes a milestone's or epic's progress as a text progress
+// bar, e.g. "`███░░░░░░░` 30% · 3/10 done, 8 remaining". Returns "" if p is nil.
+func progressLine(p *beancore.Progress) string {
+	if p == nil {
+		return ""
+	}
+	line := fmt.Sprintf("`%s` %d%% · %d/%d done", ui.ProgressBar(p.Progress, 10),
+		int(p.Progress*100+0.5), p.CompletedChildren, p.TotalChildren)
+	if p.TotalEstimate > 0 {
+		line += ", " + strconv.FormatFloat(p.RemainingEstimate, 'f', -1, 64) + " remaining"
+	}
+	return line
+}
+
 // renderBeanRef renders a bean ID, optionally as a markdown link.
 func renderBeanRef(b *bean.Bean, asLink bool, linkPrefix string) string {
 	if !asLink {
@@ -1,10 +1,13 @@
 package commands
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/config"
 )
 
@@ -254,3 +257,82 @@ func TestStatusFiltering(t *testing.T) {
 		}
 	})
 }
+
+func TestRoadmapDates(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	now := time.Now()
+	today := bean.Today()
+	past, soon, later := today.AddDays(-3), today.AddDays(7), today.AddDays(30)
+	beans := []*bean.Bean{
+		{ID: "m-undated", Type: "milestone", Title: "Undated", Status: "in-progress", CreatedAt: &now},
+		{ID: "m-later", Type: "milestone", Title: "Later", Status: "todo", CreatedAt: &now, Due: &later},
+		{ID: "m-soon", Type: "milestone", Title: "Soon", Status: "todo", CreatedAt: &now, Start: &past, Due: &soon},
+		{ID: "t1", Type: "task", Title: "Late task", Status: "todo", Parent: "m-undated", Due: &past},
+		{ID: "t2", Type: "task", Title: "Done task", Status: "completed", Parent: "m-soon", Due: &past},
+		{ID: "t3", Type: "task", Title: "Task", Status: "todo", Parent: "m-soon"},
+		{ID: "t4", Type: "task", Title: "Task", Status: "todo", Parent: "m-later"},
+	}
+
+	result := buildRoadmap(beans, false, nil, nil)
+
+	// Dated milestones come first, earliest due date first
+	var order []string
+	for _, m := range result.Milestones {
+		order = append(order, m.Milestone.ID)
+	}
+	if want := []string{"m-soon", "m-later", "m-undated"}; !slices.Equal(order, want) {
+		t.Errorf("milestone order = %v, want %v", order, want)
+	}
+
+	// Only open beans past their due date are overdue
+	if len(result.Overdue) != 1 || result.Overdue[0].ID != "t1" {
+		t.Errorf("Overdue = %v, want [t1]", result.Overdue)
+	}
+
+	md := renderRoadmapMarkdown(result, false, "")
+	for _, want := range []string{
+		"## Overdue",
+		"Late task (t1) — due " + past.String() + " **(overdue)**",
+		"📅 " + past.String() + " → " + soon.String(),
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("roadmap missing %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestRoadmapProgress(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	now := time.Now()
+	beans := []*bean.Bean{
+		{ID: "m1", Type: "milestone", Title: "v1.0", Status: "todo", CreatedAt: &now},
+		{ID: "e1", Type: "epic", Title: "Auth", Status: "todo", Parent: "m1", CreatedAt: &now},
+		{ID: "t1", Type: "task", Title: "Login", Status: "todo", Parent: "e1", CreatedAt: &now},
+	}
+	result := buildRoadmap(beans, false, nil, nil)
+	attachProgress(result, map[string]beancore.Progress{
+		"m1": {TotalChildren: 4, CompletedChildren: 1, TotalEstimate: 8, RemainingEstimate: 6, Progress: 0.25},
+		"e1": {TotalChildren: 2, CompletedChildren: 1, Progress: 0.5},
+		"t1": {},
+	})
+
+	if result.Milestones[0].Progress == nil || result.Milestones[0].Epics[0].Progress == nil {
+		t.Fatal("expected progress on milestone and epic")
+	}
+
+	md := renderRoadmapMarkdown(result, false, "")
+	for _, want := range []string{
+		"`███░░░░░░░` 25% · 1/4 done, 6 remaining",
+		"`█████░░░░░` 50% · 1/2 done\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("roadmap missing %q:\n%s", want, md)
+		}
+	}
+}
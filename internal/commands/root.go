@@ -24,8 +24,10 @@ func NewRootCmd() *cobra.Command {
 Track your work alongside your code and supercharge your coding agent with
 a full view of your project.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			// Skip core initialization for init, prime, and version commands
-			if cmd.Name() == "init" || cmd.Name() == "prime" || cmd.Name() == "version" {
+			// Skip core initialization for init, prime, version, merge-driver, and
+			// serve token commands (tokens are per user, not per project)
+			if cmd.Name() == "init" || cmd.Name() == "prime" || cmd.Name() == "version" || cmd.Name() == "merge-driver" ||
+				(cmd.HasParent() && cmd.Parent().Name() == "token") {
 				return nil
 			}
 
@@ -58,6 +60,15 @@ a full view of your project.`,
 				return fmt.Errorf("loading beans: %w", err)
 			}
 
+			// beans check reports load errors in detail
+			if n := len(core.LoadErrors()); n > 0 && cmd.Name() != "check" {
+				fmt.Fprintf(os.Stderr, "warning: %d bean file(s) could not be loaded and were skipped (run 'beans check' for details)\n", n)
+			}
+
+			if len(core.PendingMigrations()) > 0 && cmd.Name() != "migrate" {
+				fmt.Fprintf(os.Stderr, "warning: beans data uses format version %d, current is %d (run 'beans migrate' to upgrade)\n", core.FormatVersion(), config.FormatVersion)
+			}
+
 			return nil
 		},
 	}
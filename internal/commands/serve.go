@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -20,12 +21,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/hmans/beans/internal/agent"
+	"github.com/hmans/beans/internal/auth"
 	"github.com/hmans/beans/internal/gitutil"
 	"github.com/hmans/beans/internal/cors"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/portalloc"
 	"github.com/hmans/beans/internal/terminal"
 	"github.com/hmans/beans/internal/web"
+	"github.com/hmans/beans/internal/webhook"
 	"github.com/hmans/beans/internal/worktree"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/config"
@@ -84,6 +87,13 @@ func runServer(port int, origins []string) error {
 	// Set up origin checker for CORS and WebSocket
 	checker := cors.NewChecker(origins)
 
+	// Token authentication (disabled until a token is created)
+	tokenPath, err := auth.DefaultPath()
+	if err != nil {
+		return err
+	}
+	tokens := auth.NewStore(tokenPath)
+
 	// Set Gin to release mode for cleaner output
 	gin.SetMode(gin.ReleaseMode)
 
@@ -104,13 +114,14 @@ func runServer(port int, origins []string) error {
 			}
 		}
 		c.Header("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
 		}
 		c.Next()
 	})
+	router.Use(authenticate(tokens))
 	// Resolve worktree root directory (default: ~/.beans/worktrees/<project>/)
 	projectName := cfg.GetProjectName()
 	if projectName == "" {
@@ -260,6 +271,13 @@ func runServer(port int, origins []string) error {
 		}
 	})
 
+	// Assign beans to the agent when a workspace agent starts working on them.
+	// Beans the agent picks up later in the session are assigned when its turn
+	// completes (see below).
+	agentMgr.SetOnSessionStart(func(beanID string) {
+		assignAgentToWorkspaceBeans(wtManager, beanID)
+	})
+
 	// Update workspace activity timestamp when an agent completes a turn,
 	// so the sidebar sorts workspaces by most recently active.
 	agentMgr.SetOnTurnComplete(func(beanID string) {
@@ -269,6 +287,7 @@ func runServer(port int, origins []string) error {
 		if err := wtManager.TouchLastActive(beanID); err != nil {
 			log.Printf("[beans] failed to update last_active_at for %s: %v", beanID, err)
 		}
+		assignAgentToWorkspaceBeans(wtManager, beanID)
 	})
 
 	// When bean files change in a worktree, also notify the worktree manager
@@ -339,6 +358,18 @@ func runServer(port int, origins []string) error {
 		return strings.Join(lines, "\n")
 	})
 
+	// Deliver bean events to the webhooks declared in .beans.yml
+	webhooks, err := webhook.New(core, cfg.Webhooks)
+	if err != nil {
+		return err
+	}
+	webhookCtx, stopWebhooks := context.WithCancel(context.Background())
+	defer stopWebhooks()
+	webhooks.Start(webhookCtx)
+	if len(cfg.Webhooks) > 0 {
+		fmt.Printf("[beans] delivering bean events to %d webhook(s)\n", len(cfg.Webhooks))
+	}
+
 	// Create GraphQL server with explicit transports
 	es := graph.NewExecutableSchema(graph.Config{
 		Resolvers: &graph.Resolver{
@@ -347,6 +378,7 @@ func runServer(port int, origins []string) error {
 			AgentMgr:     agentMgr,
 			TerminalMgr:  termMgr,
 			PortAlloc:    portAlloc,
+			Webhooks:     webhooks,
 			Forge:        forgeProvider,
 			ProjectRoot:  projectRoot,
 		},
@@ -360,19 +392,22 @@ func runServer(port int, origins []string) error {
 			CheckOrigin:  checker.CheckOriginFunc(),
 			Subprotocols: []string{"graphql-transport-ws"},
 		},
+		InitFunc: graphqlWebsocketInit(tokens),
 	})
 	gqlHandler.AddTransport(transport.Options{})
 	gqlHandler.AddTransport(transport.GET{})
 	gqlHandler.AddTransport(transport.POST{})
+	gqlHandler.AroundOperations(checkOperationScope)
+	gqlHandler.AroundResponses(shareProgress)
 
 	// GraphQL API endpoint (handle all methods for WebSocket upgrade)
-	router.Any("/api/graphql", gin.WrapH(gqlHandler))
+	router.Any("/api/graphql", requireGraphQLScope(auth.ScopeRead), gin.WrapH(gqlHandler))
 
 	// GraphQL Playground
 	router.GET("/playground", gin.WrapH(playground.Handler("Beans GraphQL", "/api/graphql")))
 
 	// Serve agent chat image attachments
-	router.GET("/api/attachments/:beanId/:filename", func(c *gin.Context) {
+	router.GET("/api/attachments/:beanId/:filename", requireScope(auth.ScopeRead), func(c *gin.Context) {
 		beanID := c.Param("beanId")
 		filename := c.Param("filename")
 		path, err := agentMgr.AttachmentPath(beanID, filename)
@@ -390,7 +425,7 @@ func runServer(port int, origins []string) error {
 	})
 
 	// Terminal WebSocket endpoint
-	RegisterTerminalRoute(router, termMgr, wtManager, checker.CheckOriginFunc(), filepath.Dir(core.Root()))
+	RegisterTerminalRoute(router, termMgr, wtManager, checker.CheckOriginFunc(), filepath.Dir(core.Root()), requireScope(auth.ScopeAdmin))
 
 	// Serve the embedded frontend SPA
 	router.NoRoute(gin.WrapH(web.Handler()))
@@ -417,6 +452,11 @@ func runServer(port int, origins []string) error {
 		fmt.Printf("[beans] Starting server at http://localhost:%d/\n", port)
 		fmt.Printf("[beans] GraphQL Playground: http://localhost:%d/playground\n", port)
 		fmt.Printf("[beans] Allowed origins: %s\n", strings.Join(origins, ", "))
+		if tokens.Enabled() {
+			fmt.Printf("[beans] Authentication: required (tokens in %s)\n", tokenPath)
+		} else {
+			fmt.Printf("[beans] Authentication: disabled (create a token with 'beans-serve serve token create' to require one)\n")
+		}
 		serverErr <- server.ListenAndServe()
 	}()
 
@@ -453,9 +493,44 @@ func runServer(port int, origins []string) error {
 	return nil
 }
 
+// assignAgentToWorkspaceBeans assigns the configured agent identity to the
+// beans a workspace agent is working on: the bean whose ID names the workspace
+// (if any) and the beans linked to its worktree. No-op for the central agent
+// or when agent.auto_assign is disabled.
+func assignAgentToWorkspaceBeans(wtManager *worktree.Manager, beanID string) {
+	if beanID == graph.CentralSessionID || !cfg.IsAgentAutoAssignEnabled() {
+		return
+	}
+
+	var ids []string
+	if _, err := core.Get(beanID); err == nil {
+		ids = append(ids, beanID)
+	}
+	if wtManager != nil {
+		if wtPath := wtManager.WorktreePath(beanID); wtPath != "" {
+			for _, id := range core.BeansForWorktree(wtPath) {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	assignee := cfg.GetAgentAssignee()
+	for _, id := range ids {
+		assigned, err := core.Assign(id, assignee)
+		if err != nil {
+			log.Printf("[beans] failed to assign %s to %s: %v", id, assignee, err)
+		} else if assigned {
+			log.Printf("[beans] assigned %s to %s", id, assignee)
+		}
+	}
+}
+
 func RegisterServeCmd(root *cobra.Command) {
 	serveCmd.Flags().IntVarP(&servePort, "port", "p", config.DefaultServerPort, "Port to listen on")
 	serveCmd.Flags().StringSliceVar(&corsOrigins, "cors-origin", cors.DefaultOrigins, "Allowed CORS origins (use * to allow all)")
+	registerTokenCmd(serveCmd)
 	root.AddCommand(serveCmd)
 }
 
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/hmans/beans/internal/auth"
+)
+
+// authenticate resolves the request's token into a scope on the request
+// context; requireScope then decides what the request may do. Requests with
+// an invalid token are rejected; requests without one continue without a
+// scope. While no tokens exist, every request gets admin scope.
+//
+// A token passed as ?token= is also stored in a cookie, so that opening the
+// web UI with the token in the URL authenticates its own requests.
+func authenticate(tokens *auth.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if !tokens.Enabled() {
+			c.Request = c.Request.WithContext(auth.WithScope(ctx, auth.ScopeAdmin))
+			c.Next()
+			return
+		}
+
+		secret, fromQuery := auth.TokenFromRequest(c.Request)
+		if secret == "" {
+			c.Next()
+			return
+		}
+		token, ok := tokens.Verify(secret)
+		if !ok {
+			c.Header("WWW-Authenticate", "Bearer")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+			return
+		}
+		if fromQuery {
+			http.SetCookie(c.Writer, &http.Cookie{
+				Name:     auth.CookieName,
+				Value:    secret,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+		}
+		c.Request = c.Request.WithContext(auth.WithScope(ctx, token.Scope))
+		c.Next()
+	}
+}
+
+// requireScope rejects requests without a token of at least the given scope.
+func requireScope(required auth.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope, ok := auth.ScopeFrom(c.Request.Context())
+		switch {
+		case !ok:
+			c.Header("WWW-Authenticate", "Bearer")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		case !scope.Allows(required):
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "token scope " + string(scope) + " does not allow this (requires " + string(required) + ")"})
+		default:
+			c.Next()
+		}
+	}
+}
+
+// requireGraphQLScope is requireScope for the GraphQL endpoint. WebSocket
+// clients that can't send headers may authenticate in the connection_init
+// payload instead (see graphqlWebsocketInit), so upgrades pass through.
+func requireGraphQLScope(required auth.Scope) gin.HandlerFunc {
+	check := requireScope(required)
+	return func(c *gin.Context) {
+		if websocket.IsWebSocketUpgrade(c.Request) {
+			c.Next()
+			return
+		}
+		check(c)
+	}
+}
+
+// graphqlWebsocketInit authenticates GraphQL WebSocket connections that
+// weren't authenticated by their upgrade request, using the "authorization"
+// (Bearer token) or "token" field of the connection_init payload.
+func graphqlWebsocketInit(tokens *auth.Store) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		if _, ok := auth.ScopeFrom(ctx); ok {
+			return ctx, nil, nil
+		}
+		secret := strings.TrimSpace(strings.TrimPrefix(payload.Authorization(), "Bearer "))
+		if secret == "" {
+			secret = payload.GetString("token")
+		}
+		token, ok := tokens.Verify(secret)
+		if !ok {
+			return ctx, nil, errors.New("authentication required")
+		}
+		return auth.WithScope(ctx, token.Scope), nil, nil
+	}
+}
+
+// checkOperationScope rejects GraphQL operations the request's token doesn't
+// allow: mutations need write scope, and mutations that run commands on the
+// host or control agents need admin scope.
+func checkOperationScope(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	var fields []string
+	if oc.Operation != nil {
+		for _, f := range graphql.CollectFields(oc, oc.Operation.SelectionSet, nil) {
+			fields = append(fields, f.Name)
+		}
+	}
+	operation := "query"
+	if oc.Operation != nil {
+		operation = string(oc.Operation.Operation)
+	}
+
+	required := auth.RequiredScope(operation, fields)
+	if scope, ok := auth.ScopeFrom(ctx); !ok || !scope.Allows(required) {
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "not authorized: %s requires a token with %s scope", operation, required))
+	}
+	return next(ctx)
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+
+	"github.com/hmans/beans/internal/auth"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/pkg/beangraph"
+)
+
+func TestServeAuth(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	createQueryTestBean(t, testCore, "test-1", "First Bean", "todo")
+
+	tokens := auth.NewStore(filepath.Join(t.TempDir(), "tokens.yml"))
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(authenticate(tokens))
+	gqlHandler := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers: &graph.Resolver{CoreResolver: &beangraph.CoreResolver{Core: testCore}},
+	}))
+	gqlHandler.AddTransport(transport.POST{})
+	gqlHandler.AroundOperations(checkOperationScope)
+	router.POST("/api/graphql", requireGraphQLScope(auth.ScopeRead), gin.WrapH(gqlHandler))
+	router.GET("/api/terminal", requireScope(auth.ScopeAdmin), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method, path, body, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	const query = `{"query": "{ beans { id } }"}`
+	const mutation = `{"query": "mutation { addComment(id: \"test-1\", body: \"hi\") { id } }"}`
+	const adminMutation = `{"query": "mutation { writeTerminalInput(sessionId: \"x\", data: \"ls\\n\") }"}`
+
+	t.Run("open without tokens", func(t *testing.T) {
+		if w := do("POST", "/api/graphql", mutation, ""); w.Code != http.StatusOK || strings.Contains(w.Body.String(), "not authorized") {
+			t.Errorf("mutation without tokens = %d %s", w.Code, w.Body)
+		}
+		if w := do("GET", "/api/terminal", "", ""); w.Code != http.StatusOK {
+			t.Errorf("terminal without tokens = %d", w.Code)
+		}
+	})
+
+	readToken, _, err := tokens.Create("reader", auth.ScopeRead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeToken, _, _ := tokens.Create("writer", auth.ScopeWrite)
+
+	t.Run("token required", func(t *testing.T) {
+		if w := do("POST", "/api/graphql", query, ""); w.Code != http.StatusUnauthorized {
+			t.Errorf("query without token = %d, want 401", w.Code)
+		}
+		if w := do("POST", "/api/graphql", query, "beans_wrong"); w.Code != http.StatusUnauthorized {
+			t.Errorf("query with wrong token = %d, want 401", w.Code)
+		}
+	})
+
+	t.Run("read scope", func(t *testing.T) {
+		if w := do("POST", "/api/graphql", query, readToken); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "test-1") {
+			t.Errorf("query = %d %s", w.Code, w.Body)
+		}
+		if w := do("POST", "/api/graphql", mutation, readToken); !strings.Contains(w.Body.String(), "requires a token with write scope") {
+			t.Errorf("mutation with read token = %d %s", w.Code, w.Body)
+		}
+		if w := do("GET", "/api/terminal", "", readToken); w.Code != http.StatusForbidden {
+			t.Errorf("terminal with read token = %d, want 403", w.Code)
+		}
+	})
+
+	t.Run("write scope", func(t *testing.T) {
+		if w := do("POST", "/api/graphql", mutation, writeToken); strings.Contains(w.Body.String(), "not authorized") {
+			t.Errorf("mutation with write token = %d %s", w.Code, w.Body)
+		}
+		if w := do("POST", "/api/graphql", adminMutation, writeToken); !strings.Contains(w.Body.String(), "requires a token with admin scope") {
+			t.Errorf("admin mutation with write token = %d %s", w.Code, w.Body)
+		}
+	})
+
+	t.Run("query token sets cookie", func(t *testing.T) {
+		w := do("POST", "/api/graphql?token="+readToken, query, "")
+		if w.Code != http.StatusOK || !strings.Contains(w.Header().Get("Set-Cookie"), auth.CookieName+"=") {
+			t.Errorf("query token = %d, Set-Cookie %q", w.Code, w.Header().Get("Set-Cookie"))
+		}
+	})
+}
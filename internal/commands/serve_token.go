@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hmans/beans/internal/auth"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+)
+
+var (
+	tokenScope string
+	tokenName  string
+	tokenJSON  bool
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage access tokens for the server",
+	Long: `Manages the tokens that clients use to access the server.
+
+While no tokens exist, the server accepts every request. Once a token is
+created, every API request needs one, sent as an "Authorization: Bearer <token>"
+header, in the connection_init payload of GraphQL WebSocket connections, or as
+a ?token= query parameter (which also signs the web UI in).
+
+Token scopes:
+  read   queries and subscriptions
+  write  also mutations that change beans
+  admin  also terminals, run scripts, worktrees, and agent control
+
+Tokens are stored hashed in ~/.beans/tokens.yml (or $BEANS_TOKENS_FILE) and
+apply to every project you serve.`,
+}
+
+// tokenStore returns the store for the user's token file.
+func tokenStore() (*auth.Store, error) {
+	path, err := auth.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewStore(path), nil
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an access token",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		scope, err := auth.ParseScope(tokenScope)
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrValidation, "%s", err)
+		}
+		store, err := tokenStore()
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrFileError, "%s", err)
+		}
+		secret, token, err := store.Create(tokenName, scope)
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrFileError, "failed to create token: %s", err)
+		}
+
+		if tokenJSON {
+			return encodeJSON(cmd, map[string]any{
+				"id":    token.ID,
+				"name":  token.Name,
+				"scope": token.Scope,
+				"token": secret,
+			})
+		}
+		fmt.Println(ui.Success.Render("Created "+string(token.Scope)+" token ") + ui.ID.Render(token.ID))
+		fmt.Println(secret)
+		fmt.Println(ui.Muted.Render("Store it now; it can't be shown again."))
+		return nil
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List access tokens",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := tokenStore()
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrFileError, "%s", err)
+		}
+		tokens, err := store.List()
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrFileError, "failed to read tokens: %s", err)
+		}
+
+		if tokenJSON {
+			type tokenInfo struct {
+				ID        string     `json:"id"`
+				Name      string     `json:"name,omitempty"`
+				Scope     auth.Scope `json:"scope"`
+				CreatedAt string     `json:"createdAt"`
+			}
+			infos := make([]tokenInfo, len(tokens))
+			for i, t := range tokens {
+				infos[i] = tokenInfo{t.ID, t.Name, t.Scope, t.CreatedAt.Format(time.RFC3339)}
+			}
+			return encodeJSON(cmd, infos)
+		}
+
+		if len(tokens) == 0 {
+			fmt.Println(ui.Muted.Render("No tokens; the server doesn't require authentication."))
+			return nil
+		}
+		for _, t := range tokens {
+			line := fmt.Sprintf("%s  %-5s  %s", ui.ID.Render(t.ID), t.Scope, ui.Muted.Render("created "+t.CreatedAt.Local().Format("2006-01-02 15:04")))
+			if t.Name != "" {
+				line += "  " + t.Name
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke an access token",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := tokenStore()
+		if err != nil {
+			return cmdError(tokenJSON, output.ErrFileError, "%s", err)
+		}
+		if err := store.Revoke(args[0]); err != nil {
+			if errors.Is(err, auth.ErrNotFound) {
+				return cmdError(tokenJSON, output.ErrNotFound, "token not found: %s", args[0])
+			}
+			return cmdError(tokenJSON, output.ErrFileError, "failed to revoke token: %s", err)
+		}
+
+		if tokenJSON {
+			return output.SuccessMessage("Revoked token " + args[0])
+		}
+		fmt.Println(ui.Success.Render("Revoked token ") + ui.ID.Render(args[0]))
+		return nil
+	},
+}
+
+// encodeJSON writes v as indented JSON to the command's output.
+func encodeJSON(cmd *cobra.Command, v any) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func registerTokenCmd(parent *cobra.Command) {
+	tokenCreateCmd.Flags().StringVar(&tokenScope, "scope", "", "Token scope: read, write, or admin (required)")
+	tokenCreateCmd.Flags().StringVar(&tokenName, "name", "", "Name to recognize the token by (e.g. the client using it)")
+	_ = tokenCreateCmd.MarkFlagRequired("scope")
+	for _, c := range []*cobra.Command{tokenCreateCmd, tokenListCmd, tokenRevokeCmd} {
+		c.Flags().BoolVar(&tokenJSON, "json", false, "Output as JSON")
+		tokenCmd.AddCommand(c)
+	}
+	parent.AddCommand(tokenCmd)
+}
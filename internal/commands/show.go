@@ -3,11 +3,13 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/internal/output"
 	"github.com/hmans/beans/internal/ui"
@@ -159,13 +161,34 @@ func showStyledBean(b *bean.Bean) {
 	}
 	header.WriteString("\n")
 	header.WriteString(ui.Title.Render(b.Title))
+	if len(b.Assignees) > 0 {
+		header.WriteString("\n")
+		header.WriteString(ui.Muted.Render("Assigned to: ") + strings.Join(b.Assignees, ", "))
+	}
+	if b.Start != nil || b.Due != nil {
+		header.WriteString("\n")
+		header.WriteString(formatDates(b))
+	}
+	if progress := formatProgress(b); progress != "" {
+		header.WriteString("\n")
+		header.WriteString(progress)
+	}
+
+	// Display custom fields
+	if len(b.Fields) > 0 {
+		header.WriteString("\n")
+		header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
+		header.WriteString("\n")
+		header.WriteString(formatFields(b))
+	}
 
 	// Display relationships
-	if b.Parent != "" || len(b.Blocking) > 0 {
+	links := core.Links(b.ID)
+	if b.Parent != "" || len(b.Blocking) > 0 || len(links) > 0 {
 		header.WriteString("\n")
 		header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
 		header.WriteString("\n")
-		header.WriteString(formatRelationships(b))
+		header.WriteString(formatRelationships(b, links))
 	}
 
 	header.WriteString("\n")
@@ -177,17 +200,21 @@ func showStyledBean(b *bean.Bean) {
 
 	fmt.Println(headerBox)
 
+	if b.Body == "" && len(b.Comments) == 0 {
+		return
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(80),
+	)
+	if err != nil {
+		fmt.Printf("failed to create renderer: %v\n", err)
+		return
+	}
+
 	// Render the body with Glamour
 	if b.Body != "" {
-		renderer, err := glamour.NewTermRenderer(
-			glamour.WithAutoStyle(),
-			glamour.WithWordWrap(80),
-		)
-		if err != nil {
-			fmt.Printf("failed to create renderer: %v\n", err)
-			return
-		}
-
 		rendered, err := renderer.Render(b.Body)
 		if err != nil {
 			fmt.Printf("failed to render markdown: %v\n", err)
@@ -196,10 +223,92 @@ func showStyledBean(b *bean.Bean) {
 
 		fmt.Print(rendered)
 	}
+
+	// Render the comment thread
+	if len(b.Comments) > 0 {
+		fmt.Println(ui.Muted.Render(strings.Repeat("─", 50)))
+		fmt.Println(ui.Bold.Render(fmt.Sprintf("Comments (%d)", len(b.Comments))))
+		for _, c := range b.Comments {
+			fmt.Println()
+			fmt.Println(formatCommentHeader(c))
+			rendered, err := renderer.Render(c.Body)
+			if err != nil {
+				fmt.Println(c.Body)
+				continue
+			}
+			fmt.Print(rendered)
+		}
+	}
 }
 
-// formatRelationships formats parent and blocks for display.
-func formatRelationships(b *bean.Bean) string {
+// formatDates renders a bean's start and due dates, flagging overdue beans,
+// and its recurrence rule.
+func formatDates(b *bean.Bean) string {
+	var parts []string
+	if b.Start != nil {
+		parts = append(parts, ui.Muted.Render("Start: ")+b.Start.String())
+	}
+	if b.Due != nil {
+		due := ui.Muted.Render("Due: ") + b.Due.String()
+		if core.IsOverdue(b, bean.Today()) {
+			due += " " + ui.Danger.Render("(overdue)")
+		}
+		parts = append(parts, due)
+	}
+	if b.Recur != "" {
+		parts = append(parts, ui.Muted.Render("Recurs: ")+b.Recur)
+	}
+	if b.Previous != "" {
+		parts = append(parts, ui.Muted.Render("Previous: ")+ui.ID.Render(b.Previous))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// formatProgress renders a bean's estimate and the progress of its children.
+// Returns an empty string if the bean has neither.
+func formatProgress(b *bean.Bean) string {
+	p, err := core.Progress(b.ID)
+	if err != nil {
+		return ""
+	}
+
+	var parts []string
+	if b.Estimate > 0 {
+		parts = append(parts, ui.Muted.Render("Estimate: ")+strconv.FormatFloat(b.Estimate, 'f', -1, 64))
+	}
+	if p.TotalChildren > 0 {
+		line := ui.Muted.Render("Progress: ") + ui.RenderProgressBar(p.Progress, 20) +
+			ui.Muted.Render(fmt.Sprintf("  %d/%d done", p.CompletedChildren, p.TotalChildren))
+		if p.TotalEstimate > 0 {
+			line += ui.Muted.Render(", " + strconv.FormatFloat(p.RemainingEstimate, 'f', -1, 64) + " remaining")
+		}
+		parts = append(parts, line)
+	}
+	return strings.Join(parts, "  ")
+}
+
+// formatCommentHeader formats a comment's author and timestamp for display.
+func formatCommentHeader(c bean.Comment) string {
+	author := c.Author
+	if author == "" {
+		author = "unknown"
+	}
+	return ui.Primary.Render(author) + " " + ui.Muted.Render(c.CreatedAt.Format("2006-01-02 15:04 UTC"))
+}
+
+// formatFields formats custom field values for display.
+func formatFields(b *bean.Bean) string {
+	var parts []string
+	for _, name := range b.FieldNames() {
+		parts = append(parts, fmt.Sprintf("%s %s",
+			ui.Muted.Render(name+":"),
+			b.Fields[name]))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// formatRelationships formats parent, blocks, and links for display.
+func formatRelationships(b *bean.Bean, links []beancore.Link) string {
 	var parts []string
 
 	// Display parent
@@ -215,6 +324,14 @@ func formatRelationships(b *bean.Bean) string {
 			ui.Muted.Render("blocking:"),
 			ui.ID.Render(target)))
 	}
+
+	// Display links (related, duplicates, ...)
+	for _, l := range links {
+		parts = append(parts, fmt.Sprintf("%s %s %s",
+			ui.Muted.Render(l.Type+":"),
+			ui.ID.Render(l.Bean.ID),
+			l.Bean.Title))
+	}
 	return strings.Join(parts, "\n")
 }
 
@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsFilter beanFilterFlags
+	statsBy     []string
+	statsJSON   bool
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Count beans, grouped by status, type, priority, tag, or parent",
+	Long: `Counts the beans matching the filter flags of 'beans list' and sums their
+estimates, grouped by one or more of status, type, priority, tag, and parent.
+Beans with several tags count toward each of them.
+
+  beans stats
+  beans stats --by type,status --no-status completed --no-status scrapped
+  beans stats --by tag --parent epic-x1y2 --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var groupBy []model.BeanStatsGroupBy
+		for _, by := range statsBy {
+			g := model.BeanStatsGroupBy(strings.ToUpper(strings.TrimSpace(by)))
+			if !g.IsValid() {
+				return cmdError(statsJSON, output.ErrValidation, "invalid --by %q (must be status, type, priority, tag, or parent)", by)
+			}
+			groupBy = append(groupBy, g)
+		}
+
+		filter := &model.BeanFilter{}
+		if err := statsFilter.apply(filter); err != nil {
+			return cmdError(statsJSON, output.ErrValidation, "%s", err)
+		}
+
+		resolver := &beangraph.CoreResolver{Core: core}
+		stats, err := resolver.BeanStats(context.Background(), filter, groupBy)
+		if err != nil {
+			return cmdError(statsJSON, output.ErrValidation, "%s", err)
+		}
+
+		if statsJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(stats)
+		}
+
+		fmt.Print(formatStatsTable(stats, groupBy))
+		return nil
+	},
+}
+
+// formatStatsTable renders bean stats as a table with a column per groupBy
+// field, a count column, an estimate column if any bean is estimated, and a
+// total row.
+func formatStatsTable(stats *model.BeanStats, groupBy []model.BeanStatsGroupBy) string {
+	header := make([]string, 0, len(groupBy)+2)
+	for _, by := range groupBy {
+		header = append(header, strings.ToLower(string(by)))
+	}
+	header = append(header, "count")
+	if stats.Estimate != nil {
+		header = append(header, "estimate")
+	}
+
+	row := func(values []string, count int, estimate *float64) []string {
+		cells := append(values, strconv.Itoa(count))
+		if stats.Estimate != nil {
+			e := ""
+			if estimate != nil {
+				e = strconv.FormatFloat(*estimate, 'f', -1, 64)
+			}
+			cells = append(cells, e)
+		}
+		return cells
+	}
+	var rows [][]string
+	for _, g := range stats.Groups {
+		var values []string
+		for _, by := range groupBy {
+			values = append(values, statsGroupValue(g, by))
+		}
+		rows = append(rows, row(values, g.Count, g.Estimate))
+	}
+	totalLabels := make([]string, len(groupBy))
+	if len(groupBy) > 0 {
+		totalLabels[0] = "total"
+	}
+	total := row(totalLabels, stats.Count, stats.Estimate)
+
+	widths := make([]int, len(header))
+	for _, cells := range append(append([][]string{header}, rows...), total) {
+		for i, c := range cells {
+			widths[i] = max(widths[i], len(c))
+		}
+	}
+	format := func(cells []string) string {
+		parts := make([]string, len(cells))
+		for i, c := range cells {
+			// Right-align the numeric columns
+			if i >= len(groupBy) {
+				parts[i] = fmt.Sprintf("%*s", widths[i], c)
+			} else {
+				parts[i] = fmt.Sprintf("%-*s", widths[i], c)
+			}
+		}
+		return strings.TrimRight(strings.Join(parts, "  "), " ")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ui.Muted.Render(format(header)) + "\n")
+	for _, cells := range rows {
+		sb.WriteString(format(cells) + "\n")
+	}
+	if len(rows) > 0 {
+		sb.WriteString(ui.Bold.Render(format(total)) + "\n")
+	} else {
+		sb.WriteString(format(total) + "\n")
+	}
+	return sb.String()
+}
+
+// statsGroupValue returns a group's value for a groupBy field, or "(none)".
+func statsGroupValue(g *model.BeanStatsGroup, by model.BeanStatsGroupBy) string {
+	var value *string
+	switch by {
+	case model.BeanStatsGroupByStatus:
+		value = g.Status
+	case model.BeanStatsGroupByType:
+		value = g.Type
+	case model.BeanStatsGroupByPriority:
+		value = g.Priority
+	case model.BeanStatsGroupByTag:
+		value = g.Tag
+	case model.BeanStatsGroupByParent:
+		value = g.Parent
+	}
+	if value == nil {
+		return "(none)"
+	}
+	return *value
+}
+
+func RegisterStatsCmd(root *cobra.Command) {
+	statsFilter.register(statsCmd.Flags())
+	statsCmd.Flags().StringSliceVar(&statsBy, "by", []string{"status"}, "Group by status, type, priority, tag, or parent (comma-separated or repeated; empty for totals only)")
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	root.AddCommand(statsCmd)
+}
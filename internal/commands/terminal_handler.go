@@ -186,13 +186,15 @@ func resolveTerminalWorkDir(sessionID string, wtMgr *worktree.Manager, projectRo
 	return "", fmt.Errorf("unknown session: %s", sessionID)
 }
 
-// RegisterTerminalRoute adds the /api/terminal WebSocket endpoint to the Gin router.
-func RegisterTerminalRoute(router *gin.Engine, termMgr *terminal.Manager, wtMgr *worktree.Manager, checkOrigin func(r *http.Request) bool, projectRoot string) {
+// RegisterTerminalRoute adds the /api/terminal WebSocket endpoint to the Gin router,
+// behind the given middleware (e.g. authentication).
+func RegisterTerminalRoute(router *gin.Engine, termMgr *terminal.Manager, wtMgr *worktree.Manager, checkOrigin func(r *http.Request) bool, projectRoot string, middleware ...gin.HandlerFunc) {
 	upgrader := websocket.Upgrader{
 		CheckOrigin: checkOrigin,
 	}
 
-	router.GET("/api/terminal", func(c *gin.Context) {
+	handlers := append(middleware, func(c *gin.Context) {
 		handleTerminalWS(c, termMgr, wtMgr, upgrader, projectRoot)
 	})
+	router.GET("/api/terminal", handlers...)
 }
@@ -32,19 +32,54 @@ var (
 	updateRemoveBlocking  []string
 	updateBlockedBy       []string
 	updateRemoveBlockedBy []string
+	updateLink            []string
+	updateRemoveLink      []string
 	updateTag             []string
 	updateRemoveTag       []string
+	updateAssignee        []string
+	updateRemoveAssignee  []string
+	updateMe              bool
+	updateStart           string
+	updateRemoveStart     bool
+	updateDue             string
+	updateRemoveDue       bool
+	updateEstimate        float64
+	updateRemoveEstimate  bool
+	updateRecur           string
+	updateRemoveRecur     bool
+	updateField           []string
+	updateRemoveField     []string
 	updateIfMatch         string
+	updateWhere           string
+	updateDryRun          bool
 	updateJSON            bool
 )
 
 var updateCmd = &cobra.Command{
-	Use:     "update <id>",
+	Use:     "update <id> [id...]",
 	Aliases: []string{"u"},
 	Short:   "Update a bean's properties",
-	Long:    `Updates one or more properties of an existing bean.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Updates one or more properties of an existing bean.
+
+Bulk Updates:
+  Pass several IDs, or select beans with --where and the filter flags of
+  'beans list'. The beans are updated together: if one fails validation or
+  can't be written, none is updated. Use --dry-run to preview the changes.
+
+  beans update a1b2 c3d4 --tag backend
+  beans update --where '--status todo --tag backend' --priority high
+  beans update --where '--parent epic-x1y2' --status completed --dry-run`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && updateWhere == "" {
+			return fmt.Errorf("requires a bean ID or --where")
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 || updateWhere != "" || updateDryRun {
+			return runBulkUpdate(cmd, args)
+		}
+
 		ctx := context.Background()
 		resolver := &beangraph.CoreResolver{Core: core}
 
@@ -92,6 +127,8 @@ var updateCmd = &cobra.Command{
 
 		// Apply all updates atomically via single UpdateBean mutation
 		// This includes field updates, body modifications, and relationship changes
+		// Completing a recurring bean creates its next occurrence
+		hadNext := core.NextOccurrence(b.ID)
 		if hasFieldUpdates(input) {
 			b, err = resolver.UpdateBean(ctx, b.ID, input)
 			if err != nil {
@@ -101,8 +138,7 @@ var updateCmd = &cobra.Command{
 
 		// Require at least one change
 		if len(changes) == 0 {
-			return cmdError(updateJSON, output.ErrValidation,
-				"no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --tag, or their --remove-* variants)")
+			return cmdError(updateJSON, output.ErrValidation, noChangesMessage)
 		}
 
 		// Output result
@@ -119,10 +155,99 @@ var updateCmd = &cobra.Command{
 		} else {
 			fmt.Println(ui.Success.Render("Updated ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
 		}
+		if next := core.NextOccurrence(b.ID); next != nil && hadNext == nil {
+			fmt.Println(ui.Success.Render("Created next occurrence ") + ui.ID.Render(next.ID) + " " + ui.Muted.Render(next.Path))
+		}
 		return nil
 	},
 }
 
+// noChangesMessage is the error shown when update is called without any field flags.
+const noChangesMessage = "no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --link, --tag, --assignee, --me, --start, --due, --estimate, --recur, --field, or their --remove-* variants)"
+
+// runBulkUpdate applies the update flags to several beans, selected by IDs
+// and/or --where, via the updateBeans mutation.
+func runBulkUpdate(cmd *cobra.Command, ids []string) error {
+	if updateIfMatch != "" {
+		return cmdError(updateJSON, output.ErrValidation, "--if-match can only be used when updating a single bean")
+	}
+
+	var filter *model.BeanFilter
+	if updateWhere != "" {
+		var err error
+		filter, err = parseWhere(updateWhere)
+		if err != nil {
+			return cmdError(updateJSON, output.ErrValidation, "%s", err)
+		}
+	}
+
+	input, changes, err := buildUpdateInput(cmd, nil, "")
+	if err != nil {
+		return cmdError(updateJSON, output.ErrValidation, "%s", err)
+	}
+	if len(changes) == 0 {
+		return cmdError(updateJSON, output.ErrValidation, noChangesMessage)
+	}
+
+	resolver := &beangraph.CoreResolver{Core: core}
+	results, err := resolver.UpdateBeans(context.Background(), ids, filter, input, updateDryRun)
+	if err != nil {
+		return mutationError(updateJSON, err)
+	}
+
+	var failed, changed int
+	for _, r := range results {
+		if r.Error != nil {
+			failed++
+		} else if len(r.Changes) > 0 {
+			changed++
+		}
+	}
+
+	if updateJSON {
+		if failed > 0 {
+			return output.ErrorWithDetails(output.ErrValidation,
+				fmt.Sprintf("%d of %d beans failed to update; no beans were changed", failed, len(results)), results)
+		}
+		msg := fmt.Sprintf("Updated %d of %d beans", changed, len(results))
+		if updateDryRun {
+			msg = fmt.Sprintf("Dry run: would update %d of %d beans", changed, len(results))
+		}
+		return output.SuccessResults(results, msg)
+	}
+
+	if len(results) == 0 {
+		fmt.Println(ui.Muted.Render("No beans matched."))
+		return nil
+	}
+
+	for _, r := range results {
+		switch {
+		case r.Error != nil:
+			fmt.Println(ui.Danger.Render("Failed ") + ui.ID.Render(r.ID) + " " + *r.Error)
+		case len(r.Changes) == 0:
+			fmt.Println(ui.Muted.Render("Unchanged ") + ui.ID.Render(r.ID))
+		case updateDryRun || failed > 0:
+			fmt.Println(ui.Warning.Render("Would update ") + ui.ID.Render(r.ID))
+		default:
+			fmt.Println(ui.Success.Render("Updated ") + ui.ID.Render(r.ID))
+		}
+		for _, ch := range r.Changes {
+			fmt.Print(formatFieldChange(*ch))
+		}
+	}
+
+	switch {
+	case failed > 0:
+		return fmt.Errorf("%d of %d beans failed to update; no beans were changed", failed, len(results))
+	case updateDryRun:
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("Dry run: %d of %d beans would be updated", changed, len(results))))
+	default:
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("Updated %d of %d beans", changed, len(results))))
+	}
+	return nil
+}
+
 // buildUpdateInput constructs the GraphQL input from flags and returns which fields changed.
 func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody string) (model.UpdateBeanInput, []string, error) {
 	var input model.UpdateBeanInput
@@ -202,6 +327,72 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "tags")
 	}
 
+	// Handle assignees using granular add/remove
+	assignees, err := withCurrentUser(updateAssignee, updateMe)
+	if err != nil {
+		return input, nil, err
+	}
+	if len(assignees) > 0 {
+		input.AddAssignees = assignees
+		changes = append(changes, "assignees")
+	}
+	if len(updateRemoveAssignee) > 0 {
+		input.RemoveAssignees = updateRemoveAssignee
+		changes = append(changes, "assignees")
+	}
+
+	// Handle dates (validated by the resolver)
+	if cmd.Flags().Changed("start") {
+		input.Start = &updateStart
+		changes = append(changes, "start")
+	} else if updateRemoveStart {
+		empty := ""
+		input.Start = &empty
+		changes = append(changes, "start")
+	}
+	if cmd.Flags().Changed("due") {
+		input.Due = &updateDue
+		changes = append(changes, "due")
+	} else if updateRemoveDue {
+		empty := ""
+		input.Due = &empty
+		changes = append(changes, "due")
+	}
+
+	// Handle estimate (validated by the resolver)
+	if cmd.Flags().Changed("estimate") {
+		input.Estimate = &updateEstimate
+		changes = append(changes, "estimate")
+	} else if updateRemoveEstimate {
+		zero := 0.0
+		input.Estimate = &zero
+		changes = append(changes, "estimate")
+	}
+
+	// Handle recurrence (validated by the resolver)
+	if updateRecur != "" {
+		input.Recur = &updateRecur
+		changes = append(changes, "recur")
+	} else if updateRemoveRecur {
+		empty := ""
+		input.Recur = &empty
+		changes = append(changes, "recur")
+	}
+
+	// Handle custom fields
+	if len(updateField) > 0 {
+		fields, err := parseFieldAssignments(updateField)
+		if err != nil {
+			return input, nil, err
+		}
+		input.SetFields = fields
+		changes = append(changes, "fields")
+	}
+	if len(updateRemoveField) > 0 {
+		input.RemoveFields = updateRemoveField
+		changes = append(changes, "fields")
+	}
+
 	// Handle parent relationship
 	if cmd.Flags().Changed("parent") {
 		input.Parent = &updateParent
@@ -232,6 +423,24 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "blocked-by")
 	}
 
+	// Handle links
+	if len(updateLink) > 0 {
+		links, err := parseLinks(updateLink)
+		if err != nil {
+			return input, nil, err
+		}
+		input.AddLinks = links
+		changes = append(changes, "links")
+	}
+	if len(updateRemoveLink) > 0 {
+		links, err := parseLinks(updateRemoveLink)
+		if err != nil {
+			return input, nil, err
+		}
+		input.RemoveLinks = links
+		changes = append(changes, "links")
+	}
+
 	return input, changes, nil
 }
 
@@ -240,8 +449,12 @@ func hasFieldUpdates(input model.UpdateBeanInput) bool {
 	return input.Status != nil || input.Type != nil || input.Priority != nil ||
 		input.Title != nil || input.Body != nil || input.BodyMod != nil || input.Tags != nil ||
 		input.AddTags != nil || input.RemoveTags != nil ||
+		input.Assignees != nil || input.AddAssignees != nil || input.RemoveAssignees != nil ||
+		input.Start != nil || input.Due != nil || input.Estimate != nil || input.Recur != nil ||
+		input.SetFields != nil || input.RemoveFields != nil ||
 		input.Parent != nil || input.AddBlocking != nil || input.RemoveBlocking != nil ||
-		input.AddBlockedBy != nil || input.RemoveBlockedBy != nil
+		input.AddBlockedBy != nil || input.RemoveBlockedBy != nil ||
+		input.AddLinks != nil || input.RemoveLinks != nil
 }
 
 // isConflictError returns true if the error is an ETag-related conflict error.
@@ -252,15 +465,23 @@ func isConflictError(err error) bool {
 }
 
 // mutationError returns a cmdError with the appropriate error code based on the error type.
+// Workflow transition errors include structured details in JSON mode.
 func mutationError(jsonOutput bool, err error) error {
 	if isConflictError(err) {
 		return cmdError(jsonOutput, output.ErrConflict, "%s", err)
 	}
+	var transitionErr *beancore.TransitionError
+	if errors.As(err, &transitionErr) {
+		if jsonOutput {
+			return output.ErrorWithDetails(output.ErrTransition, err.Error(), transitionErr)
+		}
+		return err
+	}
 	return cmdError(jsonOutput, output.ErrValidation, "%s", err)
 }
 
 func RegisterUpdateCmd(root *cobra.Command) {
-	// Build help text with allowed values from hardcoded config
+	// Build help text with the built-in allowed values (projects may declare their own in .beans.yml)
 	statusNames := make([]string, len(config.DefaultStatuses))
 	for i, s := range config.DefaultStatuses {
 		statusNames[i] = s.Name
@@ -289,10 +510,31 @@ func RegisterUpdateCmd(root *cobra.Command) {
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlocking, "remove-blocking", nil, "ID of bean to unblock (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlockedBy, "remove-blocked-by", nil, "ID of blocker bean to remove (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateLink, "link", nil, "Link to another bean as type:id, e.g. duplicates:abc1 (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveLink, "remove-link", nil, "Link to remove as type:id (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateTag, "tag", nil, "Add tag (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveTag, "remove-tag", nil, "Remove tag (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateAssignee, "assignee", nil, "Assign to user (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveAssignee, "remove-assignee", nil, "Unassign user (can be repeated)")
+	updateCmd.Flags().BoolVar(&updateMe, "me", false, "Assign to yourself ($BEANS_USER or git user.email)")
+	updateCmd.Flags().StringVar(&updateStart, "start", "", "Set planned start date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw)")
+	updateCmd.Flags().BoolVar(&updateRemoveStart, "remove-start", false, "Remove start date")
+	updateCmd.Flags().StringVar(&updateDue, "due", "", "Set due date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw)")
+	updateCmd.Flags().BoolVar(&updateRemoveDue, "remove-due", false, "Remove due date")
+	updateCmd.Flags().Float64Var(&updateEstimate, "estimate", 0, "Set estimated effort (e.g. story points or hours)")
+	updateCmd.Flags().BoolVar(&updateRemoveEstimate, "remove-estimate", false, "Remove estimate")
+	updateCmd.Flags().StringVar(&updateRecur, "recur", "", "Repeat when done: daily, weekly, monthly, yearly, or every <n>d/w/m/y")
+	updateCmd.Flags().BoolVar(&updateRemoveRecur, "remove-recur", false, "Stop repeating")
+	updateCmd.Flags().StringArrayVar(&updateField, "field", nil, "Set custom field as key=value, or key= to clear (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveField, "remove-field", nil, "Remove custom field (can be repeated)")
 	updateCmd.Flags().StringVar(&updateIfMatch, "if-match", "", "Only update if etag matches (optimistic locking)")
+	updateCmd.Flags().StringVar(&updateWhere, "where", "", "Update all beans matching these list filter flags (e.g. '--status todo --tag backend')")
+	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "Show the changes that would be made without writing them")
 	updateCmd.MarkFlagsMutuallyExclusive("parent", "remove-parent")
+	updateCmd.MarkFlagsMutuallyExclusive("start", "remove-start")
+	updateCmd.MarkFlagsMutuallyExclusive("due", "remove-due")
+	updateCmd.MarkFlagsMutuallyExclusive("estimate", "remove-estimate")
+	updateCmd.MarkFlagsMutuallyExclusive("recur", "remove-recur")
 	updateCmd.Flags().BoolVar(&updateJSON, "json", false, "Output as JSON")
 	// body and body-file are mutually exclusive with body modifications
 	updateCmd.MarkFlagsMutuallyExclusive("body", "body-file", "body-replace-old")
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchFilter beanFilterFlags
+	watchExec   string
+)
+
+// watchEvent is a line of 'beans watch' output.
+type watchEvent struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	// Changes lists the fields changed by an update.
+	Changes []string `json:"changes,omitempty"`
+	// ETag is the bean's new etag (omitted for deletes).
+	ETag string `json:"etag,omitempty"`
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Print bean changes as they happen",
+	Long: `Watches the beans directory and prints a JSON line for every bean that is
+created, updated, or deleted, until interrupted:
+
+  {"type":"updated","id":"beans-x1y2","changes":["status"],"etag":"..."}
+
+Accepts the filter flags of 'beans list'. Deleted beans are matched as they
+were before deletion; updates that only touch timestamps are skipped.
+
+With --exec, the command is run through sh for every event, with the bean as
+JSON on stdin (its last version for deletes) and BEANS_EVENT and BEANS_ID set.
+Events are handled one at a time; a slow command may cause events to be dropped.
+The command's output goes to stderr, so stdout stays a clean stream of events.
+
+  beans watch
+  beans watch --type bug --priority critical
+  beans watch --status completed --exec 'jq -r .title >> done.txt'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter := &model.BeanFilter{}
+		if err := watchFilter.apply(filter); err != nil {
+			return cmdError(false, output.ErrValidation, "%s", err)
+		}
+
+		if err := core.StartWatching(); err != nil {
+			return fmt.Errorf("failed to start file watcher: %w", err)
+		}
+		defer core.Unwatch()
+		events, unsubscribe := core.Subscribe()
+		defer unsubscribe()
+		tracker := core.NewChangeTracker()
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return watchBeans(ctx, events, tracker, filter, watchExec, cmd.OutOrStdout(), cmd.ErrOrStderr())
+	},
+}
+
+// watchBeans prints the changes matching filter as JSON lines to out, and
+// runs execCmd (if set) for each of them, until ctx is canceled or events is
+// closed. The commands write to errOut, which failing ones are reported to.
+func watchBeans(ctx context.Context, events <-chan []beancore.BeanEvent, tracker *beancore.ChangeTracker, filter *model.BeanFilter, execCmd string, out, errOut io.Writer) error {
+	enc := json.NewEncoder(out)
+	for {
+		var batch []beancore.BeanEvent
+		select {
+		case <-ctx.Done():
+			return nil
+		case b, ok := <-events:
+			if !ok {
+				return nil
+			}
+			batch = b
+		}
+
+		for _, event := range batch {
+			change, ok := tracker.Track(event)
+			if !ok {
+				continue
+			}
+			matched, err := beangraph.Matches(change.Bean, filter, core)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+
+			line := watchEvent{Type: change.Type.String(), ID: change.BeanID}
+			for _, f := range change.Fields {
+				line.Changes = append(line.Changes, f.Field)
+			}
+			if change.Type != beancore.EventDeleted {
+				line.ETag = change.Bean.ETag()
+			}
+			if err := enc.Encode(line); err != nil {
+				return err
+			}
+
+			if execCmd != "" {
+				if err := runWatchExec(ctx, execCmd, change, errOut); err != nil {
+					fmt.Fprintln(errOut, ui.Warning.Render(fmt.Sprintf("--exec failed for %s %s: %s", change.BeanID, line.Type, err)))
+				}
+			}
+		}
+	}
+}
+
+// runWatchExec runs command through sh with the changed bean as JSON on stdin.
+// Both of its output streams go to errOut, keeping the events on stdout
+// parseable.
+func runWatchExec(ctx context.Context, command string, change beancore.Change, errOut io.Writer) error {
+	data, err := json.Marshal(change.Bean)
+	if err != nil {
+		return err
+	}
+	c := exec.CommandContext(ctx, "sh", "-c", command)
+	c.Stdin = bytes.NewReader(data)
+	c.Stdout = errOut
+	c.Stderr = errOut
+	c.Env = append(os.Environ(), "BEANS_EVENT="+change.Type.String(), "BEANS_ID="+change.BeanID)
+	return c.Run()
+}
+
+func RegisterWatchCmd(root *cobra.Command) {
+	watchFilter.register(watchCmd.Flags())
+	watchCmd.Flags().StringVar(&watchExec, "exec", "", "Command to run for each event, with the bean as JSON on stdin")
+	root.AddCommand(watchCmd)
+}
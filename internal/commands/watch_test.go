@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/beangraph/model"
+)
+
+// syncBuffer is a bytes.Buffer that is safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatchBeans(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	if err := testCore.StartWatching(); err != nil {
+		t.Fatalf("StartWatching() error = %v", err)
+	}
+	defer testCore.Unwatch()
+	events, unsubscribe := testCore.Subscribe()
+	defer unsubscribe()
+
+	execOut := filepath.Join(t.TempDir(), "exec.jsonl")
+	filter := &model.BeanFilter{ExcludeStatus: []string{"draft"}}
+	var out, errOut syncBuffer
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tracker := testCore.NewChangeTracker()
+	done := make(chan error, 1)
+	go func() {
+		done <- watchBeans(ctx, events, tracker, filter, `(echo "$BEANS_EVENT $BEANS_ID"; cat; echo) >> `+execOut+`; echo "ran $BEANS_ID"`, &out, &errOut)
+	}()
+
+	// etag returns a bean's current etag, as a client reading it would see it.
+	etag := func(id string) string {
+		b, err := testCore.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b.ETag()
+	}
+
+	// waitForLines waits until the output has n lines and returns them.
+	waitForLines := func(n int) []watchEvent {
+		t.Helper()
+		for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if len(lines) < n || lines[0] == "" {
+				continue
+			}
+			result := make([]watchEvent, len(lines))
+			for i, line := range lines {
+				if err := json.Unmarshal([]byte(line), &result[i]); err != nil {
+					t.Fatalf("invalid output line %q: %v", line, err)
+				}
+			}
+			return result
+		}
+		t.Fatalf("timed out waiting for %d lines, got:\n%s", n, out.String())
+		return nil
+	}
+
+	b := createQueryTestBean(t, testCore, "w-1", "Watched", "todo")
+	createQueryTestBean(t, testCore, "w-2", "Draft", "draft") // filtered out
+	if got := waitForLines(1)[0]; got.Type != "created" || got.ID != "w-1" || got.ETag != etag("w-1") {
+		t.Errorf("created line = %+v", got)
+	}
+
+	b.Status = "in-progress"
+	b.Title = "Watched closely"
+	if err := testCore.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+	got := waitForLines(2)[1]
+	if got.Type != "updated" || got.ETag != etag("w-1") || strings.Join(got.Changes, ",") != "title,status" {
+		t.Errorf("updated line = %+v", got)
+	}
+
+	if err := testCore.Delete("w-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := waitForLines(3)[2]; got.Type != "deleted" || got.ID != "w-1" || got.ETag != "" {
+		t.Errorf("deleted line = %+v", got)
+	}
+
+	// Closing the events channel (unlike canceling) lets the last --exec finish
+	unsubscribe()
+	if err := <-done; err != nil {
+		t.Errorf("watchBeans() error = %v", err)
+	}
+	// The commands' output goes to errOut, keeping out parseable
+	if got := errOut.String(); got != "ran w-1\nran w-1\nran w-1\n" {
+		t.Errorf("errOut = %q, want the output of three --exec runs", got)
+	}
+	if lines := waitForLines(3); len(lines) != 3 {
+		t.Errorf("out has %d lines, want 3", len(lines))
+	}
+
+	t.Run("exec", func(t *testing.T) {
+		data, err := os.ReadFile(execOut)
+		if err != nil {
+			t.Fatal(err)
+		}
+		runs := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if len(runs) != 6 || runs[0] != "created w-1" || runs[4] != "deleted w-1" {
+			t.Fatalf("exec output:\n%s", data)
+		}
+		var stdin struct{ Title string }
+		if err := json.Unmarshal([]byte(runs[3]), &stdin); err != nil || stdin.Title != "Watched closely" {
+			t.Errorf("exec stdin = %s, %v", runs[3], err)
+		}
+	})
+}
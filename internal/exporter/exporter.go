@@ -0,0 +1,216 @@
+// Package exporter writes beans in formats for other tools: CSV and markdown
+// tables for spreadsheets and documents, JSONL for scripts, and iCalendar
+// VTODOs for calendar and task apps.
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Supported export formats.
+const (
+	FormatCSV           = "csv"
+	FormatJSONL         = "jsonl"
+	FormatICS           = "ics"
+	FormatMarkdownTable = "markdown-table"
+)
+
+// Formats lists the supported export formats.
+var Formats = []string{FormatCSV, FormatJSONL, FormatICS, FormatMarkdownTable}
+
+// formatFiles maps formats to their file extension and MIME type.
+var formatFiles = map[string]struct{ ext, contentType string }{
+	FormatCSV:           {"csv", "text/csv"},
+	FormatJSONL:         {"jsonl", "application/jsonl"},
+	FormatICS:           {"ics", "text/calendar"},
+	FormatMarkdownTable: {"md", "text/markdown"},
+}
+
+// Extension returns the file extension for a format (without the dot).
+func Extension(format string) string {
+	return formatFiles[format].ext
+}
+
+// ContentType returns the MIME type for a format.
+func ContentType(format string) string {
+	return formatFiles[format].contentType
+}
+
+// Columns lists the built-in columns for CSV and markdown table exports. Custom
+// fields can be exported as columns too, by name.
+var Columns = []string{
+	"id", "slug", "path", "title", "status", "type", "priority", "tags",
+	"parent", "blocking", "blocked_by", "assignees", "start", "due", "estimate",
+	"created_at", "updated_at", "body",
+}
+
+// DefaultColumns are the columns exported when none are selected.
+var DefaultColumns = []string{"id", "title", "status", "type", "priority", "tags", "parent", "due"}
+
+// Options configures an export.
+type Options struct {
+	// Columns selects the CSV and markdown table columns (DefaultColumns if empty).
+	Columns []string
+	// Config maps statuses to iCalendar statuses and names the calendar.
+	Config *config.Config
+	// Now is used as the timestamp of beans without updated_at (time.Now if zero).
+	Now time.Time
+}
+
+// Write writes beans to w in the given format.
+func Write(w io.Writer, format string, beans []*bean.Bean, opts Options) error {
+	switch format {
+	case FormatCSV, FormatMarkdownTable:
+		columns := opts.Columns
+		if len(columns) == 0 {
+			columns = DefaultColumns
+		}
+		if err := ValidateColumns(columns, opts.Config, beans); err != nil {
+			return err
+		}
+		if format == FormatCSV {
+			return writeCSV(w, beans, columns)
+		}
+		return writeMarkdownTable(w, beans, columns)
+	case FormatJSONL:
+		return writeJSONL(w, beans)
+	case FormatICS:
+		return writeICS(w, beans, opts)
+	default:
+		return fmt.Errorf("unknown export format: %s (must be %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// ValidateColumns returns an error for columns that are neither built in nor a
+// custom field configured or set on one of the beans.
+func ValidateColumns(columns []string, cfg *config.Config, beans []*bean.Bean) error {
+	for _, col := range columns {
+		if slices.Contains(Columns, col) {
+			continue
+		}
+		if cfg != nil && cfg.GetField(col) != nil {
+			continue
+		}
+		if slices.ContainsFunc(beans, func(b *bean.Bean) bool { _, ok := b.Fields[col]; return ok }) {
+			continue
+		}
+		return fmt.Errorf("unknown column: %s (must be %s, or a custom field)", col, strings.Join(Columns, ", "))
+	}
+	return nil
+}
+
+// columnValue returns the value of a column for a bean. List values are
+// joined with ", ", so CSV exports can be read back by `beans import`.
+func columnValue(b *bean.Bean, column string) string {
+	date := func(d *bean.Date) string {
+		if d == nil {
+			return ""
+		}
+		return d.String()
+	}
+	timestamp := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	switch column {
+	case "id":
+		return b.ID
+	case "slug":
+		return b.Slug
+	case "path":
+		return b.Path
+	case "title":
+		return b.Title
+	case "status":
+		return b.Status
+	case "type":
+		return b.Type
+	case "priority":
+		return b.Priority
+	case "tags":
+		return strings.Join(b.Tags, ", ")
+	case "parent":
+		return b.Parent
+	case "blocking":
+		return strings.Join(b.Blocking, ", ")
+	case "blocked_by":
+		return strings.Join(b.BlockedBy, ", ")
+	case "assignees":
+		return strings.Join(b.Assignees, ", ")
+	case "start":
+		return date(b.Start)
+	case "due":
+		return date(b.Due)
+	case "estimate":
+		if b.Estimate == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(b.Estimate, 'f', -1, 64)
+	case "created_at":
+		return timestamp(b.CreatedAt)
+	case "updated_at":
+		return timestamp(b.UpdatedAt)
+	case "body":
+		return b.Body
+	default:
+		return b.Fields[column]
+	}
+}
+
+func writeCSV(w io.Writer, beans []*bean.Bean, columns []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	row := make([]string, len(columns))
+	for _, b := range beans {
+		for i, col := range columns {
+			row[i] = columnValue(b, col)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func writeMarkdownTable(w io.Writer, beans []*bean.Bean, columns []string) error {
+	var sb strings.Builder
+	sb.WriteString("| " + strings.Join(columns, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat(" --- |", len(columns)) + "\n")
+	for _, b := range beans {
+		sb.WriteString("|")
+		for _, col := range columns {
+			sb.WriteString(" " + markdownCellReplacer.Replace(strings.TrimSpace(columnValue(b, col))) + " |")
+		}
+		sb.WriteString("\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeJSONL(w io.Writer, beans []*bean.Bean) error {
+	enc := json.NewEncoder(w)
+	for _, b := range beans {
+		if err := enc.Encode(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
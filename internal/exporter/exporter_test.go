@@ -0,0 +1,179 @@
+package exporter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+func testBeans() []*bean.Bean {
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, 3, 2, 12, 30, 0, 0, time.UTC)
+	start := bean.Date{Time: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)}
+	due := bean.Date{Time: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)}
+	return []*bean.Bean{
+		{
+			ID: "b-1", Title: "Fix login, again", Status: "in-progress", Type: "bug", Priority: "high",
+			Tags: []string{"auth", "backend"}, Parent: "b-0", Start: &start, Due: &due,
+			CreatedAt: &created, UpdatedAt: &updated,
+			Body:   "Steps:\n1. log in | out",
+			Fields: map[string]string{"team": "core"},
+		},
+		{ID: "b-2", Title: "Old idea", Status: "scrapped", Type: "feature", Priority: "deferred"},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var sb strings.Builder
+	err := Write(&sb, FormatCSV, testBeans(), Options{Columns: []string{"id", "title", "tags", "due", "team"}})
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := "id,title,tags,due,team\n" +
+		"b-1,\"Fix login, again\",\"auth, backend\",2026-03-10,core\n" +
+		"b-2,Old idea,,,\n"
+	if sb.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestWriteCSVColumns(t *testing.T) {
+	var sb strings.Builder
+	if err := Write(&sb, FormatCSV, testBeans(), Options{}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if header, _, _ := strings.Cut(sb.String(), "\n"); header != strings.Join(DefaultColumns, ",") {
+		t.Errorf("header = %q, want default columns", header)
+	}
+
+	cfg := config.Default()
+	cfg.Fields = []config.FieldConfig{{Name: "sprint"}}
+	if err := Write(&sb, FormatCSV, testBeans(), Options{Columns: []string{"sprint"}, Config: cfg}); err != nil {
+		t.Errorf("configured custom field column: error = %v", err)
+	}
+	if err := Write(&sb, FormatCSV, testBeans(), Options{Columns: []string{"titel"}}); err == nil {
+		t.Error("unknown column: expected error")
+	}
+}
+
+func TestWriteMarkdownTable(t *testing.T) {
+	var sb strings.Builder
+	if err := Write(&sb, FormatMarkdownTable, testBeans(), Options{Columns: []string{"id", "body"}}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := "| id | body |\n" +
+		"| --- | --- |\n" +
+		"| b-1 | Steps:<br>1. log in \\| out |\n" +
+		"| b-2 |  |\n"
+	if sb.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestWriteJSONL(t *testing.T) {
+	var sb strings.Builder
+	if err := Write(&sb, FormatJSONL, testBeans(), Options{}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+	var b bean.Bean
+	if err := json.Unmarshal([]byte(lines[0]), &b); err != nil {
+		t.Fatalf("line 1 is not a bean: %v", err)
+	}
+	if b.ID != "b-1" || b.Body == "" {
+		t.Errorf("bean = %+v, want b-1 with body", b)
+	}
+}
+
+func TestWriteICS(t *testing.T) {
+	var sb strings.Builder
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	if err := Write(&sb, FormatICS, testBeans(), Options{Config: config.Default(), Now: now}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	out := sb.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:b-1@beans\r\n",
+		"DTSTAMP:20260302T123000Z\r\n",
+		"SUMMARY:Fix login\\, again\r\n",
+		"DESCRIPTION:Steps:\\n1. log in | out\r\n",
+		"DTSTART;VALUE=DATE:20260305\r\n",
+		"DUE;VALUE=DATE:20260310\r\n",
+		"STATUS:IN-PROCESS\r\n",
+		"PRIORITY:3\r\n",
+		"CATEGORIES:bug,auth,backend\r\n",
+		"RELATED-TO;RELTYPE=PARENT:b-0@beans\r\n",
+		"DTSTAMP:20260401T000000Z\r\n",
+		"STATUS:CANCELLED\r\n",
+		"PRIORITY:9\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "BEGIN:VTODO") != 2 {
+		t.Errorf("want 2 VTODOs:\n%s", out)
+	}
+}
+
+func TestICSStatus(t *testing.T) {
+	cfg := config.Default()
+	tests := map[string]string{
+		"draft":       "NEEDS-ACTION",
+		"todo":        "NEEDS-ACTION",
+		"in-progress": "IN-PROCESS",
+		"completed":   "COMPLETED",
+		"scrapped":    "CANCELLED",
+	}
+	for status, want := range tests {
+		if got := icsStatus(status, Options{Config: cfg}); got != want {
+			t.Errorf("icsStatus(%q) = %q, want %q", status, got, want)
+		}
+	}
+
+	// Custom statuses are mapped by their flags, not their names
+	cfg.Statuses = []config.StatusConfig{
+		{Name: "doing", Active: true},
+		{Name: "open"},
+		{Name: "shipped", Archive: true},
+		{Name: "wontfix", Archive: true, Cancelled: true},
+		{Name: "in-progress"},
+	}
+	tests = map[string]string{
+		"doing":       "IN-PROCESS",
+		"open":        "NEEDS-ACTION",
+		"shipped":     "COMPLETED",
+		"wontfix":     "CANCELLED",
+		"in-progress": "NEEDS-ACTION",
+		"scrapped":    "NEEDS-ACTION",
+	}
+	for status, want := range tests {
+		if got := icsStatus(status, Options{Config: cfg}); got != want {
+			t.Errorf("custom icsStatus(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestWriteFolded(t *testing.T) {
+	var sb strings.Builder
+	writeFolded(&sb, "DESCRIPTION:"+strings.Repeat("ä", 100))
+	for i, line := range strings.Split(strings.TrimSuffix(sb.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line %d is %d octets, want at most 75", i, len(line))
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line %d splits a UTF-8 sequence", i)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package exporter
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// icsPriorities maps the built-in priorities to iCalendar priorities
+// (1 = highest, 9 = lowest). Other priorities are left undefined.
+var icsPriorities = map[string]int{
+	"critical": 1,
+	"high":     3,
+	"normal":   5,
+	"low":      7,
+	"deferred": 9,
+}
+
+const icsTimestamp = "20060102T150405Z"
+
+// writeICS writes beans as an iCalendar (RFC 5545) calendar of VTODOs.
+func writeICS(w io.Writer, beans []*bean.Bean, opts Options) error {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var sb strings.Builder
+	line := func(name, value string) {
+		writeFolded(&sb, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//beans//beans export//EN")
+	if opts.Config != nil {
+		line("X-WR-CALNAME", escapeText(opts.Config.GetProjectName()))
+	}
+
+	for _, b := range beans {
+		line("BEGIN", "VTODO")
+		line("UID", icsUID(b.ID))
+		stamp := now
+		if b.UpdatedAt != nil {
+			stamp = *b.UpdatedAt
+		}
+		line("DTSTAMP", stamp.UTC().Format(icsTimestamp))
+		if b.CreatedAt != nil {
+			line("CREATED", b.CreatedAt.UTC().Format(icsTimestamp))
+		}
+		if b.UpdatedAt != nil {
+			line("LAST-MODIFIED", b.UpdatedAt.UTC().Format(icsTimestamp))
+		}
+		line("SUMMARY", escapeText(b.Title))
+		if body := strings.TrimSpace(b.Body); body != "" {
+			line("DESCRIPTION", escapeText(body))
+		}
+
+		// DUE must be later than DTSTART, so a start on or after the due date is dropped
+		if b.Start != nil && (b.Due == nil || b.Start.Before(b.Due.Time)) {
+			line("DTSTART;VALUE=DATE", b.Start.Format("20060102"))
+		}
+		if b.Due != nil {
+			line("DUE;VALUE=DATE", b.Due.Format("20060102"))
+		}
+
+		status := icsStatus(b.Status, opts)
+		line("STATUS", status)
+		if status == "COMPLETED" && b.UpdatedAt != nil {
+			line("COMPLETED", b.UpdatedAt.UTC().Format(icsTimestamp))
+		}
+		if p, ok := icsPriorities[b.Priority]; ok {
+			line("PRIORITY", strconv.Itoa(p))
+		}
+
+		var categories []string
+		for _, c := range append([]string{b.Type}, b.Tags...) {
+			if c != "" {
+				categories = append(categories, escapeText(c))
+			}
+		}
+		if len(categories) > 0 {
+			line("CATEGORIES", strings.Join(categories, ","))
+		}
+		if b.Parent != "" {
+			line("RELATED-TO;RELTYPE=PARENT", icsUID(b.Parent))
+		}
+		line("END", "VTODO")
+	}
+
+	line("END", "VCALENDAR")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// icsUID returns the iCalendar UID of a bean.
+func icsUID(id string) string {
+	return id + "@beans"
+}
+
+// icsStatus maps a bean status to a VTODO status by how the config declares
+// it (the default statuses without a config): archive statuses are completed
+// or, if marked cancelled, cancelled; active statuses are in process; and
+// everything else, including unknown statuses, still needs action.
+func icsStatus(status string, opts Options) string {
+	cfg := opts.Config
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	s := cfg.GetStatus(status)
+	switch {
+	case s == nil:
+		return "NEEDS-ACTION"
+	case s.Archive && s.Cancelled:
+		return "CANCELLED"
+	case s.Archive:
+		return "COMPLETED"
+	case s.Active:
+		return "IN-PROCESS"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// escapeText escapes an iCalendar TEXT value.
+func escapeText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// writeFolded writes a content line, folded at 75 octets without splitting
+// UTF-8 sequences, and terminated with CRLF.
+func writeFolded(sb *strings.Builder, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		sb.WriteString(s[:cut])
+		sb.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts toward the limit
+		limit = 74
+	}
+	sb.WriteString(s)
+	sb.WriteString("\r\n")
+}
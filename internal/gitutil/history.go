@@ -0,0 +1,110 @@
+package gitutil
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrNotRepository is returned when a directory is not inside a git repository.
+var ErrNotRepository = errors.New("not a git repository")
+
+// FileRevision is a single commit that touched a file, together with the
+// file's content at that commit.
+type FileRevision struct {
+	Commit  string
+	Author  string
+	Email   string
+	Date    time.Time
+	Subject string
+	// Path is the file's path relative to the repository root at this commit.
+	Path string
+	// Content is the file content after the commit, or nil if the commit deleted it.
+	Content []byte
+}
+
+// Record and field separators used in the git log format string.
+const (
+	logRecordSep = "\x1e"
+	logFieldSep  = "\x1f"
+)
+
+// FileHistory returns all committed revisions of the file at path, oldest
+// first, following renames. path may be absolute or relative to dir.
+// Returns ErrNotRepository if dir is not inside a git repository.
+func FileHistory(dir, path string) ([]FileRevision, error) {
+	if _, err := gitRevParse(dir, "--git-dir"); err != nil {
+		return nil, ErrNotRepository
+	}
+
+	format := logRecordSep + strings.Join([]string{"%H", "%an", "%ae", "%aI", "%s"}, logFieldSep)
+	cmd := exec.Command("git", "-C", dir, "log", "--follow", "--name-only", "--format="+format, "--", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log: %w", err)
+	}
+
+	revisions, err := parseFileLog(string(out))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range revisions {
+		show := exec.Command("git", "-C", dir, "show", revisions[i].Commit+":"+revisions[i].Path)
+		content, err := show.Output()
+		if err != nil {
+			// The file doesn't exist at this commit, so the commit deleted it.
+			continue
+		}
+		revisions[i].Content = content
+	}
+
+	return revisions, nil
+}
+
+// parseFileLog parses the output of git log with the FileHistory format and
+// --name-only, returning revisions oldest first. Each record looks like:
+//
+//	\x1e<hash>\x1f<author>\x1f<email>\x1f<date>\x1f<subject>\n\n<path>\n
+func parseFileLog(output string) ([]FileRevision, error) {
+	var revisions []FileRevision
+	for _, record := range strings.Split(output, logRecordSep) {
+		record = strings.TrimSpace(record)
+		if record == "" {
+			continue
+		}
+
+		header, paths, _ := strings.Cut(record, "\n")
+		fields := strings.Split(header, logFieldSep)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected git log record: %q", header)
+		}
+		date, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("parsing commit date %q: %w", fields[3], err)
+		}
+
+		rev := FileRevision{
+			Commit:  fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Date:    date,
+			Subject: fields[4],
+		}
+		for _, p := range strings.Split(paths, "\n") {
+			if p = strings.TrimSpace(p); p != "" {
+				rev.Path = p
+				break
+			}
+		}
+		revisions = append(revisions, rev)
+	}
+
+	// git log lists newest first
+	for i, j := 0, len(revisions)-1; i < j; i, j = i+1, j-1 {
+		revisions[i], revisions[j] = revisions[j], revisions[i]
+	}
+	return revisions, nil
+}
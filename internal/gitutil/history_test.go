@@ -0,0 +1,76 @@
+package gitutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileHistory(t *testing.T) {
+	dir := initTestRepo(t)
+	path := filepath.Join(dir, "notes.md")
+
+	if err := os.WriteFile(path, []byte("one\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitRun(t, dir, "add", "notes.md")
+	gitRun(t, dir, "commit", "-m", "add notes")
+
+	if err := os.WriteFile(path, []byte("two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitRun(t, dir, "commit", "-am", "update notes")
+
+	// Rename the file; history should follow it
+	gitRun(t, dir, "mv", "notes.md", "renamed.md")
+	gitRun(t, dir, "commit", "-m", "rename notes")
+
+	revs, err := FileHistory(dir, filepath.Join(dir, "renamed.md"))
+	if err != nil {
+		t.Fatalf("FileHistory() error = %v", err)
+	}
+	if len(revs) != 3 {
+		t.Fatalf("FileHistory() returned %d revisions, want 3: %+v", len(revs), revs)
+	}
+
+	if revs[0].Subject != "add notes" || string(revs[0].Content) != "one\n" || revs[0].Path != "notes.md" {
+		t.Errorf("revs[0] = %+v, want oldest revision first", revs[0])
+	}
+	if revs[1].Subject != "update notes" || string(revs[1].Content) != "two\n" {
+		t.Errorf("revs[1] = %+v, want update", revs[1])
+	}
+	if revs[2].Path != "renamed.md" || string(revs[2].Content) != "two\n" {
+		t.Errorf("revs[2] = %+v, want renamed path", revs[2])
+	}
+	if revs[0].Author != "Test" || revs[0].Email != "test@test.com" || revs[0].Date.IsZero() {
+		t.Errorf("revs[0] author/date = %q <%s> %v", revs[0].Author, revs[0].Email, revs[0].Date)
+	}
+}
+
+func TestFileHistory_NotRepository(t *testing.T) {
+	dir := t.TempDir()
+	_, err := FileHistory(dir, filepath.Join(dir, "notes.md"))
+	if !errors.Is(err, ErrNotRepository) {
+		t.Errorf("FileHistory() error = %v, want ErrNotRepository", err)
+	}
+}
+
+func TestParseFileLog(t *testing.T) {
+	out := "\x1eaaa\x1fBob\x1fbob@example.com\x1f2024-03-02T10:00:00+01:00\x1fsecond\n\nb.md\n" +
+		"\x1ebbb\x1fAlice\x1falice@example.com\x1f2024-03-01T10:00:00Z\x1ffirst\n\na.md\n"
+
+	revs, err := parseFileLog(out)
+	if err != nil {
+		t.Fatalf("parseFileLog() error = %v", err)
+	}
+	if len(revs) != 2 {
+		t.Fatalf("parseFileLog() returned %d revisions, want 2", len(revs))
+	}
+	if revs[0].Commit != "bbb" || revs[0].Path != "a.md" || revs[0].Author != "Alice" {
+		t.Errorf("revs[0] = %+v, want oldest (bbb) first", revs[0])
+	}
+	if revs[1].Subject != "second" || revs[1].Date.Hour() != 10 {
+		t.Errorf("revs[1] = %+v, want second commit with parsed date", revs[1])
+	}
+}
@@ -0,0 +1,42 @@
+package gitutil
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// TopLevel returns the root directory of the git work tree containing dir.
+func TopLevel(dir string) (string, error) {
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return "", fmt.Errorf("%s is not inside a git repository", dir)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// SetConfig sets a key in the repository's local git config.
+func SetConfig(dir, key, value string) error {
+	out, err := exec.Command("git", "-C", dir, "config", key, value).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git config %s: %s", key, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// MergeFile runs git's built-in line-based three-way merge (git merge-file),
+// writing the result to current. Returns true if the result contains conflicts.
+func MergeFile(current, base, other string) (bool, error) {
+	cmd := exec.Command("git", "merge-file", "-L", "ours", "-L", "base", "-L", "theirs", current, base, other)
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return false, nil
+	}
+	// A positive exit code below 128 is the number of conflicts
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 && exitErr.ExitCode() < 128 {
+		return true, nil
+	}
+	return false, fmt.Errorf("git merge-file: %s", strings.TrimSpace(string(out)))
+}
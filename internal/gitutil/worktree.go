@@ -72,6 +72,18 @@ func CurrentBranch(dir string) (string, bool) {
 	return branch, true
 }
 
+// UserEmail returns the configured user.email for the repo at dir.
+// Returns ("", false) if git is unavailable or no email is configured.
+func UserEmail(dir string) (string, bool) {
+	cmd := exec.Command("git", "-C", dir, "config", "user.email")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", false
+	}
+	email := strings.TrimSpace(string(out))
+	return email, email != ""
+}
+
 func gitRevParse(dir, flag string) (string, error) {
 	cmd := exec.Command("git", "-C", dir, "rev-parse", flag)
 	out, err := cmd.Output()
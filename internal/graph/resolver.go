@@ -2,11 +2,13 @@ package graph
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hmans/beans/internal/agent"
 	"github.com/hmans/beans/internal/gitutil"
 	"github.com/hmans/beans/internal/portalloc"
 	"github.com/hmans/beans/internal/terminal"
+	"github.com/hmans/beans/internal/webhook"
 	"github.com/hmans/beans/internal/worktree"
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beancore"
@@ -34,10 +36,46 @@ type Resolver struct {
 	AgentMgr    *agent.Manager
 	TerminalMgr *terminal.Manager
 	PortAlloc   *portalloc.Allocator
+	Webhooks    *webhook.Dispatcher
 	Forge       forge.Provider // git forge provider (GitHub, GitLab, etc.) — nil if not detected
 	ProjectRoot string         // absolute path to the project root (parent of .beans)
 }
 
+// deliveryToModel converts a webhook delivery to a GraphQL model.
+func deliveryToModel(d webhook.Delivery) *model.WebhookDelivery {
+	m := &model.WebhookDelivery{
+		ID:        d.ID,
+		Webhook:   d.Webhook,
+		Event:     d.Event,
+		BeanID:    d.BeanID,
+		Status:    model.WebhookDeliveryStatus(strings.ToUpper(string(d.Status))),
+		Attempts:  d.Attempts,
+		CreatedAt: d.CreatedAt,
+	}
+	if d.StatusCode != 0 {
+		m.StatusCode = &d.StatusCode
+	}
+	if d.Error != "" {
+		m.Error = &d.Error
+	}
+	if !d.LastAttemptAt.IsZero() {
+		m.LastAttemptAt = &d.LastAttemptAt
+	}
+	if !d.NextAttemptAt.IsZero() {
+		m.NextAttemptAt = &d.NextAttemptAt
+	}
+	return m
+}
+
+// deliveryStatusFromModel converts a GraphQL delivery status filter to a
+// webhook status ("" for no filter).
+func deliveryStatusFromModel(status *model.WebhookDeliveryStatus) webhook.Status {
+	if status == nil {
+		return ""
+	}
+	return webhook.Status(strings.ToLower(string(*status)))
+}
+
 // worktreeToModel converts an internal worktree to a GraphQL model.
 // It takes an optional beancore.Core to resolve BeanIDs into full Bean objects.
 // When computeGitStatus is true, it shells out to git to compute hasChanges and
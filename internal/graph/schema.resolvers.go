@@ -25,6 +25,56 @@ import (
 	"github.com/hmans/beans/pkg/config"
 )
 
+// Assignee is the resolver for the assignee field.
+func (r *beanResolver) Assignee(ctx context.Context, obj *bean.Bean) (*string, error) {
+	return r.CoreResolver.BeanAssignee(ctx, obj)
+}
+
+// IsOverdue is the resolver for the isOverdue field.
+func (r *beanResolver) IsOverdue(ctx context.Context, obj *bean.Bean) (bool, error) {
+	return r.CoreResolver.BeanIsOverdue(ctx, obj)
+}
+
+// Estimate is the resolver for the estimate field.
+func (r *beanResolver) Estimate(ctx context.Context, obj *bean.Bean) (*float64, error) {
+	return r.CoreResolver.BeanEstimate(ctx, obj)
+}
+
+// Recur is the resolver for the recur field.
+func (r *beanResolver) Recur(ctx context.Context, obj *bean.Bean) (*string, error) {
+	return r.CoreResolver.BeanRecur(ctx, obj)
+}
+
+// Previous is the resolver for the previous field.
+func (r *beanResolver) Previous(ctx context.Context, obj *bean.Bean) (*bean.Bean, error) {
+	return r.CoreResolver.BeanPrevious(ctx, obj)
+}
+
+// NextOccurrence is the resolver for the nextOccurrence field.
+func (r *beanResolver) NextOccurrence(ctx context.Context, obj *bean.Bean) (*bean.Bean, error) {
+	return r.CoreResolver.BeanNextOccurrence(ctx, obj)
+}
+
+// Progress is the resolver for the progress field.
+func (r *beanResolver) Progress(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.CoreResolver.BeanProgress(ctx, obj)
+}
+
+// TotalChildren is the resolver for the totalChildren field.
+func (r *beanResolver) TotalChildren(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.CoreResolver.BeanTotalChildren(ctx, obj)
+}
+
+// CompletedChildren is the resolver for the completedChildren field.
+func (r *beanResolver) CompletedChildren(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.CoreResolver.BeanCompletedChildren(ctx, obj)
+}
+
+// RemainingEstimate is the resolver for the remainingEstimate field.
+func (r *beanResolver) RemainingEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.CoreResolver.BeanRemainingEstimate(ctx, obj)
+}
+
 // IsDirty is the resolver for the isDirty field.
 func (r *beanResolver) IsDirty(ctx context.Context, obj *bean.Bean) (bool, error) {
 	return r.CoreResolver.BeanIsDirty(ctx, obj)
@@ -35,6 +85,16 @@ func (r *beanResolver) WorktreeID(ctx context.Context, obj *bean.Bean) (*string,
 	return r.CoreResolver.BeanWorktreeID(ctx, obj)
 }
 
+// Fields is the resolver for the fields field.
+func (r *beanResolver) Fields(ctx context.Context, obj *bean.Bean) ([]*model.BeanField, error) {
+	return r.CoreResolver.BeanFields(ctx, obj)
+}
+
+// Field is the resolver for the field field.
+func (r *beanResolver) Field(ctx context.Context, obj *bean.Bean, name string) (*string, error) {
+	return r.CoreResolver.BeanField(ctx, obj, name)
+}
+
 // ParentID is the resolver for the parentId field.
 func (r *beanResolver) ParentID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	return r.CoreResolver.BeanParentID(ctx, obj)
@@ -51,13 +111,13 @@ func (r *beanResolver) BlockedByIds(ctx context.Context, obj *bean.Bean) ([]stri
 }
 
 // BlockedBy is the resolver for the blockedBy field.
-func (r *beanResolver) BlockedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
-	return r.CoreResolver.BeanBlockedBy(ctx, obj, filter)
+func (r *beanResolver) BlockedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanBlockedBy(ctx, obj, filter, orderBy, first)
 }
 
 // Blocking is the resolver for the blocking field.
-func (r *beanResolver) Blocking(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
-	return r.CoreResolver.BeanBlocking(ctx, obj, filter)
+func (r *beanResolver) Blocking(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanBlocking(ctx, obj, filter, orderBy, first)
 }
 
 // Parent is the resolver for the parent field.
@@ -66,8 +126,38 @@ func (r *beanResolver) Parent(ctx context.Context, obj *bean.Bean) (*bean.Bean,
 }
 
 // Children is the resolver for the children field.
-func (r *beanResolver) Children(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
-	return r.CoreResolver.BeanChildren(ctx, obj, filter)
+func (r *beanResolver) Children(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanChildren(ctx, obj, filter, orderBy, first)
+}
+
+// Links is the resolver for the links field.
+func (r *beanResolver) Links(ctx context.Context, obj *bean.Bean, typeArg *string) ([]*beancore.Link, error) {
+	return r.CoreResolver.BeanLinks(ctx, obj, typeArg)
+}
+
+// Related is the resolver for the related field.
+func (r *beanResolver) Related(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, "related", filter)
+}
+
+// Duplicates is the resolver for the duplicates field.
+func (r *beanResolver) Duplicates(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, "duplicates", filter)
+}
+
+// DuplicatedBy is the resolver for the duplicatedBy field.
+func (r *beanResolver) DuplicatedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, "duplicated_by", filter)
+}
+
+// Supersedes is the resolver for the supersedes field.
+func (r *beanResolver) Supersedes(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, "supersedes", filter)
+}
+
+// SupersededBy is the resolver for the supersededBy field.
+func (r *beanResolver) SupersededBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, "superseded_by", filter)
 }
 
 // ImplicitStatus is the resolver for the implicitStatus field.
@@ -80,6 +170,26 @@ func (r *beanResolver) ImplicitStatusFrom(ctx context.Context, obj *bean.Bean) (
 	return r.CoreResolver.BeanImplicitStatusFrom(ctx, obj)
 }
 
+// History is the resolver for the history field.
+func (r *beanResolver) History(ctx context.Context, obj *bean.Bean, limit *int) ([]*beancore.HistoryEntry, error) {
+	return r.CoreResolver.BeanHistory(ctx, obj, limit)
+}
+
+// From is the resolver for the from field.
+func (r *beanChangeResolver) From(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return r.CoreResolver.BeanChangeFrom(ctx, obj)
+}
+
+// To is the resolver for the to field.
+func (r *beanChangeResolver) To(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return r.CoreResolver.BeanChangeTo(ctx, obj)
+}
+
+// Diff is the resolver for the diff field.
+func (r *beanChangeResolver) Diff(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return r.CoreResolver.BeanChangeDiff(ctx, obj)
+}
+
 // CreateBean is the resolver for the createBean field.
 func (r *mutationResolver) CreateBean(ctx context.Context, input model.CreateBeanInput) (*bean.Bean, error) {
 	return r.CoreResolver.CreateBean(ctx, input)
@@ -90,6 +200,11 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 	return r.CoreResolver.UpdateBean(ctx, id, input)
 }
 
+// UpdateBeans is the resolver for the updateBeans field.
+func (r *mutationResolver) UpdateBeans(ctx context.Context, ids []string, filter *model.BeanFilter, input model.UpdateBeanInput, dryRun *bool) ([]*model.BeanUpdateResult, error) {
+	return r.CoreResolver.UpdateBeans(ctx, ids, filter, input, dryRun != nil && *dryRun)
+}
+
 // DeleteBean is the resolver for the deleteBean field.
 func (r *mutationResolver) DeleteBean(ctx context.Context, id string) (bool, error) {
 	return r.CoreResolver.DeleteBean(ctx, id)
@@ -120,6 +235,11 @@ func (r *mutationResolver) RemoveBlockedBy(ctx context.Context, id string, targe
 	return r.CoreResolver.RemoveBlockedBy(ctx, id, targetID, ifMatch)
 }
 
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, id string, body string, author *string, ifMatch *string) (*bean.Bean, error) {
+	return r.CoreResolver.AddComment(ctx, id, body, author, ifMatch)
+}
+
 // WriteTerminalInput is the resolver for the writeTerminalInput field.
 // Creates the session on demand if it doesn't exist yet.
 func (r *mutationResolver) WriteTerminalInput(ctx context.Context, sessionID string, data string) (bool, error) {
@@ -511,8 +631,66 @@ func (r *queryResolver) Bean(ctx context.Context, id string) (*bean.Bean, error)
 }
 
 // Beans is the resolver for the beans field.
-func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]*bean.Bean, error) {
-	return r.CoreResolver.Beans(ctx, filter)
+func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int, after *string) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeansPage(ctx, filter, orderBy, first, after)
+}
+
+// BeansConnection is the resolver for the beansConnection field.
+func (r *queryResolver) BeansConnection(ctx context.Context, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int, after *string) (*model.BeanConnection, error) {
+	return r.CoreResolver.BeansConnection(ctx, filter, orderBy, first, after)
+}
+
+// BeanStats is the resolver for the beanStats field.
+func (r *queryResolver) BeanStats(ctx context.Context, filter *model.BeanFilter, groupBy []model.BeanStatsGroupBy) (*model.BeanStats, error) {
+	return r.CoreResolver.BeanStats(ctx, filter, groupBy)
+}
+
+// LoadErrors is the resolver for the loadErrors field.
+func (r *queryResolver) LoadErrors(ctx context.Context) ([]*beancore.LoadError, error) {
+	return r.CoreResolver.LoadErrors(ctx)
+}
+
+// SearchBeans is the resolver for the searchBeans field.
+func (r *queryResolver) SearchBeans(ctx context.Context, query string, limit *int) (*beancore.SearchResults, error) {
+	return r.CoreResolver.SearchBeans(ctx, query, limit)
+}
+
+// Export is the resolver for the export field.
+func (r *queryResolver) Export(ctx context.Context, format model.ExportFormat, filter *model.BeanFilter, columns []string) (*model.ExportFile, error) {
+	return r.CoreResolver.Export(ctx, format, filter, columns)
+}
+
+// Views is the resolver for the views field.
+func (r *queryResolver) Views(ctx context.Context) ([]*config.ViewConfig, error) {
+	return r.CoreResolver.Views(ctx)
+}
+
+// View is the resolver for the view field.
+func (r *queryResolver) View(ctx context.Context, name string) (*config.ViewConfig, error) {
+	return r.CoreResolver.View(ctx, name)
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhook *string, status *model.WebhookDeliveryStatus, limit *int) ([]*model.WebhookDelivery, error) {
+	if r.Webhooks == nil {
+		return []*model.WebhookDelivery{}, nil
+	}
+
+	var name string
+	if webhook != nil {
+		name = *webhook
+	}
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+
+	deliveries := r.Webhooks.Deliveries(name, deliveryStatusFromModel(status), n)
+	result := make([]*model.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = deliveryToModel(d)
+	}
+	return result, nil
 }
 
 // Worktrees is the resolver for the worktrees field.
@@ -1339,9 +1517,32 @@ func (r *subscriptionResolver) WorkspaceStatuses(ctx context.Context) (<-chan []
 	return out, nil
 }
 
+// Description is the resolver for the description field.
+func (r *viewResolver) Description(ctx context.Context, obj *config.ViewConfig) (*string, error) {
+	return r.CoreResolver.ViewDescription(ctx, obj)
+}
+
+// Sort is the resolver for the sort field.
+func (r *viewResolver) Sort(ctx context.Context, obj *config.ViewConfig) (*string, error) {
+	return r.CoreResolver.ViewSort(ctx, obj)
+}
+
+// Format is the resolver for the format field.
+func (r *viewResolver) Format(ctx context.Context, obj *config.ViewConfig) (string, error) {
+	return r.CoreResolver.ViewFormat(ctx, obj)
+}
+
+// Beans is the resolver for the beans field.
+func (r *viewResolver) Beans(ctx context.Context, obj *config.ViewConfig) ([]*bean.Bean, error) {
+	return r.CoreResolver.ViewBeans(ctx, obj)
+}
+
 // Bean returns BeanResolver implementation.
 func (r *Resolver) Bean() BeanResolver { return &beanResolver{r} }
 
+// BeanChange returns BeanChangeResolver implementation.
+func (r *Resolver) BeanChange() BeanChangeResolver { return &beanChangeResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -1351,7 +1552,12 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
+// View returns ViewResolver implementation.
+func (r *Resolver) View() ViewResolver { return &viewResolver{r} }
+
 type beanResolver struct{ *Resolver }
+type beanChangeResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type viewResolver struct{ *Resolver }
@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hmans/beans/internal/agent"
+	"github.com/hmans/beans/internal/webhook"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/bean"
@@ -108,7 +113,7 @@ func TestQueryBeans(t *testing.T) {
 
 	t.Run("no filter", func(t *testing.T) {
 		qr := resolver.Query()
-		got, err := qr.Beans(ctx, nil)
+		got, err := qr.Beans(ctx, nil, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -122,7 +127,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -139,7 +144,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo", "in-progress"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -153,7 +158,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeStatus: []string{"completed"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -180,7 +185,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			Tags: []string{"frontend"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -194,7 +199,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			Tags: []string{"frontend", "backend"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -208,7 +213,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeTags: []string{"urgent"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -240,7 +245,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"normal"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -262,7 +267,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"critical"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -279,7 +284,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"critical", "high"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -293,7 +298,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludePriority: []string{"normal"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -355,7 +360,7 @@ func TestBeanRelationships(t *testing.T) {
 
 	t.Run("children resolver", func(t *testing.T) {
 		br := resolver.Bean()
-		got, err := br.Children(ctx, parent, nil)
+		got, err := br.Children(ctx, parent, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Children() error = %v", err)
 		}
@@ -366,7 +371,7 @@ func TestBeanRelationships(t *testing.T) {
 
 	t.Run("blockedBy resolver", func(t *testing.T) {
 		br := resolver.Bean()
-		got, err := br.BlockedBy(ctx, child1, nil)
+		got, err := br.BlockedBy(ctx, child1, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -380,7 +385,7 @@ func TestBeanRelationships(t *testing.T) {
 
 	t.Run("blocks resolver", func(t *testing.T) {
 		br := resolver.Bean()
-		got, err := br.Blocking(ctx, blocker, nil)
+		got, err := br.Blocking(ctx, blocker, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Blocks() error = %v", err)
 		}
@@ -447,7 +452,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			HasParent: &hasParentBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -465,7 +470,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			NoParent: &noParentBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -480,7 +485,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			HasBlocking: &hasBlocksBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -498,7 +503,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlockedBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -516,7 +521,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlockedBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -538,7 +543,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			ParentID: &parentID,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -630,7 +635,7 @@ func TestIsBlockedFilterWithResolvedBlockers(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlocked,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -664,7 +669,7 @@ func TestIsBlockedFilterWithResolvedBlockers(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlocked,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -932,6 +937,402 @@ func TestMutationUpdateBean(t *testing.T) {
 	})
 }
 
+func TestMutationValidatesConfiguredDefinitions(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	cfg := core.Config()
+	cfg.Statuses = []config.StatusConfig{
+		{Name: "todo", Color: "green"},
+		{Name: "in-review", Color: "purple"},
+		{Name: "done", Color: "gray", Archive: true},
+	}
+	cfg.Types = []config.TypeConfig{
+		{Name: "chore", Color: "blue"},
+		{Name: "spike", Color: "yellow"},
+	}
+	cfg.Beans.DefaultType = "chore"
+	mr := resolver.Mutation()
+
+	t.Run("create uses configured default type", func(t *testing.T) {
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Default type"})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if got.Type != "chore" {
+			t.Errorf("CreateBean().Type = %q, want \"chore\"", got.Type)
+		}
+	})
+
+	t.Run("custom values accepted", func(t *testing.T) {
+		status, typ := "in-review", "spike"
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Spike", Status: &status, Type: &typ}); err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+	})
+
+	t.Run("unknown values rejected", func(t *testing.T) {
+		status, typ, priority := "completed", "bug", "urgent"
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Bad", Status: &status}); err == nil {
+			t.Error("CreateBean() expected error for unconfigured status")
+		}
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Bad", Type: &typ}); err == nil {
+			t.Error("CreateBean() expected error for unconfigured type")
+		}
+
+		b := createTestBean(t, core, "valid-1", "Valid", "todo")
+		if _, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{Priority: &priority}); err == nil {
+			t.Error("UpdateBean() expected error for unconfigured priority")
+		}
+	})
+}
+
+func TestUpdateBeanEnforcesWorkflow(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	core.Config().Workflow = config.WorkflowConfig{
+		Transitions: map[string]map[string][]string{
+			config.WorkflowAnyType: {"todo": {"in-progress", "scrapped"}},
+		},
+	}
+	mr := resolver.Mutation()
+
+	b := createTestBean(t, core, "wf-1", "Workflow", "todo")
+	completed := "completed"
+	title := "Renamed"
+	_, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{Status: &completed, Title: &title})
+	var terr *beancore.TransitionError
+	if !errors.As(err, &terr) {
+		t.Fatalf("UpdateBean() error = %v, want *beancore.TransitionError", err)
+	}
+	if terr.From != "todo" || terr.To != "completed" || terr.Rule != beancore.RuleTransition {
+		t.Errorf("TransitionError = %+v, want todo -> completed transition violation", terr)
+	}
+
+	got, _ := core.Get(b.ID)
+	if got.Status != "todo" || got.Title != "Workflow" {
+		t.Errorf("bean was modified after rejected transition: status=%q title=%q", got.Status, got.Title)
+	}
+
+	inProgress := "in-progress"
+	if _, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{Status: &inProgress}); err != nil {
+		t.Errorf("UpdateBean() error = %v, want allowed transition", err)
+	}
+}
+
+func TestBeanHistoryWithoutGit(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	b := createTestBean(t, core, "hist-1", "History", "todo")
+	history, err := resolver.Bean().History(ctx, b, nil)
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if history == nil || len(history) != 0 {
+		t.Errorf("History() = %v, want empty list outside git", history)
+	}
+}
+
+func TestMutationAddComment(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	b := createTestBean(t, core, "cmt-1", "Commented", "todo")
+
+	t.Run("explicit author", func(t *testing.T) {
+		author := "alice@example.com"
+		got, err := mr.AddComment(ctx, b.ID, "First!", &author, nil)
+		if err != nil {
+			t.Fatalf("AddComment() error = %v", err)
+		}
+		if len(got.Comments) != 1 || got.Comments[0].Author != author || got.Comments[0].Body != "First!" {
+			t.Errorf("Comments = %+v, want one comment by %s", got.Comments, author)
+		}
+	})
+
+	t.Run("author from BEANS_USER", func(t *testing.T) {
+		t.Setenv(beancore.UserEnvVar, "agent-7")
+		got, err := mr.AddComment(ctx, b.ID, "Second", nil, nil)
+		if err != nil {
+			t.Fatalf("AddComment() error = %v", err)
+		}
+		if last := got.Comments[len(got.Comments)-1]; last.Author != "agent-7" {
+			t.Errorf("Author = %q, want \"agent-7\"", last.Author)
+		}
+	})
+
+	t.Run("empty body rejected", func(t *testing.T) {
+		author := "alice"
+		if _, err := mr.AddComment(ctx, b.ID, "  ", &author, nil); err == nil {
+			t.Error("AddComment() expected error for empty body")
+		}
+	})
+
+	t.Run("etag mismatch rejected", func(t *testing.T) {
+		author, etag := "alice", "0000000000000000"
+		if _, err := mr.AddComment(ctx, b.ID, "Late", &author, &etag); err == nil {
+			t.Error("AddComment() expected etag mismatch error")
+		}
+	})
+
+	t.Run("persisted and searchable", func(t *testing.T) {
+		results, err := core.Search("Second")
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(results) != 1 || results[0].ID != b.ID {
+			t.Errorf("Search() = %v, want [%s]", results, b.ID)
+		}
+	})
+}
+
+func TestAssignees(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+
+	createTestBean(t, core, "asg-1", "Unassigned", "todo")
+	created, err := mr.CreateBean(ctx, model.CreateBeanInput{
+		Title:     "Assigned",
+		Assignees: []string{"alice@example.com", "ALICE@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	if len(created.Assignees) != 1 {
+		t.Errorf("Assignees = %v, want duplicates collapsed", created.Assignees)
+	}
+
+	t.Run("add and remove", func(t *testing.T) {
+		got, err := mr.UpdateBean(ctx, created.ID, model.UpdateBeanInput{
+			AddAssignees:    []string{"agent"},
+			RemoveAssignees: []string{"Alice@Example.com"},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if len(got.Assignees) != 1 || got.Assignees[0] != "agent" {
+			t.Errorf("Assignees = %v, want [agent]", got.Assignees)
+		}
+		assignee, _ := resolver.Bean().Assignee(ctx, got)
+		if assignee == nil || *assignee != "agent" {
+			t.Errorf("Assignee = %v, want agent", assignee)
+		}
+	})
+
+	t.Run("replace and add are mutually exclusive", func(t *testing.T) {
+		_, err := mr.UpdateBean(ctx, created.ID, model.UpdateBeanInput{
+			Assignees:    []string{"bob"},
+			AddAssignees: []string{"carol"},
+		})
+		if err == nil {
+			t.Error("UpdateBean() expected error")
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		got, err := qr.Beans(ctx, &model.BeanFilter{Assignee: []string{"AGENT", "nobody"}}, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != created.ID {
+			t.Errorf("Beans(assignee) = %v, want [%s]", got, created.ID)
+		}
+
+		noAssignee := true
+		got, err = qr.Beans(ctx, &model.BeanFilter{NoAssignee: &noAssignee}, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "asg-1" {
+			t.Errorf("Beans(noAssignee) = %v, want [asg-1]", got)
+		}
+	})
+}
+
+func TestDueDates(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+
+	today := bean.Today()
+	yesterday, nextWeek := today.AddDays(-1), today.AddDays(7)
+
+	late, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Late", Due: &yesterday})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	upcoming, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Upcoming", Start: &today, Due: &nextWeek})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	createTestBean(t, core, "undated", "Undated", "todo")
+
+	t.Run("isOverdue field", func(t *testing.T) {
+		br := resolver.Bean()
+		if overdue, _ := br.IsOverdue(ctx, late); !overdue {
+			t.Error("IsOverdue(late) = false, want true")
+		}
+		if overdue, _ := br.IsOverdue(ctx, upcoming); overdue {
+			t.Error("IsOverdue(upcoming) = true, want false")
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		isOverdue := true
+		got, _ := qr.Beans(ctx, &model.BeanFilter{IsOverdue: &isOverdue}, nil, nil, nil)
+		if len(got) != 1 || got[0].ID != late.ID {
+			t.Errorf("Beans(isOverdue) = %v, want [%s]", got, late.ID)
+		}
+		got, _ = qr.Beans(ctx, &model.BeanFilter{DueBefore: &today}, nil, nil, nil)
+		if len(got) != 1 || got[0].ID != late.ID {
+			t.Errorf("Beans(dueBefore) = %v, want [%s]", got, late.ID)
+		}
+		got, _ = qr.Beans(ctx, &model.BeanFilter{DueAfter: &nextWeek}, nil, nil, nil)
+		if len(got) != 1 || got[0].ID != upcoming.ID {
+			t.Errorf("Beans(dueAfter) = %v, want [%s]", got, upcoming.ID)
+		}
+	})
+
+	t.Run("completed beans are not overdue", func(t *testing.T) {
+		status := "completed"
+		got, err := mr.UpdateBean(ctx, late.ID, model.UpdateBeanInput{Status: &status})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if overdue, _ := resolver.Bean().IsOverdue(ctx, got); overdue {
+			t.Error("IsOverdue(completed) = true, want false")
+		}
+	})
+
+	t.Run("update and clear", func(t *testing.T) {
+		due, empty := "+3d", ""
+		got, err := mr.UpdateBean(ctx, "undated", model.UpdateBeanInput{Due: &due})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Due == nil || !got.Due.Equal(today.AddDays(3).Time) {
+			t.Errorf("Due = %v, want %s", got.Due, today.AddDays(3))
+		}
+		got, err = mr.UpdateBean(ctx, "undated", model.UpdateBeanInput{Due: &empty})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Due != nil {
+			t.Errorf("Due = %v, want nil", got.Due)
+		}
+	})
+
+	t.Run("invalid dates rejected", func(t *testing.T) {
+		bad, before := "someday", "2000-01-01"
+		if _, err := mr.UpdateBean(ctx, upcoming.ID, model.UpdateBeanInput{Due: &bad}); err == nil {
+			t.Error("UpdateBean() expected error for invalid date")
+		}
+		if _, err := mr.UpdateBean(ctx, upcoming.ID, model.UpdateBeanInput{Due: &before}); err == nil {
+			t.Error("UpdateBean() expected error for due date before start date")
+		}
+	})
+}
+
+func TestCustomFields(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	core.Config().Fields = []config.FieldConfig{
+		{Name: "points", Type: config.FieldTypeInt},
+		{Name: "area", Type: config.FieldTypeEnum, Values: []string{"ui", "api"}},
+	}
+	mr := resolver.Mutation()
+
+	t.Run("create with fields", func(t *testing.T) {
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title: "Fielded",
+			Fields: []*model.FieldInput{
+				{Name: "points", Value: "08"},
+				{Name: "area", Value: "ui"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		fields, _ := resolver.Bean().Fields(ctx, got)
+		if len(fields) != 2 || fields[0].Name != "area" || fields[1].Name != "points" || fields[1].Value != "8" {
+			t.Errorf("Fields() = %+v, want sorted [area=ui points=8]", fields)
+		}
+	})
+
+	t.Run("create with invalid value", func(t *testing.T) {
+		_, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:  "Bad",
+			Fields: []*model.FieldInput{{Name: "area", Value: "db"}},
+		})
+		if err == nil {
+			t.Error("CreateBean() expected error for invalid enum value")
+		}
+	})
+
+	t.Run("create with undeclared field", func(t *testing.T) {
+		_, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:  "Bad",
+			Fields: []*model.FieldInput{{Name: "customer", Value: "acme"}},
+		})
+		if err == nil {
+			t.Error("CreateBean() expected error for undeclared field")
+		}
+	})
+
+	t.Run("update set and remove", func(t *testing.T) {
+		b := createTestBean(t, core, "fields-upd", "Update Fields", "todo")
+		got, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{
+			SetFields: []*model.FieldInput{{Name: "points", Value: "3"}, {Name: "area", Value: "api"}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if v, _ := resolver.Bean().Field(ctx, got, "points"); v == nil || *v != "3" {
+			t.Errorf("Field(points) = %v, want 3", v)
+		}
+
+		got, err = mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{
+			SetFields:    []*model.FieldInput{{Name: "points", Value: ""}},
+			RemoveFields: []string{"area"},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if len(got.Fields) != 0 {
+			t.Errorf("Fields = %v, want empty", got.Fields)
+		}
+	})
+
+	t.Run("filter by field", func(t *testing.T) {
+		qr := resolver.Query()
+		isSet := false
+		tests := []struct {
+			name   string
+			filter *model.FieldFilter
+			want   int
+		}{
+			{"by value", &model.FieldFilter{Name: "area", Values: []string{"ui"}}, 1},
+			{"by values OR", &model.FieldFilter{Name: "area", Values: []string{"ui", "api"}}, 1},
+			{"not set", &model.FieldFilter{Name: "points", IsSet: &isSet}, 1},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := qr.Beans(ctx, &model.BeanFilter{Fields: []*model.FieldFilter{tt.filter}}, nil, nil, nil)
+				if err != nil {
+					t.Fatalf("Beans() error = %v", err)
+				}
+				if len(got) != tt.want {
+					t.Errorf("Beans() count = %d, want %d", len(got), tt.want)
+				}
+			})
+		}
+	})
+}
+
 func TestMutationSetParent(t *testing.T) {
 	resolver, core := setupTestResolver(t)
 	ctx := context.Background()
@@ -1255,7 +1656,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo"},
 		}
-		got, err := br.Children(ctx, parent, filter)
+		got, err := br.Children(ctx, parent, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("Children() error = %v", err)
 		}
@@ -1271,7 +1672,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeStatus: []string{"completed"},
 		}
-		got, err := br.Children(ctx, parent, filter)
+		got, err := br.Children(ctx, parent, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("Children() error = %v", err)
 		}
@@ -1284,7 +1685,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"high"},
 		}
-		got, err := br.Children(ctx, parent, filter)
+		got, err := br.Children(ctx, parent, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("Children() error = %v", err)
 		}
@@ -1297,7 +1698,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 	})
 
 	t.Run("children with nil filter returns all", func(t *testing.T) {
-		got, err := br.Children(ctx, parent, nil)
+		got, err := br.Children(ctx, parent, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Children() error = %v", err)
 		}
@@ -1310,7 +1711,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			Type: []string{"bug"},
 		}
-		got, err := br.BlockedBy(ctx, child1, filter)
+		got, err := br.BlockedBy(ctx, child1, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -1326,7 +1727,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeStatus: []string{"completed"},
 		}
-		got, err := br.BlockedBy(ctx, child1, filter)
+		got, err := br.BlockedBy(ctx, child1, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -1342,7 +1743,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo"},
 		}
-		got, err := br.Blocking(ctx, blocker1, filter)
+		got, err := br.Blocking(ctx, blocker1, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("Blocking() error = %v", err)
 		}
@@ -1355,7 +1756,7 @@ func TestRelationshipFieldsWithFilter(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"completed"},
 		}
-		got, err := br.Blocking(ctx, blocker1, filter)
+		got, err := br.Blocking(ctx, blocker1, filter, nil, nil)
 		if err != nil {
 			t.Fatalf("Blocking() error = %v", err)
 		}
@@ -2668,7 +3069,7 @@ func TestBlockedByResolverCombinesBothDirections(t *testing.T) {
 		core.Create(blocked)
 
 		br := resolver.Bean()
-		result, err := br.BlockedBy(ctx, blocked, nil)
+		result, err := br.BlockedBy(ctx, blocked, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -2684,7 +3085,7 @@ func TestBlockedByResolverCombinesBothDirections(t *testing.T) {
 		core.Create(blocked)
 
 		br := resolver.Bean()
-		result, err := br.BlockedBy(ctx, blocked, nil)
+		result, err := br.BlockedBy(ctx, blocked, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -2701,7 +3102,7 @@ func TestBlockedByResolverCombinesBothDirections(t *testing.T) {
 		core.Create(blocked)
 
 		br := resolver.Bean()
-		result, err := br.BlockedBy(ctx, blocked, nil)
+		result, err := br.BlockedBy(ctx, blocked, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("BlockedBy() error = %v", err)
 		}
@@ -3252,3 +3653,967 @@ func TestListFiles(t *testing.T) {
 	})
 }
 
+
+func TestEstimateProgress(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	br := resolver.Bean()
+
+	epicType := "epic"
+	epic, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Epic", Type: &epicType})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	three, five := 3.0, 5.0
+	completed := "completed"
+	if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Done", Parent: &epic.ID, Estimate: &three, Status: &completed}); err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	open, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Open", Parent: &epic.ID, Estimate: &five})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+
+	t.Run("estimate field", func(t *testing.T) {
+		if got, _ := br.Estimate(ctx, open); got == nil || *got != 5 {
+			t.Errorf("Estimate(open) = %v, want 5", got)
+		}
+		if got, _ := br.Estimate(ctx, epic); got != nil {
+			t.Errorf("Estimate(epic) = %v, want nil", *got)
+		}
+	})
+
+	for name, ctx := range map[string]context.Context{"roll-up fields": ctx, "roll-up fields with progress cache": beangraph.WithProgressCache(ctx)} {
+		t.Run(name, func(t *testing.T) {
+			if got, _ := br.TotalChildren(ctx, epic); got != 2 {
+				t.Errorf("TotalChildren = %d, want 2", got)
+			}
+			if got, _ := br.CompletedChildren(ctx, epic); got != 1 {
+				t.Errorf("CompletedChildren = %d, want 1", got)
+			}
+			if got, _ := br.RemainingEstimate(ctx, epic); got != 5 {
+				t.Errorf("RemainingEstimate = %v, want 5", got)
+			}
+			if got, _ := br.Progress(ctx, epic); got != 3.0/8.0 {
+				t.Errorf("Progress = %v, want %v", got, 3.0/8.0)
+			}
+		})
+	}
+
+	t.Run("update and clear estimate", func(t *testing.T) {
+		two := 2.0
+		updated, err := mr.UpdateBean(ctx, open.ID, model.UpdateBeanInput{Estimate: &two})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if updated.Estimate != 2 {
+			t.Errorf("Estimate = %v, want 2", updated.Estimate)
+		}
+		zero := 0.0
+		updated, err = mr.UpdateBean(ctx, open.ID, model.UpdateBeanInput{Estimate: &zero})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if updated.Estimate != 0 {
+			t.Errorf("Estimate = %v, want 0 (cleared)", updated.Estimate)
+		}
+	})
+
+	t.Run("negative estimate rejected", func(t *testing.T) {
+		negative := -1.0
+		if _, err := mr.UpdateBean(ctx, open.ID, model.UpdateBeanInput{Estimate: &negative}); err == nil {
+			t.Error("UpdateBean() with negative estimate should fail")
+		}
+	})
+}
+
+func TestParseFieldFilters(t *testing.T) {
+	got, err := beangraph.ParseFieldFilters([]string{"area=ui", "area=api", "points"})
+	if err != nil {
+		t.Fatalf("ParseFieldFilters() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ParseFieldFilters() returned %d filters, want 2", len(got))
+	}
+	if got[0].Name != "area" || len(got[0].Values) != 2 || got[0].IsSet != nil {
+		t.Errorf("filter[0] = %+v, want area with 2 values", got[0])
+	}
+	if got[1].Name != "points" || got[1].IsSet == nil || !*got[1].IsSet {
+		t.Errorf("filter[1] = %+v, want points isSet", got[1])
+	}
+}
+
+func TestViews(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	qr := resolver.Query()
+	vr := resolver.View()
+
+	core.Config().Views = []config.ViewConfig{
+		{Name: "triage", Description: "Open work", Filter: config.ViewFilter{Status: []string{"todo"}}, Sort: "id"},
+		{Name: "bad-date", Filter: config.ViewFilter{DueBefore: "someday"}},
+	}
+	createTestBean(t, core, "b2", "Second", "todo")
+	createTestBean(t, core, "b1", "First", "todo")
+	createTestBean(t, core, "b3", "Done", "completed")
+
+	views, err := qr.Views(ctx)
+	if err != nil {
+		t.Fatalf("Views() error = %v", err)
+	}
+	if len(views) != 2 || views[0].Name != "triage" {
+		t.Fatalf("Views() = %v, want [triage bad-date]", views)
+	}
+
+	view, _ := qr.View(ctx, "triage")
+	if view == nil {
+		t.Fatal("View(triage) = nil")
+	}
+	if desc, _ := vr.Description(ctx, view); desc == nil || *desc != "Open work" {
+		t.Errorf("Description = %v, want Open work", desc)
+	}
+	if format, _ := vr.Format(ctx, view); format != config.ViewFormatTree {
+		t.Errorf("Format = %q, want tree", format)
+	}
+
+	beans, err := vr.Beans(ctx, view)
+	if err != nil {
+		t.Fatalf("Beans() error = %v", err)
+	}
+	if len(beans) != 2 || beans[0].ID != "b1" || beans[1].ID != "b2" {
+		t.Errorf("Beans() = %v, want [b1 b2] sorted by id", beans)
+	}
+
+	if missing, _ := qr.View(ctx, "missing"); missing != nil {
+		t.Errorf("View(missing) = %v, want nil", missing)
+	}
+	bad, _ := qr.View(ctx, "bad-date")
+	if _, err := vr.Beans(ctx, bad); err == nil {
+		t.Error("Beans() with invalid due_before should fail")
+	}
+}
+
+func TestUpdateBeans(t *testing.T) {
+	ctx := context.Background()
+	high := "high"
+	dryRun := true
+
+	t.Run("by filter", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		mr := resolver.Mutation()
+		createTestBean(t, core, "b1", "First", "todo")
+		createTestBean(t, core, "b2", "Second", "todo")
+		createTestBean(t, core, "b3", "Done", "completed")
+
+		filter := &model.BeanFilter{Status: []string{"todo"}}
+		results, err := mr.UpdateBeans(ctx, nil, filter, model.UpdateBeanInput{Priority: &high}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("UpdateBeans() returned %d results, want 2", len(results))
+		}
+		for _, r := range results {
+			if r.Error != nil || r.Bean == nil || len(r.Changes) != 1 || r.Changes[0].Field != "priority" {
+				t.Errorf("result for %s = %+v, want a priority change", r.ID, r)
+			}
+		}
+		for id, want := range map[string]string{"b1": "high", "b2": "high", "b3": ""} {
+			if b, _ := core.Get(id); b.Priority != want {
+				t.Errorf("%s priority = %q, want %q", id, b.Priority, want)
+			}
+		}
+	})
+
+	t.Run("ids narrowed by filter", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		mr := resolver.Mutation()
+		createTestBean(t, core, "b1", "First", "todo")
+		createTestBean(t, core, "b2", "Second", "completed")
+
+		filter := &model.BeanFilter{Status: []string{"todo"}}
+		results, err := mr.UpdateBeans(ctx, []string{"b1", "b2"}, filter, model.UpdateBeanInput{Priority: &high}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("UpdateBeans() returned %d results, want 2", len(results))
+		}
+		for _, r := range results {
+			if (r.Error != nil) != (r.ID == "b2") {
+				t.Errorf("result for %s has error %v, want an error only for b2", r.ID, r.Error)
+			}
+		}
+		if b, _ := core.Get("b1"); b.Priority != "" {
+			t.Errorf("b1 priority = %q, want unchanged when an ID doesn't match", b.Priority)
+		}
+	})
+
+	t.Run("guards see the updated beans", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		mr := resolver.Mutation()
+		core.Config().Workflow = config.WorkflowConfig{
+			Guards: []config.GuardConfig{{Rule: config.GuardChildrenResolved, Statuses: []string{"completed"}}},
+		}
+		createTestBean(t, core, "epic", "Epic", "in-progress")
+		for _, id := range []string{"c1", "c2"} {
+			child := createTestBean(t, core, id, "Child", "todo")
+			child.Parent = "epic"
+			if err := core.Update(child, nil); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+		}
+
+		completed := "completed"
+		results, err := mr.UpdateBeans(ctx, []string{"epic", "c1"}, nil, model.UpdateBeanInput{Status: &completed}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if results[0].Error == nil {
+			t.Error("completing epic with c2 still open should fail")
+		}
+
+		results, err = mr.UpdateBeans(ctx, []string{"epic", "c1", "c2"}, nil, model.UpdateBeanInput{Status: &completed}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		for _, r := range results {
+			if r.Error != nil {
+				t.Errorf("result for %s has error %q, want none", r.ID, *r.Error)
+			}
+		}
+		if b, _ := core.Get("epic"); b.Status != "completed" {
+			t.Errorf("epic status = %q, want completed", b.Status)
+		}
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		mr := resolver.Mutation()
+		createTestBean(t, core, "b1", "First", "todo")
+
+		results, err := mr.UpdateBeans(ctx, []string{"b1"}, nil, model.UpdateBeanInput{Priority: &high}, &dryRun)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if len(results) != 1 || results[0].Bean == nil || results[0].Bean.Priority != "high" || len(results[0].Changes) != 1 {
+			t.Errorf("UpdateBeans() = %+v, want a previewed priority change", results[0])
+		}
+		if b, _ := core.Get("b1"); b.Priority != "" {
+			t.Errorf("priority = %q after dry run, want unchanged", b.Priority)
+		}
+	})
+
+	t.Run("failure writes nothing", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		mr := resolver.Mutation()
+		epic := &bean.Bean{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"}
+		if err := core.Create(epic); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		createTestBean(t, core, "b1", "First", "todo")
+
+		parent := "epic"
+		results, err := mr.UpdateBeans(ctx, []string{"b1", "epic", "missing"}, nil, model.UpdateBeanInput{Parent: &parent}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		failed := 0
+		for _, r := range results {
+			if r.Error != nil {
+				failed++
+			}
+			if r.Bean != nil {
+				t.Errorf("result for %s has a bean, want nil when nothing was written", r.ID)
+			}
+		}
+		if len(results) != 3 || failed != 2 {
+			t.Errorf("UpdateBeans() = %d results with %d failures, want 3 with 2", len(results), failed)
+		}
+		if b, _ := core.Get("b1"); b.Parent != "" {
+			t.Errorf("b1 parent = %q, want unchanged", b.Parent)
+		}
+	})
+
+	t.Run("invalid selection", func(t *testing.T) {
+		resolver, _ := setupTestResolver(t)
+		mr := resolver.Mutation()
+		if _, err := mr.UpdateBeans(ctx, nil, nil, model.UpdateBeanInput{Priority: &high}, nil); err == nil {
+			t.Error("UpdateBeans() without ids or filter should fail")
+		}
+		etag := "abc"
+		if _, err := mr.UpdateBeans(ctx, []string{"b1"}, nil, model.UpdateBeanInput{IfMatch: &etag}, nil); err == nil {
+			t.Error("UpdateBeans() with ifMatch should fail")
+		}
+	})
+}
+
+func TestLoadErrorsQuery(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	createTestBean(t, core, "good", "Good", "todo")
+
+	broken := "---\ntitle: [unclosed\n---\n"
+	if err := os.WriteFile(filepath.Join(core.Root(), "bad1--broken.md"), []byte(broken), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	errs, err := resolver.Query().LoadErrors(ctx)
+	if err != nil {
+		t.Fatalf("LoadErrors() error = %v", err)
+	}
+	if len(errs) != 1 || errs[0].Path != "bad1--broken.md" || errs[0].Error == "" {
+		t.Errorf("LoadErrors() = %+v, want one error for bad1--broken.md", errs)
+	}
+	if b, _ := resolver.Query().Bean(ctx, "good"); b == nil {
+		t.Error("good bean should still load")
+	}
+}
+
+func TestExportQuery(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	createTestBean(t, core, "a1", "First", "todo")
+	createTestBean(t, core, "b2", "Second", "completed")
+
+	status := []string{"todo"}
+	file, err := resolver.Query().Export(ctx, model.ExportFormatCSV, &model.BeanFilter{Status: status}, []string{"id", "title"})
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if want := "id,title\na1,First\n"; file.Content != want {
+		t.Errorf("Content = %q, want %q", file.Content, want)
+	}
+	if file.Filename != "beans.csv" || file.ContentType != "text/csv" {
+		t.Errorf("Filename = %q, ContentType = %q", file.Filename, file.ContentType)
+	}
+
+	file, err = resolver.Query().Export(ctx, model.ExportFormatMarkdownTable, nil, nil)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if !strings.Contains(file.Content, "| a1 | First |") || !strings.Contains(file.Content, "| b2 | Second |") {
+		t.Errorf("markdown table missing beans:\n%s", file.Content)
+	}
+
+	if _, err := resolver.Query().Export(ctx, model.ExportFormatCSV, nil, []string{"nope"}); err == nil {
+		t.Error("Export() with unknown column: expected error")
+	}
+}
+
+func TestSearchBeansQuery(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	createTestBean(t, core, "a1", "Fix login bug", "todo")
+	createTestBean(t, core, "b2", "Login page polish", "completed")
+	createTestBean(t, core, "c3", "Unrelated", "todo")
+
+	limit := 1
+	results, err := resolver.Query().SearchBeans(ctx, "login", &limit)
+	if err != nil {
+		t.Fatalf("SearchBeans() error = %v", err)
+	}
+	if results.Total != 2 {
+		t.Errorf("Total = %d, want 2", results.Total)
+	}
+	if len(results.Hits) != 1 {
+		t.Fatalf("len(Hits) = %d, want 1", len(results.Hits))
+	}
+	hit := results.Hits[0]
+	if hit.Score <= 0 || len(hit.Snippets) == 0 || !strings.Contains(hit.Snippets[0].Text, "<mark>") {
+		t.Errorf("hit = %+v, want score and highlighted snippet", hit)
+	}
+	// Facets count all matches, not just the returned hits
+	if len(results.Facets.Status) != 2 {
+		t.Errorf("Facets.Status = %v, want todo and completed", results.Facets.Status)
+	}
+
+	results, err = resolver.Query().SearchBeans(ctx, "", nil)
+	if err != nil {
+		t.Fatalf("SearchBeans() error = %v", err)
+	}
+	if results.Total != 0 || len(results.Hits) != 0 {
+		t.Errorf("SearchBeans(\"\") = %+v, want no hits", results)
+	}
+}
+
+func TestCreateBeanWithTemplate(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	t.Setenv("BEANS_USER", "ann@example.com")
+
+	templatesDir := filepath.Join(core.Root(), beancore.TemplatesDir)
+	if err := os.MkdirAll(templatesDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	writeTemplate := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(templatesDir, name+".md"), []byte(content), 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	writeTemplate("bug", "---\npriority: high\ntags: [triage]\n---\n\n## Steps to reproduce\n\nReported by {{.User}}\n")
+	writeTemplate("security", "---\ntype: bug\npriority: critical\n---\n\n# {{.Title}}\n")
+
+	t.Run("type template", func(t *testing.T) {
+		beanType := "bug"
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Crash", Type: &beanType, Tags: []string{"ui"}})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if got.Priority != "high" || got.Status != "todo" {
+			t.Errorf("Priority = %q, Status = %q; want high, todo", got.Priority, got.Status)
+		}
+		if !slices.Equal(got.Tags, []string{"ui", "triage"}) {
+			t.Errorf("Tags = %v, want [ui triage]", got.Tags)
+		}
+		if got.Body != "## Steps to reproduce\n\nReported by ann@example.com" {
+			t.Errorf("Body = %q", got.Body)
+		}
+	})
+
+	t.Run("explicit values win", func(t *testing.T) {
+		beanType, priority, body := "bug", "low", "Custom body"
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Typo", Type: &beanType, Priority: &priority, Body: &body})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if got.Priority != "low" || got.Body != "Custom body" {
+			t.Errorf("Priority = %q, Body = %q; want low, Custom body", got.Priority, got.Body)
+		}
+	})
+
+	t.Run("named template", func(t *testing.T) {
+		template := "security"
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "XSS", Template: &template})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if got.Type != "bug" || got.Priority != "critical" || got.Body != "# XSS" {
+			t.Errorf("Type = %q, Priority = %q, Body = %q", got.Type, got.Priority, got.Body)
+		}
+	})
+
+	t.Run("no template for type", func(t *testing.T) {
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Chore"})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if got.Body != "" || got.Priority != "" {
+			t.Errorf("Body = %q, Priority = %q; want empty", got.Body, got.Priority)
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		template := "nope"
+		_, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "X", Template: &template})
+		if !errors.Is(err, beancore.ErrTemplateNotFound) {
+			t.Errorf("CreateBean() error = %v, want ErrTemplateNotFound", err)
+		}
+	})
+}
+
+func TestBeanLinks(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+	br := resolver.Bean()
+
+	createTestBean(t, core, "orig", "Original", "todo")
+	createTestBean(t, core, "dupe", "Duplicate", "todo")
+	createTestBean(t, core, "done", "Done duplicate", "completed")
+	createTestBean(t, core, "newer", "Newer", "todo")
+
+	t.Run("duplicates link scraps the duplicate", func(t *testing.T) {
+		got, err := mr.UpdateBean(ctx, "dupe", model.UpdateBeanInput{
+			AddLinks: []*model.LinkInput{{Type: "duplicates", Target: "orig"}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Status != "scrapped" || !got.HasRelation("duplicates", "orig") {
+			t.Errorf("Status = %q, Relations = %v; want scrapped with duplicates link", got.Status, got.Relations)
+		}
+	})
+
+	t.Run("archived and explicit statuses are kept", func(t *testing.T) {
+		got, err := mr.UpdateBean(ctx, "done", model.UpdateBeanInput{
+			AddLinks: []*model.LinkInput{{Type: "duplicates", Target: "orig"}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Status != "completed" {
+			t.Errorf("Status = %q, want completed", got.Status)
+		}
+
+		status := "draft"
+		created, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:  "Another duplicate",
+			Status: &status,
+			Links:  []*model.LinkInput{{Type: "duplicates", Target: "orig"}},
+		})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		if created.Status != "draft" {
+			t.Errorf("Status = %q, want draft", created.Status)
+		}
+	})
+
+	t.Run("inverse and symmetric fields", func(t *testing.T) {
+		if _, err := mr.UpdateBean(ctx, "newer", model.UpdateBeanInput{
+			AddLinks: []*model.LinkInput{{Type: "supersedes", Target: "orig"}, {Type: "related", Target: "dupe"}},
+		}); err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		orig, _ := core.Get("orig")
+
+		dupes, _ := br.DuplicatedBy(ctx, orig, nil)
+		if len(dupes) != 3 {
+			t.Errorf("duplicatedBy = %d beans, want 3", len(dupes))
+		}
+		superseded, _ := br.SupersededBy(ctx, orig, nil)
+		if len(superseded) != 1 || superseded[0].ID != "newer" {
+			t.Errorf("supersededBy = %v, want [newer]", superseded)
+		}
+		dupe, _ := core.Get("dupe")
+		related, _ := br.Related(ctx, dupe, nil)
+		if len(related) != 1 || related[0].ID != "newer" {
+			t.Errorf("related = %v, want [newer]", related)
+		}
+
+		name := "superseded_by"
+		links, _ := br.Links(ctx, orig, &name)
+		if len(links) != 1 || links[0].Bean.ID != "newer" || !links[0].Incoming {
+			t.Errorf("links(superseded_by) = %+v", links)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		got, err := qr.Beans(ctx, &model.BeanFilter{HasLink: []string{"duplicated_by", "superseded_by"}}, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "orig" {
+			t.Errorf("hasLink = %v, want [orig]", got)
+		}
+		got, _ = qr.Beans(ctx, &model.BeanFilter{NoLink: []string{"duplicates", "related"}}, nil, nil, nil)
+		if len(got) != 1 || got[0].ID != "orig" {
+			t.Errorf("noLink = %v, want [orig]", got)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			link    model.LinkInput
+			wantErr string
+		}{
+			{"unknown type", model.LinkInput{Type: "mentions", Target: "dupe"}, "invalid link type"},
+			{"inverse name", model.LinkInput{Type: "superseded_by", Target: "newer"}, "add a supersedes link to newer instead"},
+			{"self link", model.LinkInput{Type: "related", Target: "orig"}, "cannot link to itself"},
+			{"missing target", model.LinkInput{Type: "related", Target: "nope"}, "not found"},
+			{"cycle", model.LinkInput{Type: "supersedes", Target: "newer"}, "would create cycle"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := mr.UpdateBean(ctx, "orig", model.UpdateBeanInput{AddLinks: []*model.LinkInput{&tt.link}})
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("UpdateBean() error = %v, want %q", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("remove links", func(t *testing.T) {
+		_, err := mr.UpdateBean(ctx, "dupe", model.UpdateBeanInput{
+			RemoveLinks: []*model.LinkInput{{Type: "related", Target: "newer"}},
+		})
+		if err == nil || !strings.Contains(err.Error(), "stored on newer") {
+			t.Errorf("UpdateBean() error = %v, want link stored on newer", err)
+		}
+		got, err := mr.UpdateBean(ctx, "newer", model.UpdateBeanInput{
+			RemoveLinks: []*model.LinkInput{{Type: "related", Target: "dupe"}, {Type: "supersedes", Target: "orig"}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Relations != nil {
+			t.Errorf("Relations = %v, want none", got.Relations)
+		}
+	})
+}
+
+func TestRecurringBeans(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	br := resolver.Bean()
+
+	t.Run("invalid rule is rejected", func(t *testing.T) {
+		recur := "every other week"
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Bad", Recur: &recur}); err == nil {
+			t.Error("CreateBean() expected error for invalid recurrence")
+		}
+	})
+
+	recur := "RRULE:FREQ=WEEKLY;INTERVAL=2"
+	created, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Dependency audit", Recur: &recur})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	if created.Recur != "every 2w" {
+		t.Errorf("Recur = %q, want canonical %q", created.Recur, "every 2w")
+	}
+
+	status := "completed"
+	if _, err := mr.UpdateBean(ctx, created.ID, model.UpdateBeanInput{Status: &status}); err != nil {
+		t.Fatalf("UpdateBean() error = %v", err)
+	}
+	next, err := br.NextOccurrence(ctx, created)
+	if err != nil || next == nil {
+		t.Fatalf("NextOccurrence() = %v, %v; want next occurrence", next, err)
+	}
+	if next.Status != "todo" || next.Title != created.Title || next.Due == nil {
+		t.Errorf("next occurrence = %+v", next)
+	}
+	previous, err := br.Previous(ctx, next)
+	if err != nil || previous == nil || previous.ID != created.ID {
+		t.Errorf("Previous() = %v, %v; want %s", previous, err, created.ID)
+	}
+
+	t.Run("staying finished doesn't recur again", func(t *testing.T) {
+		scrapped := "scrapped"
+		if _, err := mr.UpdateBean(ctx, created.ID, model.UpdateBeanInput{Status: &scrapped}); err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got, _ := br.NextOccurrence(ctx, created); got == nil || got.ID != next.ID {
+			t.Errorf("NextOccurrence() = %v, want %s", got, next.ID)
+		}
+	})
+
+	t.Run("scrapping ends the series", func(t *testing.T) {
+		chore, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Duplicate chore", Recur: &recur})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		scrapped := "scrapped"
+		if _, err := mr.UpdateBean(ctx, chore.ID, model.UpdateBeanInput{Status: &scrapped}); err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got, _ := br.NextOccurrence(ctx, chore); got != nil {
+			t.Errorf("NextOccurrence() = %s, want none for a scrapped bean", got.ID)
+		}
+	})
+
+	t.Run("clearing the rule", func(t *testing.T) {
+		empty := ""
+		got, err := mr.UpdateBean(ctx, next.ID, model.UpdateBeanInput{Recur: &empty, Status: &status})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Recur != "" {
+			t.Errorf("Recur = %q, want empty", got.Recur)
+		}
+		if following, _ := br.NextOccurrence(ctx, got); following != nil {
+			t.Errorf("NextOccurrence() = %v, want nil", following)
+		}
+	})
+}
+
+func TestBeansOrderAndPagination(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	qr := resolver.Query()
+	br := resolver.Bean()
+
+	parent := createTestBean(t, core, "p", "Parent", "todo")
+	for _, id := range []string{"c1", "c2", "c3", "c4", "c5"} {
+		b := createTestBean(t, core, id, "Child "+id, "todo")
+		b.Parent = "p"
+		if err := core.Update(b, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	ids := func(beans []*bean.Bean) string {
+		var s []string
+		for _, b := range beans {
+			s = append(s, b.ID)
+		}
+		return strings.Join(s, ",")
+	}
+	desc := model.SortDirectionDesc
+	byIDDesc := []*model.BeanOrder{{Field: model.BeanOrderFieldID, Direction: &desc}}
+	two := 2
+
+	t.Run("orderBy and first", func(t *testing.T) {
+		got, err := qr.Beans(ctx, nil, byIDDesc, &two, nil)
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if ids(got) != "p,c5" {
+			t.Errorf("Beans() = %s, want p,c5", ids(got))
+		}
+	})
+
+	t.Run("connection pages through all beans", func(t *testing.T) {
+		parentID := "p"
+		filter := &model.BeanFilter{ParentID: &parentID}
+		var seen []string
+		var after *string
+		for page := 0; ; page++ {
+			conn, err := qr.BeansConnection(ctx, filter, byIDDesc, &two, after)
+			if err != nil {
+				t.Fatalf("BeansConnection() error = %v", err)
+			}
+			if conn.TotalCount != 5 {
+				t.Errorf("TotalCount = %d, want 5", conn.TotalCount)
+			}
+			if conn.PageInfo.HasPreviousPage != (page > 0) {
+				t.Errorf("page %d: HasPreviousPage = %v", page, conn.PageInfo.HasPreviousPage)
+			}
+			seen = append(seen, ids(conn.Nodes))
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+		if got := strings.Join(seen, "|"); got != "c5,c4|c3,c2|c1" {
+			t.Errorf("pages = %s, want c5,c4|c3,c2|c1", got)
+		}
+	})
+
+	t.Run("tied beans page in a fixed order", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		qr := resolver.Query()
+		for i := range 20 {
+			createTestBean(t, core, fmt.Sprintf("same-%02d", i), "Same title", "todo")
+		}
+		three := 3
+		for round := range 5 {
+			seen := make(map[string]int)
+			var after *string
+			for {
+				conn, err := qr.BeansConnection(ctx, nil, nil, &three, after)
+				if err != nil {
+					t.Fatalf("BeansConnection() error = %v", err)
+				}
+				for _, b := range conn.Nodes {
+					seen[b.ID]++
+				}
+				if !conn.PageInfo.HasNextPage {
+					break
+				}
+				after = conn.PageInfo.EndCursor
+			}
+			if len(seen) != 20 {
+				t.Errorf("round %d: visited %d beans, want 20", round, len(seen))
+			}
+			for id, n := range seen {
+				if n != 1 {
+					t.Errorf("round %d: visited %s %d times", round, id, n)
+				}
+			}
+		}
+	})
+
+	t.Run("paging continues after the cursor bean changes", func(t *testing.T) {
+		resolver, core := setupTestResolver(t)
+		qr := resolver.Query()
+		for _, id := range []string{"a", "b", "c", "d", "e", "f"} {
+			createTestBean(t, core, id, "Bean "+id, "todo")
+		}
+		byID := []*model.BeanOrder{{Field: model.BeanOrderFieldID}}
+		filter := &model.BeanFilter{Status: []string{"todo"}}
+		page := func(after *string) *model.BeanConnection {
+			t.Helper()
+			conn, err := qr.BeansConnection(ctx, filter, byID, &two, after)
+			if err != nil {
+				t.Fatalf("BeansConnection() error = %v", err)
+			}
+			return conn
+		}
+
+		first := page(nil)
+		if ids(first.Nodes) != "a,b" {
+			t.Fatalf("first page = %s, want a,b", ids(first.Nodes))
+		}
+		// The cursor bean drops out of the filter
+		b, _ := core.Get("b")
+		b.Status = "completed"
+		if err := core.Update(b, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		second := page(first.PageInfo.EndCursor)
+		if ids(second.Nodes) != "c,d" {
+			t.Errorf("second page = %s, want c,d", ids(second.Nodes))
+		}
+		// The cursor bean is deleted
+		if err := core.Delete("d"); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if third := page(second.PageInfo.EndCursor); ids(third.Nodes) != "e,f" {
+			t.Errorf("third page = %s, want e,f", ids(third.Nodes))
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		cursor := "nope"
+		if _, err := qr.BeansConnection(ctx, nil, nil, nil, &cursor); err == nil {
+			t.Error("BeansConnection() expected error for invalid cursor")
+		}
+	})
+
+	t.Run("children", func(t *testing.T) {
+		got, err := br.Children(ctx, parent, nil, byIDDesc, &two)
+		if err != nil {
+			t.Fatalf("Children() error = %v", err)
+		}
+		if ids(got) != "c5,c4" {
+			t.Errorf("Children() = %s, want c5,c4", ids(got))
+		}
+	})
+}
+
+func TestBeanStats(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	qr := resolver.Query()
+
+	for _, b := range []*bean.Bean{
+		{ID: "a", Title: "A", Status: "todo", Type: "bug", Tags: []string{"ui", "api"}, Estimate: 2},
+		{ID: "b", Title: "B", Status: "todo", Type: "task", Tags: []string{"api"}, Estimate: 3},
+		{ID: "c", Title: "C", Status: "completed", Type: "bug"},
+		{ID: "d", Title: "D", Status: "in-progress", Type: "bug", Parent: "a"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	str := func(s *string) string {
+		if s == nil {
+			return "-"
+		}
+		return *s
+	}
+	est := func(f *float64) string {
+		if f == nil {
+			return "-"
+		}
+		return strconv.FormatFloat(*f, 'f', -1, 64)
+	}
+
+	t.Run("totals", func(t *testing.T) {
+		stats, err := qr.BeanStats(ctx, nil, nil)
+		if err != nil {
+			t.Fatalf("BeanStats() error = %v", err)
+		}
+		if stats.Count != 4 || est(stats.Estimate) != "5" || len(stats.Groups) != 0 {
+			t.Errorf("BeanStats() = %d, %s, %d groups; want 4, 5, 0 groups", stats.Count, est(stats.Estimate), len(stats.Groups))
+		}
+	})
+
+	t.Run("grouped in configured order", func(t *testing.T) {
+		stats, err := qr.BeanStats(ctx, nil, []model.BeanStatsGroupBy{model.BeanStatsGroupByType, model.BeanStatsGroupByStatus})
+		if err != nil {
+			t.Fatalf("BeanStats() error = %v", err)
+		}
+		var got []string
+		for _, g := range stats.Groups {
+			got = append(got, fmt.Sprintf("%s/%s:%d:%s", str(g.Type), str(g.Status), g.Count, est(g.Estimate)))
+		}
+		want := "bug/in-progress:1:-,bug/todo:1:2,bug/completed:1:-,task/todo:1:3"
+		if strings.Join(got, ",") != want {
+			t.Errorf("groups = %s, want %s", strings.Join(got, ","), want)
+		}
+	})
+
+	t.Run("tags and parents with filter", func(t *testing.T) {
+		filter := &model.BeanFilter{Type: []string{"bug"}}
+		stats, err := qr.BeanStats(ctx, filter, []model.BeanStatsGroupBy{model.BeanStatsGroupByTag})
+		if err != nil {
+			t.Fatalf("BeanStats() error = %v", err)
+		}
+		var got []string
+		for _, g := range stats.Groups {
+			got = append(got, fmt.Sprintf("%s:%d", str(g.Tag), g.Count))
+		}
+		if want := "api:1,ui:1,-:2"; strings.Join(got, ",") != want {
+			t.Errorf("groups = %s, want %s", strings.Join(got, ","), want)
+		}
+
+		stats, _ = qr.BeanStats(ctx, filter, []model.BeanStatsGroupBy{model.BeanStatsGroupByParent})
+		if len(stats.Groups) != 2 || str(stats.Groups[0].Parent) != "a" || stats.Groups[1].Parent != nil {
+			t.Errorf("parent groups = %+v", stats.Groups)
+		}
+	})
+
+	t.Run("duplicate groupBy", func(t *testing.T) {
+		if _, err := qr.BeanStats(ctx, nil, []model.BeanStatsGroupBy{model.BeanStatsGroupByTag, model.BeanStatsGroupByTag}); err == nil {
+			t.Error("BeanStats() expected error for duplicate groupBy")
+		}
+	})
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	qr := resolver.Query()
+
+	t.Run("no dispatcher", func(t *testing.T) {
+		got, err := qr.WebhookDeliveries(ctx, nil, nil, nil)
+		if err != nil || len(got) != 0 {
+			t.Errorf("WebhookDeliveries() = %v, %v; want none", got, err)
+		}
+	})
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer receiver.Close()
+
+	if err := core.StartWatching(); err != nil {
+		t.Fatalf("StartWatching() error = %v", err)
+	}
+	defer core.Unwatch()
+	dispatcher, err := webhook.New(core, []config.WebhookConfig{
+		{Name: "ok", URL: receiver.URL + "/ok"},
+		{Name: "broken", URL: receiver.URL + "/broken"},
+	})
+	if err != nil {
+		t.Fatalf("webhook.New() error = %v", err)
+	}
+	dispatcher.Start(ctx)
+	resolver.Webhooks = dispatcher
+
+	createTestBean(t, core, "hook-1", "Hooked", "todo")
+
+	failed := model.WebhookDeliveryStatusFailed
+	var got []*model.WebhookDelivery
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if got, err = qr.WebhookDeliveries(ctx, nil, &failed, nil); err != nil || len(got) > 0 {
+			break
+		}
+	}
+	if err != nil || len(got) != 1 {
+		t.Fatalf("WebhookDeliveries(FAILED) = %v, %v; want one", got, err)
+	}
+	d := got[0]
+	if d.Webhook != "broken" || d.BeanID != "hook-1" || d.Event != "created" || d.StatusCode == nil || *d.StatusCode != http.StatusBadRequest || d.Error == nil {
+		t.Errorf("failed delivery = %+v", d)
+	}
+
+	name := "ok"
+	if got, _ := qr.WebhookDeliveries(ctx, &name, nil, nil); len(got) != 1 || got[0].Webhook != "ok" {
+		t.Errorf("WebhookDeliveries(ok) = %v", got)
+	}
+}
@@ -0,0 +1,302 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// Supported import formats.
+const (
+	FormatGitHubJSON = "github-json"
+	FormatCSV        = "csv"
+	FormatJSONL      = "jsonl"
+)
+
+// Formats lists the supported import formats.
+var Formats = []string{FormatGitHubJSON, FormatCSV, FormatJSONL}
+
+// blockedByPattern matches "blocked by #12" (also "blocked by: #12, #13 and #14")
+// references in issue bodies.
+var blockedByPattern = regexp.MustCompile(`(?i)\bblocked\s+by:?\s+(#\d+(?:\s*(?:,|and)\s*#\d+)*)`)
+
+var issueRefPattern = regexp.MustCompile(`#(\d+)`)
+
+// Parse reads records in the given format.
+func Parse(format string, r io.Reader) ([]Record, error) {
+	switch format {
+	case FormatGitHubJSON:
+		return parseGitHubJSON(r)
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatJSONL:
+		return parseJSONL(r)
+	default:
+		return nil, fmt.Errorf("unknown import format: %s (must be %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// githubIssue is an issue as exported by `gh issue list --json ...` or the
+// GitHub REST API (which uses snake_case for some keys).
+type githubIssue struct {
+	Number         int    `json:"number"`
+	URL            string `json:"url"`
+	HTMLURL        string `json:"html_url"`
+	Title          string `json:"title"`
+	Body           string `json:"body"`
+	State          string `json:"state"`
+	StateReason    string `json:"stateReason"`
+	StateReasonAPI string `json:"state_reason"`
+	Labels         []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		Title    string `json:"title"`
+		DueOn    string `json:"dueOn"`
+		DueOnAPI string `json:"due_on"`
+	} `json:"milestone"`
+	Assignees []struct {
+		Login string `json:"login"`
+	} `json:"assignees"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// parseGitHubJSON reads a JSON array of GitHub issues, e.g. from
+// `gh issue list --state all --json number,url,title,body,state,stateReason,labels,milestone,assignees`.
+func parseGitHubJSON(r io.Reader) ([]Record, error) {
+	var issues []githubIssue
+	if err := json.NewDecoder(r).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("parsing GitHub issues: %w", err)
+	}
+
+	// Issue numbers are resolved to keys after all issues are known
+	keys := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		keys[strconv.Itoa(issue.Number)] = githubKey(issue)
+	}
+
+	var records []Record
+	for _, issue := range issues {
+		// The REST API lists pull requests as issues
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+
+		rec := Record{
+			Key:    githubKey(issue),
+			Title:  issue.Title,
+			Body:   issue.Body,
+			Status: issue.State,
+		}
+		reason := strings.ToLower(issue.StateReason + issue.StateReasonAPI)
+		if strings.EqualFold(issue.State, "closed") && reason == "not_planned" {
+			rec.Status = "not_planned"
+		}
+		for _, l := range issue.Labels {
+			rec.Labels = append(rec.Labels, l.Name)
+		}
+		if issue.Milestone != nil && issue.Milestone.Title != "" {
+			rec.Milestone = issue.Milestone.Title
+			rec.MilestoneDue = parseDueOn(issue.Milestone.DueOn + issue.Milestone.DueOnAPI)
+		}
+		for _, a := range issue.Assignees {
+			rec.Assignees = append(rec.Assignees, a.Login)
+		}
+		for _, n := range blockedByRefs(issue.Body) {
+			if key, ok := keys[n]; ok {
+				rec.BlockedBy = append(rec.BlockedBy, key)
+			} else {
+				rec.BlockedBy = append(rec.BlockedBy, "#"+n)
+			}
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// githubKey returns the stable import key of a GitHub issue: its URL, or its
+// number if the export doesn't include URLs.
+func githubKey(issue githubIssue) string {
+	if issue.URL != "" {
+		return issue.URL
+	}
+	if issue.HTMLURL != "" {
+		return issue.HTMLURL
+	}
+	return "github#" + strconv.Itoa(issue.Number)
+}
+
+// parseDueOn parses a GitHub milestone due date (an RFC 3339 timestamp).
+func parseDueOn(value string) *bean.Date {
+	if value == "" {
+		return nil
+	}
+	d, err := bean.ParseDate(value[:min(len(value), 10)], bean.Today())
+	if err != nil {
+		return nil
+	}
+	return &d
+}
+
+// blockedByRefs returns the issue numbers referenced as "blocked by #N" in body.
+func blockedByRefs(body string) []string {
+	var refs []string
+	for _, m := range blockedByPattern.FindAllStringSubmatch(body, -1) {
+		for _, ref := range issueRefPattern.FindAllStringSubmatch(m[1], -1) {
+			refs = append(refs, ref[1])
+		}
+	}
+	return refs
+}
+
+// parseCSV reads a CSV file with a header row. Recognized columns (case
+// insensitive): id, title, body (or description), status (or state), type,
+// priority, tags (or labels), due, milestone, blocked_by, and assignees (or
+// assignee). List cells are separated by commas or semicolons.
+func parseCSV(r io.Reader) ([]Record, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	var records []Record
+	for i, row := range rows[1:] {
+		values := make(map[string]any, len(header))
+		for j, name := range header {
+			if j < len(row) {
+				values[name] = row[j]
+			}
+		}
+		rec, err := recordFromMap(values)
+		if err != nil {
+			return nil, fmt.Errorf("CSV row %d: %w", i+2, err)
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// parseJSONL reads one JSON object per line, with the same keys as the CSV
+// columns. List values may be JSON arrays or comma-separated strings.
+func parseJSONL(r io.Reader) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var values map[string]any
+		if err := json.Unmarshal([]byte(text), &values); err != nil {
+			return nil, fmt.Errorf("JSONL line %d: %w", line, err)
+		}
+		rec, err := recordFromMap(values)
+		if err != nil {
+			return nil, fmt.Errorf("JSONL line %d: %w", line, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading JSONL: %w", err)
+	}
+	return records, nil
+}
+
+// recordFromMap builds a record from a generic row of named values.
+func recordFromMap(values map[string]any) (Record, error) {
+	get := func(names ...string) any {
+		for key, v := range values {
+			normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+			for _, name := range names {
+				if normalized == name {
+					return v
+				}
+			}
+		}
+		return nil
+	}
+
+	rec := Record{
+		Key:       stringValue(get("id", "key")),
+		Title:     stringValue(get("title")),
+		Body:      stringValue(get("body", "description")),
+		Status:    stringValue(get("status", "state")),
+		Type:      stringValue(get("type")),
+		Priority:  stringValue(get("priority")),
+		Milestone: stringValue(get("milestone")),
+		Labels:    listValue(get("tags", "labels")),
+		BlockedBy: listValue(get("blocked_by", "blockedby")),
+		Assignees: listValue(get("assignees", "assignee")),
+	}
+	if rec.Title == "" {
+		return rec, fmt.Errorf("missing title")
+	}
+	if due := stringValue(get("due", "due_date")); due != "" {
+		d, err := bean.ParseDate(due, bean.Today())
+		if err != nil {
+			return rec, fmt.Errorf("due: %w", err)
+		}
+		rec.Due = &d
+	}
+	// Without an ID, the title identifies the record on re-import
+	if rec.Key == "" {
+		rec.Key = rec.Title
+	}
+	for _, n := range blockedByRefs(rec.Body) {
+		rec.BlockedBy = append(rec.BlockedBy, n)
+	}
+	return rec, nil
+}
+
+// stringValue converts a scalar JSON or CSV value to a trimmed string.
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
+
+// listValue converts a JSON array or a comma/semicolon-separated string to a list.
+func listValue(v any) []string {
+	var items []string
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		for _, item := range v {
+			items = append(items, stringValue(item))
+		}
+	default:
+		items = strings.FieldsFunc(stringValue(v), func(r rune) bool { return r == ',' || r == ';' })
+	}
+
+	var result []string
+	for _, item := range items {
+		if item = strings.TrimPrefix(strings.TrimSpace(item), "#"); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
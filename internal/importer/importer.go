@@ -0,0 +1,308 @@
+// Package importer maps issues exported from other trackers (GitHub issues,
+// generic CSV and JSONL files) to beans.
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// ImportedFromField is the front matter field recording the source key of an
+// imported bean, so that a re-import updates the bean instead of duplicating it.
+const ImportedFromField = "imported_from"
+
+// Record is an issue read from an import file.
+type Record struct {
+	// Key identifies the issue in its source (e.g. the GitHub issue URL).
+	Key          string
+	Title        string
+	Body         string
+	Status       string // source status or state, mapped to a configured status
+	Type         string
+	Priority     string
+	Labels       []string // mapped to type, priority, or tags
+	Due          *bean.Date
+	Milestone    string // title of the milestone; becomes a milestone bean
+	MilestoneDue *bean.Date
+	BlockedBy    []string // keys of the issues blocking this one
+	Assignees    []string
+}
+
+// ActionKind describes what an import does with a record.
+type ActionKind string
+
+const (
+	ActionCreate    ActionKind = "create"
+	ActionUpdate    ActionKind = "update"
+	ActionUnchanged ActionKind = "unchanged"
+)
+
+// Action is the planned outcome of importing a single record.
+type Action struct {
+	Kind    ActionKind             `json:"action"`
+	Key     string                 `json:"key"`
+	Bean    *bean.Bean             `json:"bean"`
+	Changes []beancore.FieldChange `json:"changes,omitempty"`
+}
+
+// Plan is the set of changes an import will make.
+type Plan struct {
+	Actions  []Action
+	Warnings []string
+}
+
+// Count returns the number of actions of the given kind.
+func (p *Plan) Count(kind ActionKind) int {
+	n := 0
+	for _, a := range p.Actions {
+		if a.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// NewPlan maps records to beans. Records whose key matches the
+// ImportedFromField of an existing bean update that bean; others create new
+// beans with IDs from newID. Milestones become milestone beans (one per title)
+// that are set as the parent of their issues where the type rules allow it.
+func NewPlan(records []Record, existing []*bean.Bean, cfg *config.Config, newID func() (string, error)) (*Plan, error) {
+	plan := &Plan{}
+	warn := func(format string, args ...any) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	byKey := make(map[string]*bean.Bean)
+	for _, b := range existing {
+		if key := b.Fields[ImportedFromField]; key != "" {
+			byKey[key] = b
+		}
+	}
+
+	// Milestones are imported before the issues that belong to them
+	var all []Record
+	seenMilestones := make(map[string]bool)
+	for _, rec := range records {
+		if rec.Milestone != "" && !seenMilestones[rec.Milestone] {
+			seenMilestones[rec.Milestone] = true
+			all = append(all, Record{
+				Key:   milestoneKey(rec.Milestone),
+				Title: rec.Milestone,
+				Type:  "milestone",
+				Due:   rec.MilestoneDue,
+			})
+		}
+	}
+	all = append(all, records...)
+
+	// First pass: find or allocate the bean for every record, so that
+	// references between records can be resolved to IDs
+	type target struct {
+		rec      Record
+		original *bean.Bean // nil for new beans
+		bean     *bean.Bean
+	}
+	var targets []target
+	ids := make(map[string]string)
+	for _, rec := range all {
+		if _, dup := ids[rec.Key]; dup {
+			warn("skipping duplicate record %s", rec.Key)
+			continue
+		}
+		t := target{rec: rec}
+		if b, ok := byKey[rec.Key]; ok {
+			t.original = b
+			t.bean = b.Clone()
+		} else {
+			id, err := newID()
+			if err != nil {
+				return nil, err
+			}
+			t.bean = &bean.Bean{ID: id, Slug: bean.Slugify(rec.Title), Status: cfg.GetDefaultStatus(), Type: cfg.GetDefaultType()}
+		}
+		ids[rec.Key] = t.bean.ID
+		targets = append(targets, t)
+	}
+
+	// Second pass: apply the records
+	for _, t := range targets {
+		rec, b := t.rec, t.bean
+		b.Title = rec.Title
+		// Bodies are compared trimmed, since saving normalizes surrounding newlines
+		if (rec.Body != "" || t.original == nil) && strings.TrimSpace(rec.Body) != strings.TrimSpace(b.Body) {
+			b.Body = rec.Body
+		}
+		if status := mapStatus(rec.Status, cfg); status != "" {
+			b.Status = status
+		} else if rec.Status != "" {
+			warn("%s: unknown status %q, keeping %s", rec.Key, rec.Status, b.Status)
+		}
+		if rec.Type != "" {
+			if cfg.IsValidType(rec.Type) {
+				b.Type = rec.Type
+			} else {
+				warn("%s: unknown type %q, keeping %s", rec.Key, rec.Type, b.Type)
+			}
+		}
+		if rec.Priority != "" {
+			if cfg.IsValidPriority(rec.Priority) {
+				b.Priority = rec.Priority
+			} else {
+				warn("%s: unknown priority %q", rec.Key, rec.Priority)
+			}
+		}
+
+		// Labels naming a type or priority set it; all others become tags
+		for _, label := range rec.Labels {
+			name := normalizeLabel(label)
+			switch {
+			case rec.Type == "" && cfg.IsValidType(name):
+				b.Type = name
+			case rec.Priority == "" && cfg.IsValidPriority(name):
+				b.Priority = name
+			case bean.ValidateTag(name) == nil:
+				if !b.HasTag(name) {
+					b.Tags = append(b.Tags, name)
+				}
+			default:
+				warn("%s: skipping label %q (not a valid tag)", rec.Key, label)
+			}
+		}
+
+		if rec.Due != nil {
+			b.Due = rec.Due
+		}
+		if rec.Milestone != "" {
+			if parents := cfg.ValidParentTypes(b.Type); slices.Contains(parents, "milestone") {
+				b.Parent = ids[milestoneKey(rec.Milestone)]
+			} else {
+				warn("%s: %s beans can't have a milestone as parent, skipping milestone %q", rec.Key, b.Type, rec.Milestone)
+			}
+		}
+		for _, key := range rec.BlockedBy {
+			id, ok := ids[key]
+			if !ok {
+				if existing, found := byKey[key]; found {
+					id, ok = existing.ID, true
+				}
+			}
+			if !ok {
+				warn("%s: blocked by %s, which is not part of the import", rec.Key, key)
+				continue
+			}
+			if id != b.ID && !b.IsBlockedBy(id) {
+				b.AddBlockedBy(id)
+			}
+		}
+		for _, a := range rec.Assignees {
+			if !slices.Contains(b.Assignees, a) {
+				b.Assignees = append(b.Assignees, a)
+			}
+		}
+
+		if b.Fields == nil {
+			b.Fields = make(map[string]string)
+		}
+		b.Fields[ImportedFromField] = rec.Key
+
+		action := Action{Key: rec.Key, Bean: b}
+		switch {
+		case t.original == nil:
+			action.Kind = ActionCreate
+		default:
+			action.Changes = beancore.Diff(t.original, b)
+			action.Kind = ActionUpdate
+			if len(action.Changes) == 0 {
+				action.Kind = ActionUnchanged
+			}
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+
+	return plan, nil
+}
+
+// PlaceholderIDs returns an ID generator for previewing an import without
+// applying it. The IDs of new beans are random and only generated for the
+// import that creates them, so a preview can't show them; it uses the
+// placeholders "new:1", "new:2", ... instead, which can't be mistaken for
+// real IDs but still show how new beans refer to each other.
+func PlaceholderIDs() func() (string, error) {
+	n := 0
+	return func() (string, error) {
+		n++
+		return fmt.Sprintf("new:%d", n), nil
+	}
+}
+
+// Apply writes the planned beans: new beans are created, changed beans updated.
+func (p *Plan) Apply(core *beancore.Core) error {
+	for _, a := range p.Actions {
+		switch a.Kind {
+		case ActionCreate:
+			if err := core.Create(a.Bean); err != nil {
+				return fmt.Errorf("creating bean for %s: %w", a.Key, err)
+			}
+		case ActionUpdate:
+			if err := core.Update(a.Bean, nil); err != nil {
+				return fmt.Errorf("updating bean %s for %s: %w", a.Bean.ID, a.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// milestoneKey returns the import key of the milestone bean with the given title.
+func milestoneKey(title string) string {
+	return "milestone:" + title
+}
+
+// statusAliases maps common status names of other trackers to built-in statuses.
+var statusAliases = map[string]string{
+	"open":        "todo",
+	"new":         "todo",
+	"backlog":     "todo",
+	"to-do":       "todo",
+	"closed":      "completed",
+	"done":        "completed",
+	"resolved":    "completed",
+	"fixed":       "completed",
+	"complete":    "completed",
+	"doing":       "in-progress",
+	"started":     "in-progress",
+	"active":      "in-progress",
+	"wip":         "in-progress",
+	"not-planned": "scrapped",
+	"wontfix":     "scrapped",
+	"won-t-fix":   "scrapped",
+	"cancelled":   "scrapped",
+	"canceled":    "scrapped",
+	"duplicate":   "scrapped",
+	"invalid":     "scrapped",
+}
+
+// mapStatus maps a source status to a configured status. Returns "" if the
+// status is empty or unknown.
+func mapStatus(status string, cfg *config.Config) string {
+	name := normalizeLabel(status)
+	if name == "" || cfg.IsValidStatus(name) {
+		return name
+	}
+	if alias, ok := statusAliases[name]; ok && cfg.IsValidStatus(alias) {
+		return alias
+	}
+	return ""
+}
+
+var nonTagChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// normalizeLabel converts a label to tag form: lowercase words joined by hyphens.
+func normalizeLabel(label string) string {
+	return strings.Trim(nonTagChars.ReplaceAllString(strings.ToLower(label), "-"), "-")
+}
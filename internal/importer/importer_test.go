@@ -0,0 +1,289 @@
+package importer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+const githubIssues = `[
+  {"number": 1, "url": "https://github.com/o/r/issues/1", "title": "Login page",
+   "body": "Needs design.\n\nBlocked by #2", "state": "OPEN",
+   "labels": [{"name": "bug"}, {"name": "Good First Issue"}],
+   "milestone": {"title": "v1.0", "dueOn": "2026-12-01T00:00:00Z"},
+   "assignees": [{"login": "alice"}]},
+  {"number": 2, "url": "https://github.com/o/r/issues/2", "title": "Design system",
+   "state": "CLOSED", "stateReason": "COMPLETED", "labels": [{"name": "feature"}]},
+  {"number": 3, "url": "https://github.com/o/r/issues/3", "title": "Dark mode",
+   "state": "CLOSED", "stateReason": "NOT_PLANNED", "milestone": {"title": "v1.0"}},
+  {"number": 4, "html_url": "https://github.com/o/r/pull/4", "title": "A pull request",
+   "state": "open", "pull_request": {"url": "x"}}
+]`
+
+func TestParseGitHubJSON(t *testing.T) {
+	records, err := Parse(FormatGitHubJSON, strings.NewReader(githubIssues))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3 (pull requests skipped)", len(records))
+	}
+
+	login := records[0]
+	if login.Key != "https://github.com/o/r/issues/1" || login.Status != "OPEN" {
+		t.Errorf("Key = %q, Status = %q", login.Key, login.Status)
+	}
+	if want := []string{"bug", "Good First Issue"}; !slices.Equal(login.Labels, want) {
+		t.Errorf("Labels = %v, want %v", login.Labels, want)
+	}
+	if login.Milestone != "v1.0" || login.MilestoneDue == nil || login.MilestoneDue.String() != "2026-12-01" {
+		t.Errorf("Milestone = %q, MilestoneDue = %v", login.Milestone, login.MilestoneDue)
+	}
+	if want := []string{"https://github.com/o/r/issues/2"}; !slices.Equal(login.BlockedBy, want) {
+		t.Errorf("BlockedBy = %v, want %v", login.BlockedBy, want)
+	}
+	if want := []string{"alice"}; !slices.Equal(login.Assignees, want) {
+		t.Errorf("Assignees = %v, want %v", login.Assignees, want)
+	}
+	if records[2].Status != "not_planned" {
+		t.Errorf("closed as not planned: Status = %q, want not_planned", records[2].Status)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	input := "ID,Title,State,Labels,Blocked-By,Due\n" +
+		"A-1,Write docs,doing,\"docs; high\",A-2,2026-11-01\n" +
+		",Set up site,done,,,\n"
+	records, err := Parse(FormatCSV, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	r := records[0]
+	if r.Key != "A-1" || r.Title != "Write docs" || r.Status != "doing" {
+		t.Errorf("record = %+v", r)
+	}
+	if want := []string{"docs", "high"}; !slices.Equal(r.Labels, want) {
+		t.Errorf("Labels = %v, want %v", r.Labels, want)
+	}
+	if want := []string{"A-2"}; !slices.Equal(r.BlockedBy, want) {
+		t.Errorf("BlockedBy = %v, want %v", r.BlockedBy, want)
+	}
+	if r.Due == nil || r.Due.String() != "2026-11-01" {
+		t.Errorf("Due = %v, want 2026-11-01", r.Due)
+	}
+	if records[1].Key != "Set up site" {
+		t.Errorf("Key without id = %q, want the title", records[1].Key)
+	}
+
+	if _, err := Parse(FormatCSV, strings.NewReader("id,title\n1,\n")); err == nil {
+		t.Error("Parse() without title: expected error")
+	}
+}
+
+func TestParseJSONL(t *testing.T) {
+	input := `{"id": 7, "title": "Fix crash", "tags": ["bug", "urgent"], "body": "blocked by #6"}
+
+{"id": "6", "title": "Upgrade library", "assignees": "alice, bob"}
+`
+	records, err := Parse(FormatJSONL, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	if records[0].Key != "7" || !slices.Equal(records[0].Labels, []string{"bug", "urgent"}) {
+		t.Errorf("record = %+v", records[0])
+	}
+	if want := []string{"6"}; !slices.Equal(records[0].BlockedBy, want) {
+		t.Errorf("BlockedBy = %v, want %v", records[0].BlockedBy, want)
+	}
+	if want := []string{"alice", "bob"}; !slices.Equal(records[1].Assignees, want) {
+		t.Errorf("Assignees = %v, want %v", records[1].Assignees, want)
+	}
+
+	if _, err := Parse(FormatJSONL, strings.NewReader("{not json}\n")); err == nil {
+		t.Error("Parse() with invalid JSON: expected error")
+	}
+}
+
+// sequentialIDs returns an ID generator yielding imp-1, imp-2, ...
+func sequentialIDs() func() (string, error) {
+	n := 0
+	return func() (string, error) {
+		n++
+		return fmt.Sprintf("imp-%d", n), nil
+	}
+}
+
+func TestNewPlan(t *testing.T) {
+	cfg := config.Default()
+	records, err := Parse(FormatGitHubJSON, strings.NewReader(githubIssues))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	plan, err := NewPlan(records, nil, cfg, sequentialIDs())
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	if len(plan.Actions) != 4 || plan.Count(ActionCreate) != 4 {
+		t.Fatalf("got %d actions (%d creates), want 4 creates", len(plan.Actions), plan.Count(ActionCreate))
+	}
+
+	byKey := make(map[string]*bean.Bean)
+	for _, a := range plan.Actions {
+		byKey[a.Key] = a.Bean
+	}
+
+	milestone := byKey["milestone:v1.0"]
+	if milestone == nil || milestone.Type != "milestone" || milestone.Due == nil {
+		t.Fatalf("milestone bean = %+v", milestone)
+	}
+
+	login := byKey["https://github.com/o/r/issues/1"]
+	if login.Type != "bug" || login.Status != "todo" {
+		t.Errorf("Type = %q, Status = %q, want bug/todo", login.Type, login.Status)
+	}
+	if want := []string{"good-first-issue"}; !slices.Equal(login.Tags, want) {
+		t.Errorf("Tags = %v, want %v", login.Tags, want)
+	}
+	if login.Parent != milestone.ID {
+		t.Errorf("Parent = %q, want milestone %q", login.Parent, milestone.ID)
+	}
+	if login.Due != nil {
+		t.Errorf("Due = %v, want the milestone's due date only on the milestone", login.Due)
+	}
+	design := byKey["https://github.com/o/r/issues/2"]
+	if want := []string{design.ID}; !slices.Equal(login.BlockedBy, want) {
+		t.Errorf("BlockedBy = %v, want %v", login.BlockedBy, want)
+	}
+	if design.Status != "completed" || design.Type != "feature" {
+		t.Errorf("design: Status = %q, Type = %q, want completed/feature", design.Status, design.Type)
+	}
+	if status := byKey["https://github.com/o/r/issues/3"].Status; status != "scrapped" {
+		t.Errorf("not planned: Status = %q, want scrapped", status)
+	}
+	if login.Fields[ImportedFromField] != "https://github.com/o/r/issues/1" {
+		t.Errorf("imported_from = %q", login.Fields[ImportedFromField])
+	}
+}
+
+func TestNewPlanReimport(t *testing.T) {
+	cfg := config.Default()
+	records, err := Parse(FormatGitHubJSON, strings.NewReader(githubIssues))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	first, err := NewPlan(records, nil, cfg, sequentialIDs())
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	var existing []*bean.Bean
+	for _, a := range first.Actions {
+		existing = append(existing, a.Bean)
+	}
+
+	newID := func() (string, error) {
+		t.Error("re-import must not allocate new IDs")
+		return "", nil
+	}
+
+	// Importing the same records again changes nothing
+	plan, err := NewPlan(records, existing, cfg, newID)
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	if n := plan.Count(ActionUnchanged); n != 4 {
+		t.Errorf("re-import: %d unchanged, want 4", n)
+	}
+
+	// A changed record updates the existing bean
+	records[1].Title = "Design system v2"
+	plan, err = NewPlan(records, existing, cfg, newID)
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	if plan.Count(ActionUpdate) != 1 || plan.Count(ActionUnchanged) != 3 {
+		t.Fatalf("got %d updates and %d unchanged, want 1 and 3", plan.Count(ActionUpdate), plan.Count(ActionUnchanged))
+	}
+	for _, a := range plan.Actions {
+		if a.Kind == ActionUpdate {
+			if a.Bean.Title != "Design system v2" || len(a.Changes) != 1 || a.Changes[0].Field != "title" {
+				t.Errorf("update = %+v, changes = %+v", a.Bean, a.Changes)
+			}
+		}
+	}
+}
+
+func TestPlaceholderIDs(t *testing.T) {
+	records, err := Parse(FormatGitHubJSON, strings.NewReader(githubIssues))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	plan, err := NewPlan(records, nil, config.Default(), PlaceholderIDs())
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+
+	var ids []string
+	for _, a := range plan.Actions {
+		ids = append(ids, a.Bean.ID)
+	}
+	if want := []string{"new:1", "new:2", "new:3", "new:4"}; !slices.Equal(ids, want) {
+		t.Errorf("IDs = %v, want %v", ids, want)
+	}
+	// References between new beans use the placeholders too
+	if login := plan.Actions[1].Bean; login.Parent != "new:1" || !slices.Equal(login.BlockedBy, []string{"new:3"}) {
+		t.Errorf("Parent = %q, BlockedBy = %v", login.Parent, login.BlockedBy)
+	}
+}
+
+func TestNewPlanWarnings(t *testing.T) {
+	cfg := config.Default()
+	records := []Record{
+		{Key: "1", Title: "Epic work", Type: "epic", Milestone: "v1", BlockedBy: []string{"99"}, Status: "someday"},
+		{Key: "1", Title: "Duplicate"},
+	}
+	plan, err := NewPlan(records, nil, cfg, sequentialIDs())
+	if err != nil {
+		t.Fatalf("NewPlan() error = %v", err)
+	}
+	if len(plan.Actions) != 2 {
+		t.Errorf("got %d actions, want 2 (milestone and first record)", len(plan.Actions))
+	}
+	if len(plan.Warnings) != 3 {
+		t.Errorf("Warnings = %v, want unknown status, missing blocker, and duplicate", plan.Warnings)
+	}
+	for _, a := range plan.Actions {
+		if a.Key == "1" && a.Bean.Status != cfg.GetDefaultStatus() {
+			t.Errorf("unknown status: Status = %q, want default", a.Bean.Status)
+		}
+	}
+}
+
+func TestMapStatus(t *testing.T) {
+	cfg := config.Default()
+	tests := map[string]string{
+		"":            "",
+		"todo":        "todo",
+		"OPEN":        "todo",
+		"Closed":      "completed",
+		"In Progress": "in-progress",
+		"not_planned": "scrapped",
+		"Won't Fix":   "scrapped",
+		"someday":     "",
+	}
+	for in, want := range tests {
+		if got := mapStatus(in, cfg); got != want {
+			t.Errorf("mapStatus(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
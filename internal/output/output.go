@@ -16,6 +16,7 @@ const (
 	ErrFileError     = "FILE_ERROR"
 	ErrValidation    = "VALIDATION_ERROR"
 	ErrConflict      = "CONFLICT"
+	ErrTransition    = "INVALID_TRANSITION"
 )
 
 // Response is the standard JSON response envelope.
@@ -28,6 +29,8 @@ type Response struct {
 	Warnings []string     `json:"warnings,omitempty"`
 	Error    string       `json:"error,omitempty"`
 	Code     string       `json:"code,omitempty"`
+	Details  any          `json:"details,omitempty"`
+	Results  any          `json:"results,omitempty"`
 	Path     string       `json:"path,omitempty"`
 }
 
@@ -73,6 +76,16 @@ func SuccessMultiple(beans []*bean.Bean) error {
 	return enc.Encode(beans)
 }
 
+// SuccessResults outputs a success response with per-item results (e.g. of a
+// bulk update).
+func SuccessResults(results any, message string) error {
+	return JSON(Response{
+		Success: true,
+		Message: message,
+		Results: results,
+	})
+}
+
 // SuccessMessage outputs a success response with just a message.
 func SuccessMessage(message string) error {
 	return JSON(Response{
@@ -100,6 +113,18 @@ func Error(code string, message string) error {
 	return fmt.Errorf("%s", message)
 }
 
+// ErrorWithDetails outputs an error response with structured details and
+// returns an error for command handling.
+func ErrorWithDetails(code string, message string, details any) error {
+	_ = JSON(Response{
+		Success: false,
+		Error:   message,
+		Code:    code,
+		Details: details,
+	})
+	return fmt.Errorf("%s", message)
+}
+
 // ErrorFrom outputs an error response from an existing error.
 func ErrorFrom(code string, err error) error {
 	return Error(code, err.Error())
@@ -2,22 +2,100 @@
 package search
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/mapping"
 	"github.com/hmans/beans/pkg/bean"
+	bolterrors "go.etcd.io/bbolt/errors"
 )
 
-// Index wraps a Bleve in-memory index for searching beans.
+// MappingVersion identifies the index mapping and document structure. Bump it
+// whenever either changes, so that persistent indexes are rebuilt.
+const MappingVersion = 2
+
+// ErrLocked is returned by Open when another process has the index open.
+var ErrLocked = errors.New("search index is in use by another process")
+
+// openTimeout is how long Open waits for another process to release the index.
+const openTimeout = "250ms"
+
+var mappingVersionKey = []byte("mapping_version")
+
+// hashKey returns the internal key storing the hash of a bean's indexed document.
+func hashKey(id string) []byte {
+	return []byte("hash:" + id)
+}
+
+// Index wraps a Bleve index for searching beans, either in memory or
+// persisted on disk.
 type Index struct {
 	index bleve.Index
+
+	// parentTitle looks up the title of a parent bean for indexing (optional)
+	parentTitle func(id string) string
 }
 
 // beanDocument is the structure stored in the Bleve index.
 type beanDocument struct {
-	ID    string `json:"id"`
-	Slug  string `json:"slug"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	ID          string   `json:"id"`
+	Slug        string   `json:"slug"`
+	Title       string   `json:"title"`
+	Body        string   `json:"body"`
+	Comments    string   `json:"comments"`
+	Tags        []string `json:"tags"`
+	Type        string   `json:"type"`
+	Status      string   `json:"status"`
+	Priority    string   `json:"priority"`
+	Parent      string   `json:"parent"`
+	ParentTitle string   `json:"parent_title"`
+}
+
+// newBeanDocument builds the indexed document for a bean. Comment bodies are
+// concatenated into a single searchable field.
+func (idx *Index) newBeanDocument(b *bean.Bean) beanDocument {
+	comments := make([]string, len(b.Comments))
+	for i, c := range b.Comments {
+		comments[i] = c.Body
+	}
+	doc := beanDocument{
+		ID:       b.ID,
+		Slug:     b.Slug,
+		Title:    b.Title,
+		Body:     b.Body,
+		Comments: strings.Join(comments, "\n\n"),
+		Tags:     b.Tags,
+		Type:     b.Type,
+		Status:   b.Status,
+		Priority: b.Priority,
+		Parent:   b.Parent,
+	}
+	if b.Parent != "" && idx.parentTitle != nil {
+		doc.ParentTitle = idx.parentTitle(b.Parent)
+	}
+	return doc
+}
+
+// SetParentTitleFunc sets the function used to look up parent titles, which
+// are indexed with their children (as parent_title). It is called while
+// indexing, so it must not call back into the index.
+func (idx *Index) SetParentTitleFunc(fn func(id string) string) {
+	idx.parentTitle = fn
+}
+
+// hash returns a fingerprint of the document, used to skip re-indexing
+// unchanged beans.
+func (d beanDocument) hash() []byte {
+	data, _ := json.Marshal(d)
+	h := fnv.New64a()
+	h.Write(data)
+	return []byte(strconv.FormatUint(h.Sum64(), 16))
 }
 
 // NewIndex creates a new in-memory Bleve index.
@@ -31,6 +109,44 @@ func NewIndex() (*Index, error) {
 	return &Index{index: idx}, nil
 }
 
+// Open opens the persistent index at path, creating it if it doesn't exist.
+// An index built with a different MappingVersion, or one that can't be read,
+// is discarded and recreated empty; use Sync to (re)populate it. Returns
+// ErrLocked if another process has the index open.
+func Open(path string) (*Index, error) {
+	config := map[string]any{"bolt_timeout": openTimeout}
+	idx, err := bleve.OpenUsing(path, config)
+	switch {
+	case errors.Is(err, bolterrors.ErrTimeout):
+		return nil, ErrLocked
+	case err == nil:
+		version, verr := idx.GetInternal(mappingVersionKey)
+		if verr == nil && string(version) == strconv.Itoa(MappingVersion) {
+			return &Index{index: idx}, nil
+		}
+		idx.Close()
+	}
+
+	// Missing, outdated, or unreadable: start over
+	if err := os.RemoveAll(path); err != nil {
+		return nil, fmt.Errorf("removing search index: %w", err)
+	}
+	idx, err = bleve.NewUsing(path, buildIndexMapping(), bleve.Config.DefaultIndexType, bleve.Config.DefaultKVStore, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := idx.SetInternal(mappingVersionKey, []byte(strconv.Itoa(MappingVersion))); err != nil {
+		idx.Close()
+		return nil, err
+	}
+	// Keep the index out of version control, whatever the project's .gitignore says
+	if err := os.WriteFile(path+string(os.PathSeparator)+".gitignore", []byte("*\n"), 0644); err != nil {
+		idx.Close()
+		return nil, err
+	}
+	return &Index{index: idx}, nil
+}
+
 // buildIndexMapping creates the Bleve index mapping for bean documents.
 func buildIndexMapping() mapping.IndexMapping {
 	// Create a text field mapping with the standard analyzer
@@ -46,6 +162,16 @@ func buildIndexMapping() mapping.IndexMapping {
 	beanMapping.AddFieldMappingsAt("slug", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("title", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("body", textFieldMapping)
+	beanMapping.AddFieldMappingsAt("comments", textFieldMapping)
+	beanMapping.AddFieldMappingsAt("parent_title", textFieldMapping)
+
+	// Keyword fields match whole values (e.g. tags:backend, status:in-progress)
+	// and are used for facets
+	beanMapping.AddFieldMappingsAt("tags", keywordFieldMapping)
+	beanMapping.AddFieldMappingsAt("type", keywordFieldMapping)
+	beanMapping.AddFieldMappingsAt("status", keywordFieldMapping)
+	beanMapping.AddFieldMappingsAt("priority", keywordFieldMapping)
+	beanMapping.AddFieldMappingsAt("parent", keywordFieldMapping)
 
 	// Create the index mapping with BM25 scoring for better relevance ranking
 	indexMapping := bleve.NewIndexMapping()
@@ -70,18 +196,28 @@ func (idx *Index) Close() error {
 
 // IndexBean adds or updates a bean in the search index.
 func (idx *Index) IndexBean(b *bean.Bean) error {
-	doc := beanDocument{
-		ID:    b.ID,
-		Slug:  b.Slug,
-		Title: b.Title,
-		Body:  b.Body,
+	batch := idx.index.NewBatch()
+	if err := addToBatch(batch, b.ID, idx.newBeanDocument(b)); err != nil {
+		return err
 	}
-	return idx.index.Index(b.ID, doc)
+	return idx.index.Batch(batch)
 }
 
 // DeleteBean removes a bean from the search index.
 func (idx *Index) DeleteBean(id string) error {
-	return idx.index.Delete(id)
+	batch := idx.index.NewBatch()
+	batch.Delete(id)
+	batch.DeleteInternal(hashKey(id))
+	return idx.index.Batch(batch)
+}
+
+// addToBatch adds a document and its hash to a batch.
+func addToBatch(batch *bleve.Batch, id string, doc beanDocument) error {
+	if err := batch.Index(id, doc); err != nil {
+		return err
+	}
+	batch.SetInternal(hashKey(id), doc.hash())
+	return nil
 }
 
 // DefaultSearchLimit is the default maximum number of search results.
@@ -119,19 +255,195 @@ func (idx *Index) Search(queryStr string, limit int) ([]string, error) {
 	return ids, nil
 }
 
+// Highlight markers wrapping matched terms in snippets. Snippet text is
+// otherwise HTML-escaped.
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+)
+
+// snippetFields are the fields snippets are taken from, in order of preference.
+var snippetFields = []string{"title", "body", "comments", "parent_title"}
+
+// FacetFields are the fields counted in Results.Facets.
+var FacetFields = []string{"status", "type", "tags"}
+
+// facetSize is the maximum number of values counted per facet.
+const facetSize = 50
+
+// Results are the ranked matches of a query.
+type Results struct {
+	// Total is the number of matching beans, which may exceed len(Hits).
+	Total int
+	Hits  []Hit
+	// Facets maps each of FacetFields to value counts over all matching
+	// beans, most frequent first.
+	Facets map[string][]FacetCount
+}
+
+// Hit is a single matching bean.
+type Hit struct {
+	ID string
+	// Score is the BM25 relevance score (higher is more relevant).
+	Score float64
+	// Snippets are highlighted excerpts of the matching fields, in the order of
+	// snippetFields.
+	Snippets []Snippet
+}
+
+// Snippet is a highlighted excerpt of a matching field.
+type Snippet struct {
+	Field string
+	// Text is HTML-escaped, with matched terms between HighlightStart and HighlightEnd.
+	Text string
+}
+
+// FacetCount is the number of matching beans with a given field value.
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// Query executes a search query (in the same syntax as Search) and returns
+// scored hits with snippets, plus facet counts over all matches. The limit
+// parameter controls the maximum number of hits (0 uses DefaultSearchLimit).
+func (idx *Index) Query(queryStr string, limit int) (*Results, error) {
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	req := bleve.NewSearchRequest(bleve.NewQueryStringQuery(queryStr))
+	req.Size = limit
+	req.Highlight = bleve.NewHighlightWithStyle("html")
+	req.Highlight.Fields = snippetFields
+	for _, field := range FacetFields {
+		req.AddFacet(field, bleve.NewFacetRequest(field, facetSize))
+	}
+
+	result, err := idx.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Results{
+		Total:  int(result.Total),
+		Hits:   make([]Hit, 0, len(result.Hits)),
+		Facets: make(map[string][]FacetCount, len(FacetFields)),
+	}
+	for _, h := range result.Hits {
+		hit := Hit{ID: h.ID, Score: h.Score}
+		for _, field := range snippetFields {
+			for _, fragment := range h.Fragments[field] {
+				// Fields are returned whether they matched or not
+				if strings.Contains(fragment, HighlightStart) {
+					hit.Snippets = append(hit.Snippets, Snippet{Field: field, Text: fragment})
+				}
+			}
+		}
+		res.Hits = append(res.Hits, hit)
+	}
+	for _, field := range FacetFields {
+		counts := []FacetCount{}
+		if facet := result.Facets[field]; facet != nil && facet.Terms != nil {
+			for _, term := range facet.Terms.Terms() {
+				counts = append(counts, FacetCount{Value: term.Term, Count: term.Count})
+			}
+		}
+		res.Facets[field] = counts
+	}
+	return res, nil
+}
+
 // IndexBeans indexes multiple beans in a batch for efficiency.
 func (idx *Index) IndexBeans(beans []*bean.Bean) error {
 	batch := idx.index.NewBatch()
 	for _, b := range beans {
-		doc := beanDocument{
-			ID:    b.ID,
-			Slug:  b.Slug,
-			Title: b.Title,
-			Body:  b.Body,
-		}
-		if err := batch.Index(b.ID, doc); err != nil {
+		if err := addToBatch(batch, b.ID, idx.newBeanDocument(b)); err != nil {
 			return err
 		}
 	}
 	return idx.index.Batch(batch)
 }
+
+// Refresh re-indexes those of the given beans whose indexed document changed
+// (or that aren't indexed yet), and returns how many were re-indexed.
+func (idx *Index) Refresh(beans []*bean.Bean) (int, error) {
+	batch := idx.index.NewBatch()
+	indexed, err := idx.addChangedToBatch(batch, beans)
+	if err != nil || indexed == 0 {
+		return 0, err
+	}
+	return indexed, idx.index.Batch(batch)
+}
+
+// addChangedToBatch adds the beans whose indexed document changed to batch.
+func (idx *Index) addChangedToBatch(batch *bleve.Batch, beans []*bean.Bean) (int, error) {
+	n := 0
+	for _, b := range beans {
+		doc := idx.newBeanDocument(b)
+		old, err := idx.index.GetInternal(hashKey(b.ID))
+		if err != nil {
+			return 0, err
+		}
+		if string(old) == string(doc.hash()) {
+			continue
+		}
+		if err := addToBatch(batch, b.ID, doc); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Sync brings the index in line with the given beans: beans whose indexed
+// document changed (or that aren't indexed yet) are re-indexed, and indexed
+// beans that no longer exist are removed. Returns the number of beans
+// re-indexed and removed.
+func (idx *Index) Sync(beans []*bean.Bean) (indexed, removed int, err error) {
+	batch := idx.index.NewBatch()
+	indexed, err = idx.addChangedToBatch(batch, beans)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	current := make(map[string]bool, len(beans))
+	for _, b := range beans {
+		current[b.ID] = true
+	}
+	ids, err := idx.indexedIDs()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, id := range ids {
+		if !current[id] {
+			batch.Delete(id)
+			batch.DeleteInternal(hashKey(id))
+			removed++
+		}
+	}
+
+	if indexed == 0 && removed == 0 {
+		return 0, 0, nil
+	}
+	return indexed, removed, idx.index.Batch(batch)
+}
+
+// indexedIDs returns the IDs of all indexed beans.
+func (idx *Index) indexedIDs() ([]string, error) {
+	count, err := idx.index.DocCount()
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	req := bleve.NewSearchRequest(bleve.NewMatchAllQuery())
+	req.Size = int(count)
+	result, err := idx.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(result.Hits))
+	for i, hit := range result.Hits {
+		ids[i] = hit.ID
+	}
+	return ids, nil
+}
@@ -1,6 +1,12 @@
 package search
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
@@ -109,6 +115,38 @@ func TestSearch_MatchBody(t *testing.T) {
 	}
 }
 
+func TestSearch_MatchComments(t *testing.T) {
+	idx := setupTestIndex(t)
+
+	b := &bean.Bean{
+		ID:       "aaa1",
+		Title:    "Login page",
+		Body:     "Build the login form",
+		Comments: []bean.Comment{{Author: "alice", Body: "Should we support passkeys?"}},
+	}
+	if err := idx.IndexBean(b); err != nil {
+		t.Fatalf("IndexBean() error = %v", err)
+	}
+
+	for _, query := range []string{"passkeys", "comments:passkeys"} {
+		ids, err := idx.Search(query, 10)
+		if err != nil {
+			t.Fatalf("Search(%q) error = %v", query, err)
+		}
+		if len(ids) != 1 || ids[0] != "aaa1" {
+			t.Errorf("Search(%q) = %v, want [aaa1]", query, ids)
+		}
+	}
+
+	ids, err := idx.Search("body:passkeys", 10)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("Search(body:passkeys) = %v, want no results", ids)
+	}
+}
+
 func TestSearch_MatchSlug(t *testing.T) {
 	idx := setupTestIndex(t)
 
@@ -399,3 +437,239 @@ func TestSearch_DefaultLimit(t *testing.T) {
 		t.Errorf("Search with limit 0 (default) returned %d results, want 1", len(ids))
 	}
 }
+
+func TestOpen_Persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".index")
+
+	idx, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if err := idx.IndexBean(&bean.Bean{ID: "abc1", Title: "Persistent Bean"}); err != nil {
+		t.Fatalf("IndexBean() error = %v", err)
+	}
+	if err := idx.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	idx, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() again error = %v", err)
+	}
+	defer idx.Close()
+
+	ids, err := idx.Search("Persistent", 10)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "abc1" {
+		t.Errorf("Search() after reopen = %v, want [abc1]", ids)
+	}
+	if _, err := os.Stat(filepath.Join(path, ".gitignore")); err != nil {
+		t.Errorf("index directory should contain a .gitignore: %v", err)
+	}
+}
+
+func TestOpen_RebuildsOnMappingVersionChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".index")
+
+	idx, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if err := idx.IndexBean(&bean.Bean{ID: "abc1", Title: "Old Bean"}); err != nil {
+		t.Fatalf("IndexBean() error = %v", err)
+	}
+	// Simulate an index built by an older version
+	if err := idx.index.SetInternal(mappingVersionKey, []byte(strconv.Itoa(MappingVersion-1))); err != nil {
+		t.Fatalf("SetInternal() error = %v", err)
+	}
+	idx.Close()
+
+	idx, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() again error = %v", err)
+	}
+	defer idx.Close()
+
+	ids, err := idx.Search("Old", 10)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("Search() after version change = %v, want an empty, rebuilt index", ids)
+	}
+}
+
+func TestOpen_Locked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".index")
+
+	idx, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer idx.Close()
+
+	if _, err := Open(path); !errors.Is(err, ErrLocked) {
+		t.Errorf("Open() while open elsewhere: error = %v, want ErrLocked", err)
+	}
+}
+
+func TestSync(t *testing.T) {
+	idx := setupTestIndex(t)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Bean One"},
+		{ID: "bbb2", Title: "Bean Two"},
+		{ID: "ccc3", Title: "Bean Three"},
+	}
+	indexed, removed, err := idx.Sync(beans)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if indexed != 3 || removed != 0 {
+		t.Errorf("first Sync() = %d indexed, %d removed; want 3, 0", indexed, removed)
+	}
+
+	// Nothing changed: nothing is re-indexed
+	indexed, removed, err = idx.Sync(beans)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if indexed != 0 || removed != 0 {
+		t.Errorf("unchanged Sync() = %d indexed, %d removed; want 0, 0", indexed, removed)
+	}
+
+	// One changed, one deleted
+	beans = []*bean.Bean{
+		{ID: "aaa1", Title: "Bean One"},
+		{ID: "bbb2", Title: "Bean Two, renamed"},
+	}
+	indexed, removed, err = idx.Sync(beans)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if indexed != 1 || removed != 1 {
+		t.Errorf("Sync() = %d indexed, %d removed; want 1, 1", indexed, removed)
+	}
+
+	ids, err := idx.Search("renamed", 10)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "bbb2" {
+		t.Errorf("Search(renamed) = %v, want [bbb2]", ids)
+	}
+	if ids, _ := idx.Search("Three", 10); len(ids) != 0 {
+		t.Errorf("Search(Three) = %v, want deleted bean gone", ids)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	idx := setupTestIndex(t)
+	idx.SetParentTitleFunc(func(id string) string {
+		if id == "epic" {
+			return "Payments Epic"
+		}
+		return ""
+	})
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Checkout flow", Body: "The checkout button is broken", Status: "todo", Type: "bug", Tags: []string{"frontend"}},
+		{ID: "bbb2", Title: "Refactor billing", Body: "Prepare for checkout changes", Status: "in-progress", Type: "task", Tags: []string{"backend"}, Parent: "epic"},
+		{ID: "ccc3", Title: "Unrelated", Body: "Nothing to see here", Status: "todo", Type: "task", Tags: []string{"frontend"}},
+	}
+	if err := idx.IndexBeans(beans); err != nil {
+		t.Fatalf("IndexBeans() error = %v", err)
+	}
+
+	t.Run("scores and snippets", func(t *testing.T) {
+		res, err := idx.Query("checkout", 10)
+		if err != nil {
+			t.Fatalf("Query() error = %v", err)
+		}
+		if res.Total != 2 || len(res.Hits) != 2 {
+			t.Fatalf("Query(checkout) = %d total, %d hits; want 2, 2", res.Total, len(res.Hits))
+		}
+		// The bean matching in both title and body ranks first
+		if res.Hits[0].ID != "aaa1" {
+			t.Errorf("first hit = %s, want aaa1", res.Hits[0].ID)
+		}
+		if res.Hits[0].Score < res.Hits[1].Score || res.Hits[1].Score <= 0 {
+			t.Errorf("scores = %v, %v; want positive and descending", res.Hits[0].Score, res.Hits[1].Score)
+		}
+
+		fields := map[string]string{}
+		for _, s := range res.Hits[0].Snippets {
+			fields[s.Field] = s.Text
+		}
+		if !strings.Contains(fields["title"], "<mark>Checkout</mark>") {
+			t.Errorf("title snippet = %q, want highlighted match", fields["title"])
+		}
+		if !strings.Contains(fields["body"], "<mark>checkout</mark>") {
+			t.Errorf("body snippet = %q, want highlighted match", fields["body"])
+		}
+		for field := range fields {
+			if field != "title" && field != "body" {
+				t.Errorf("unexpected snippet for non-matching field %q", field)
+			}
+		}
+	})
+
+	t.Run("facets", func(t *testing.T) {
+		res, err := idx.Query("", 10)
+		if err != nil {
+			t.Fatalf("Query() error = %v", err)
+		}
+		if res.Total != 0 {
+			t.Errorf("Query(\"\") total = %d, want 0", res.Total)
+		}
+
+		res, err = idx.Query("checkout OR nothing", 10)
+		if err != nil {
+			t.Fatalf("Query() error = %v", err)
+		}
+		want := map[string][]FacetCount{
+			"status": {{Value: "todo", Count: 2}, {Value: "in-progress", Count: 1}},
+			"type":   {{Value: "task", Count: 2}, {Value: "bug", Count: 1}},
+			"tags":   {{Value: "frontend", Count: 2}, {Value: "backend", Count: 1}},
+		}
+		for field, counts := range want {
+			got := res.Facets[field]
+			if len(got) != len(counts) {
+				t.Errorf("facet %s = %v, want %v", field, got, counts)
+				continue
+			}
+			for i := range counts {
+				if got[i] != counts[i] {
+					t.Errorf("facet %s = %v, want %v", field, got, counts)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("field queries", func(t *testing.T) {
+		tests := []struct {
+			query string
+			want  []string
+		}{
+			{"tags:frontend", []string{"aaa1", "ccc3"}},
+			{"status:in-progress", []string{"bbb2"}},
+			{"type:bug", []string{"aaa1"}},
+			{"parent:epic", []string{"bbb2"}},
+			{"parent_title:payments", []string{"bbb2"}},
+			{"payments", []string{"bbb2"}},
+		}
+		for _, tt := range tests {
+			ids, err := idx.Search(tt.query, 10)
+			if err != nil {
+				t.Fatalf("Search(%q) error = %v", tt.query, err)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, ids, tt.want)
+			}
+		}
+	})
+}
@@ -9,7 +9,8 @@ import (
 
 // beanCreatedMsg is sent when a new bean is created
 type beanCreatedMsg struct {
-	title string
+	title    string
+	template string // empty for the type's default template
 }
 
 // closeCreateModalMsg is sent when the create modal is cancelled
@@ -21,11 +22,13 @@ type openCreateModalMsg struct{}
 // createModalModel is the model for the create bean modal
 type createModalModel struct {
 	textInput textinput.Model
+	templates []string // templates in .beans/templates/
+	template  int      // index into templates, or -1 for the type's default
 	width     int
 	height    int
 }
 
-func newCreateModalModel(width, height int) createModalModel {
+func newCreateModalModel(templates []string, width, height int) createModalModel {
 	ti := textinput.New()
 	ti.Placeholder = "Enter bean title..."
 	ti.CharLimit = 200
@@ -38,6 +41,8 @@ func newCreateModalModel(width, height int) createModalModel {
 
 	return createModalModel{
 		textInput: ti,
+		templates: templates,
+		template:  -1,
 		width:     width,
 		height:    height,
 	}
@@ -60,8 +65,12 @@ func (m createModalModel) Update(msg tea.Msg) (createModalModel, tea.Cmd) {
 		case tea.KeyEnter:
 			title := m.textInput.Value()
 			if title != "" {
+				template := ""
+				if m.template >= 0 {
+					template = m.templates[m.template]
+				}
 				return m, func() tea.Msg {
-					return beanCreatedMsg{title: title}
+					return beanCreatedMsg{title: title, template: template}
 				}
 			}
 			// Empty title - just close
@@ -73,6 +82,16 @@ func (m createModalModel) Update(msg tea.Msg) (createModalModel, tea.Cmd) {
 			return m, func() tea.Msg {
 				return closeCreateModalMsg{}
 			}
+
+		case tea.KeyTab:
+			// Cycle through the templates, then back to the type's default
+			if len(m.templates) > 0 {
+				m.template++
+				if m.template >= len(m.templates) {
+					m.template = -1
+				}
+			}
+			return m, nil
 		}
 	}
 
@@ -99,11 +118,22 @@ func (m createModalModel) View() string {
 		Render(m.textInput.View())
 
 	// Help text
-	help := helpKeyStyle.Render("enter") + " " + helpStyle.Render("create") + "  " +
-		helpKeyStyle.Render("esc") + " " + helpStyle.Render("cancel")
+	help := helpKeyStyle.Render("enter") + " " + helpStyle.Render("create") + "  "
+	if len(m.templates) > 0 {
+		help += helpKeyStyle.Render("tab") + " " + helpStyle.Render("template") + "  "
+	}
+	help += helpKeyStyle.Render("esc") + " " + helpStyle.Render("cancel")
 
 	// Assemble content
-	content := header + "\n\n" + inputBox + "\n\n" + help
+	content := header + "\n\n" + inputBox + "\n\n"
+	if len(m.templates) > 0 {
+		template := "default for type"
+		if m.template >= 0 {
+			template = m.templates[m.template]
+		}
+		content += helpStyle.Render("Template: ") + template + "\n\n"
+	}
+	content += help
 
 	// Border style
 	border := lipgloss.NewStyle().
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/internal/ui"
@@ -139,6 +141,7 @@ type detailModel struct {
 	linksActive   bool                 // true = links section focused
 	cols          ui.ResponsiveColumns // responsive column widths for links
 	statusMessage string               // Status message to display in footer
+	showComments  bool                 // true = bottom pane shows the comment thread instead of the body
 }
 
 func newDetailModel(b *bean.Bean, resolver *beangraph.CoreResolver, cfg *config.Config, width, height int) detailModel {
@@ -379,6 +382,12 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 				}
 			}
 
+		case "C":
+			// Toggle the bottom pane between body and comments
+			m.setShowComments(!m.showComments)
+			m.linksActive = false
+			return m, nil
+
 		case "y":
 			// Copy bean ID to clipboard
 			return m, func() tea.Msg {
@@ -452,7 +461,12 @@ func (m detailModel) View() string {
 		}
 		footer += helpKeyStyle.Render("enter") + " " + helpStyle.Render("go to") + "  "
 	}
+	commentsLabel := fmt.Sprintf("comments (%d)", len(m.bean.Comments))
+	if m.showComments {
+		commentsLabel = "description"
+	}
 	footer += helpKeyStyle.Render("b") + " " + helpStyle.Render("blocking") + "  " +
+		helpKeyStyle.Render("C") + " " + helpStyle.Render(commentsLabel) + "  " +
 		helpKeyStyle.Render("e") + " " + helpStyle.Render("edit") + "  " +
 		helpKeyStyle.Render("p") + " " + helpStyle.Render("parent") + "  " +
 		helpKeyStyle.Render("P") + " " + helpStyle.Render("priority") + "  " +
@@ -486,9 +500,24 @@ func (m detailModel) calculateHeaderHeight() int {
 		baseHeight += listHeight + 3
 	}
 
+	// Add a line for the progress bar
+	if _, ok := m.childProgress(); ok {
+		baseHeight++
+	}
+
 	return baseHeight
 }
 
+// childProgress returns the bean's rolled-up progress, and false if it has no
+// children to show progress for.
+func (m detailModel) childProgress() (beancore.Progress, bool) {
+	p, err := m.resolver.Core.Progress(m.bean.ID)
+	if err != nil || p.TotalChildren == 0 {
+		return p, false
+	}
+	return p, true
+}
+
 func (m detailModel) renderHeader() string {
 	// Title
 	title := detailTitleStyle.Render(m.bean.Title)
@@ -511,12 +540,34 @@ func (m detailModel) renderHeader() string {
 	headerContent.WriteString("\n")
 	headerContent.WriteString(id + "  " + status)
 
+	// Add due date, highlighted if overdue
+	if m.bean.Due != nil {
+		due := "due " + m.bean.Due.String()
+		if !isArchive && m.bean.IsOverdue(bean.Today()) {
+			due = ui.Danger.Render(due + " (overdue)")
+		} else {
+			due = ui.Muted.Render(due)
+		}
+		headerContent.WriteString("  " + due)
+	}
+
 	// Add tags if present
 	if len(m.bean.Tags) > 0 {
 		headerContent.WriteString("  ")
 		headerContent.WriteString(ui.RenderTags(m.bean.Tags))
 	}
 
+	// Add progress bar for beans with children
+	if p, ok := m.childProgress(); ok {
+		line := ui.RenderProgressBar(p.Progress, 20) +
+			ui.Muted.Render(fmt.Sprintf("  %d/%d done", p.CompletedChildren, p.TotalChildren))
+		if p.TotalEstimate > 0 {
+			line += ui.Muted.Render(", " + strconv.FormatFloat(p.RemainingEstimate, 'f', -1, 64) + " remaining")
+		}
+		headerContent.WriteString("\n")
+		headerContent.WriteString(line)
+	}
+
 	// Header box style - always muted border (not focused, links section is separate)
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -555,7 +606,7 @@ func (m detailModel) resolveAllLinks() []resolvedLink {
 	var links []resolvedLink
 	ctx := context.Background()
 	// Resolve outgoing links via core resolver
-	if blocking, _ := m.resolver.BeanBlocking(ctx, m.bean, nil); blocking != nil {
+	if blocking, _ := m.resolver.BeanBlocking(ctx, m.bean, nil, nil, nil); blocking != nil {
 		for _, b := range blocking {
 			links = append(links, resolvedLink{linkType: "blocking", bean: b, incoming: false})
 		}
@@ -565,12 +616,12 @@ func (m detailModel) resolveAllLinks() []resolvedLink {
 	}
 
 	// Resolve incoming links via core resolver
-	if blockedBy, _ := m.resolver.BeanBlockedBy(ctx, m.bean, nil); blockedBy != nil {
+	if blockedBy, _ := m.resolver.BeanBlockedBy(ctx, m.bean, nil, nil, nil); blockedBy != nil {
 		for _, b := range blockedBy {
 			links = append(links, resolvedLink{linkType: "blocking", bean: b, incoming: true})
 		}
 	}
-	if children, _ := m.resolver.BeanChildren(ctx, m.bean, nil); children != nil {
+	if children, _ := m.resolver.BeanChildren(ctx, m.bean, nil, nil, nil); children != nil {
 		for _, b := range children {
 			links = append(links, resolvedLink{linkType: "parent", bean: b, incoming: true})
 		}
@@ -663,7 +714,18 @@ func compareBeansByStatusPriorityAndType(a, b *bean.Bean, statusNames, priorityN
 }
 
 
+// setShowComments switches the bottom pane between the body and the comment thread.
+func (m *detailModel) setShowComments(show bool) {
+	m.showComments = show
+	m.viewport.SetContent(m.renderBody(m.viewport.Width))
+	m.viewport.GotoTop()
+}
+
 func (m detailModel) renderBody(_ int) string {
+	if m.showComments {
+		return m.renderComments()
+	}
+
 	if m.bean.Body == "" {
 		return lipgloss.NewStyle().
 			Foreground(ui.ColorMuted).
@@ -683,3 +745,43 @@ func (m detailModel) renderBody(_ int) string {
 
 	return strings.TrimSpace(rendered)
 }
+
+// renderComments renders the bean's comment thread, oldest first.
+func (m detailModel) renderComments() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#fff")).
+		Background(ui.ColorBlue).
+		Padding(0, 1)
+	title := lipgloss.NewStyle().Padding(0, 1).Render(titleStyle.Render(fmt.Sprintf("Comments (%d)", len(m.bean.Comments))))
+
+	if len(m.bean.Comments) == 0 {
+		return title + "\n\n" + lipgloss.NewStyle().
+			Foreground(ui.ColorMuted).
+			Padding(0, 1).
+			Render("No comments yet. Add one with: beans comment "+m.bean.ID+" \"...\"")
+	}
+
+	renderer := getGlamourRenderer()
+	var sb strings.Builder
+	sb.WriteString(title)
+	for _, c := range m.bean.Comments {
+		author := c.Author
+		if author == "" {
+			author = "unknown"
+		}
+		sb.WriteString("\n\n")
+		sb.WriteString(lipgloss.NewStyle().Padding(0, 1).Render(
+			ui.Primary.Bold(true).Render(author) + " " + ui.Muted.Render(c.CreatedAt.Local().Format("2006-01-02 15:04"))))
+		sb.WriteString("\n")
+
+		body := c.Body
+		if renderer != nil {
+			if rendered, err := renderer.Render(c.Body); err == nil {
+				body = strings.Trim(rendered, "\n")
+			}
+		}
+		sb.WriteString(body)
+	}
+	return sb.String()
+}
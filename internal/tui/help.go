@@ -82,6 +82,7 @@ func (m helpOverlayModel) View() string {
 	content.WriteString(shortcut("enter", "View bean details") + "\n")
 	content.WriteString(shortcut("b", "Manage blocking") + "\n")
 	content.WriteString(shortcut("c", "Create new bean") + "\n")
+	content.WriteString(shortcut("C", "Toggle comments (detail view)") + "\n")
 	content.WriteString(shortcut("e", "Edit in $EDITOR") + "\n")
 	content.WriteString(shortcut("p", "Set parent") + "\n")
 	content.WriteString(shortcut("P", "Change priority") + "\n")
@@ -90,6 +91,7 @@ func (m helpOverlayModel) View() string {
 	content.WriteString(shortcut("y", "Copy bean ID") + "\n")
 	content.WriteString(shortcut("/", "Filter") + "\n")
 	content.WriteString(shortcut("g t", "Filter by tag") + "\n")
+	content.WriteString(shortcut("g v", "Switch view") + "\n")
 	content.WriteString(shortcut("q", "Quit") + "\n")
 	content.WriteString("\n")
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -96,6 +97,7 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 			IDColWidth:      d.idColWidth,
 			UseFullNames:    d.cols.UseFullTypeStatus,
 			ImplicitStatus: item.implicitStatus,
+			Overdue:         !colors.IsArchive && item.bean.IsOverdue(bean.Today()),
 		},
 	)
 
@@ -118,12 +120,16 @@ type listModel struct {
 
 	// Active filters
 	tagFilter string // if set, only show beans with this tag
+	viewName  string // if set, only show beans matched by this named view
 
 	// Multi-select state
 	selectedBeans map[string]bool // IDs of beans marked for multi-edit
 
 	// Status message to display in footer
 	statusMessage string
+
+	// Number of bean files that could not be loaded (shown as a banner)
+	loadErrors int
 }
 
 func newListModel(resolver *beangraph.CoreResolver, cfg *config.Config) listModel {
@@ -152,6 +158,7 @@ func newListModel(resolver *beangraph.CoreResolver, cfg *config.Config) listMode
 type beansLoadedMsg struct {
 	items      []ui.FlatItem // flattened tree items
 	idColWidth int           // calculated ID column width for tree
+	loadErrors int           // number of bean files that could not be loaded
 }
 
 // errMsg is sent when an error occurs
@@ -169,10 +176,22 @@ func (m listModel) Init() tea.Cmd {
 }
 
 func (m listModel) loadBeans() tea.Msg {
-	// Build filter if tag filter is set
+	// Build filter from the active view and tag filter
 	var filter *model.BeanFilter
+	var sortBy string
+	if view := m.config.GetView(m.viewName); view != nil {
+		var err error
+		filter, err = beangraph.ViewFilter(m.config, view, m.resolver.Core.CurrentUser(), bean.Today())
+		if err != nil {
+			return errMsg{err}
+		}
+		sortBy = view.Sort
+	}
 	if m.tagFilter != "" {
-		filter = &model.BeanFilter{Tags: []string{m.tagFilter}}
+		if filter == nil {
+			filter = &model.BeanFilter{}
+		}
+		filter.Tags = append(filter.Tags, m.tagFilter)
 	}
 
 	// Query filtered beans
@@ -189,7 +208,7 @@ func (m listModel) loadBeans() tea.Msg {
 
 	// Sort function for tree building
 	sortFn := func(beans []*bean.Bean) {
-		bean.SortByStatusPriorityAndType(beans, m.config.StatusNames(), m.config.PriorityNames(), m.config.TypeNames())
+		bean.SortBy(beans, sortBy, m.config.StatusNames(), m.config.PriorityNames(), m.config.TypeNames())
 	}
 
 	// Pre-compute implicit statuses for all beans
@@ -218,7 +237,7 @@ func (m listModel) loadBeans() tea.Msg {
 		idColWidth += maxDepth * 3 // 3 chars per depth level (├─ + space)
 	}
 
-	return beansLoadedMsg{items: items, idColWidth: idColWidth}
+	return beansLoadedMsg{items: items, idColWidth: idColWidth, loadErrors: len(m.resolver.Core.LoadErrors())}
 }
 
 // setTagFilter sets the tag filter
@@ -226,14 +245,35 @@ func (m *listModel) setTagFilter(tag string) {
 	m.tagFilter = tag
 }
 
+// setView switches to a named view
+func (m *listModel) setView(name string) {
+	m.viewName = name
+}
+
 // clearFilter clears all active filters
 func (m *listModel) clearFilter() {
 	m.tagFilter = ""
+	m.viewName = ""
 }
 
 // hasActiveFilter returns true if any filter is active
 func (m *listModel) hasActiveFilter() bool {
-	return m.tagFilter != ""
+	return m.tagFilter != "" || m.viewName != ""
+}
+
+// title returns the list title, naming the active view and tag filter.
+func (m *listModel) title() string {
+	var active []string
+	if m.viewName != "" {
+		active = append(active, "view: "+m.viewName)
+	}
+	if m.tagFilter != "" {
+		active = append(active, "tag: "+m.tagFilter)
+	}
+	if len(active) == 0 {
+		return "Beans"
+	}
+	return fmt.Sprintf("Beans [%s]", strings.Join(active, ", "))
 }
 
 func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
@@ -247,8 +287,8 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		// Reserve space for border and footer
-		m.list.SetSize(msg.Width-2, msg.Height-4)
+		// Reserve space for border, footer, and banner
+		m.list.SetSize(msg.Width-2, msg.Height-4-m.bannerHeight())
 		// Recalculate responsive columns
 		m.cols = ui.CalculateResponsiveColumns(msg.Width, m.hasTags)
 		m.updateDelegate()
@@ -271,6 +311,10 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 		}
 		m.list.SetItems(items)
 		m.idColWidth = msg.idColWidth
+		if msg.loadErrors != m.loadErrors {
+			m.loadErrors = msg.loadErrors
+			m.list.SetSize(m.width-2, m.height-4-m.bannerHeight())
+		}
 		// Calculate responsive columns based on hasTags and width
 		m.cols = ui.CalculateResponsiveColumns(m.width, m.hasTags)
 		m.updateDelegate()
@@ -502,14 +546,28 @@ func (m listModel) View() string {
 	}
 
 	// Update title based on active filter
-	if m.tagFilter != "" {
-		m.list.Title = fmt.Sprintf("Beans [tag: %s]", m.tagFilter)
-	} else {
-		m.list.Title = "Beans"
-	}
+	m.list.Title = m.title()
 
 	// Inner height: total height minus border (2) minus footer (1) minus padding (1)
-	return m.viewContent(m.height-4) + "\n" + m.Footer()
+	return m.banner() + m.viewContent(m.height-4-m.bannerHeight()) + "\n" + m.Footer()
+}
+
+// banner renders a warning line about bean files that could not be loaded,
+// or an empty string if all files loaded.
+func (m listModel) banner() string {
+	if m.loadErrors == 0 {
+		return ""
+	}
+	style := lipgloss.NewStyle().Foreground(ui.ColorWarning).Bold(true).MaxWidth(m.width)
+	return style.Render(fmt.Sprintf("⚠ %d bean file(s) could not be loaded — run 'beans check' for details", m.loadErrors)) + "\n"
+}
+
+// bannerHeight returns the number of lines taken by the banner.
+func (m listModel) bannerHeight() int {
+	if m.loadErrors == 0 {
+		return 0
+	}
+	return 1
 }
 
 // viewContent renders just the bordered list without footer.
@@ -595,8 +653,8 @@ func (m listModel) ViewConstrained(width, height int) string {
 	m.width = width
 	m.height = height
 
-	// Inner height for border content (height minus 2 for top/bottom border)
-	innerHeight := height - 2
+	// Inner height for border content (height minus 2 for top/bottom border, and the banner)
+	innerHeight := height - 2 - m.bannerHeight()
 	m.list.SetSize(width-2, innerHeight)
 
 	// Recalculate columns for constrained width
@@ -604,12 +662,8 @@ func (m listModel) ViewConstrained(width, height int) string {
 	m.updateDelegate()
 
 	// Update title based on active filter
-	if m.tagFilter != "" {
-		m.list.Title = fmt.Sprintf("Beans [tag: %s]", m.tagFilter)
-	} else {
-		m.list.Title = "Beans"
-	}
+	m.list.Title = m.title()
 
-	return m.viewContent(innerHeight)
+	return m.banner() + m.viewContent(innerHeight)
 }
 
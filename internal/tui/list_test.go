@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
@@ -281,3 +282,33 @@ func TestCompareBeansByStatusPriorityAndType(t *testing.T) {
 		}
 	})
 }
+
+func TestListTitle(t *testing.T) {
+	m := &listModel{}
+	if got := m.title(); got != "Beans" {
+		t.Errorf("title() = %q, want Beans", got)
+	}
+	m.setView("triage")
+	if got := m.title(); got != "Beans [view: triage]" {
+		t.Errorf("title() = %q, want view in title", got)
+	}
+	m.setTagFilter("ui")
+	if got := m.title(); got != "Beans [view: triage, tag: ui]" {
+		t.Errorf("title() = %q, want view and tag in title", got)
+	}
+	m.clearFilter()
+	if m.hasActiveFilter() {
+		t.Error("hasActiveFilter() = true after clearFilter()")
+	}
+}
+
+func TestListBanner(t *testing.T) {
+	m := listModel{width: 100}
+	if got := m.banner(); got != "" || m.bannerHeight() != 0 {
+		t.Errorf("banner() = %q without load errors, want empty", got)
+	}
+	m.loadErrors = 2
+	if got := m.banner(); !strings.Contains(got, "2 bean file(s) could not be loaded") || m.bannerHeight() != 1 {
+		t.Errorf("banner() = %q, want load error warning", got)
+	}
+}
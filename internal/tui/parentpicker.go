@@ -11,7 +11,6 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/pkg/bean"
-	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/internal/ui"
@@ -96,7 +95,7 @@ func newParentPickerModel(beanIDs []string, beanTitle string, beanTypes []string
 	// Get valid parent types - for multi-select, find types valid for ALL beans
 	var validParentTypes []string
 	for i, beanType := range beanTypes {
-		typeParents := beancore.ValidParentTypes(beanType)
+		typeParents := cfg.ValidParentTypes(beanType)
 		if i == 0 {
 			validParentTypes = typeParents
 		} else {
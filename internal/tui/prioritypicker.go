@@ -83,8 +83,8 @@ type priorityPickerModel struct {
 }
 
 func newPriorityPickerModel(beanIDs []string, beanTitle, currentPriority string, cfg *config.Config, width, height int) priorityPickerModel {
-	// Get all priorities (hardcoded in config package)
-	priorities := config.DefaultPriorities
+	// Get all configured priorities
+	priorities := cfg.GetPriorities()
 
 	delegate := priorityItemDelegate{}
 
@@ -34,6 +34,8 @@ type statusItem struct {
 	color       string
 	isArchive   bool
 	isCurrent   bool
+	// disallowedReason explains why the workflow forbids this status (empty if allowed)
+	disallowedReason string
 }
 
 func (i statusItem) Title() string       { return i.name }
@@ -60,13 +62,18 @@ func (d statusItemDelegate) Render(w io.Writer, m list.Model, index int, listIte
 		cursor = "  "
 	}
 
-	// Render status with color (text only)
+	// Render status with color (text only), muted if the workflow forbids it
 	statusText := ui.RenderStatusTextWithColor(item.name, item.color, item.isArchive)
+	if item.disallowedReason != "" {
+		statusText = ui.Muted.Render(item.name)
+	}
 
 	// Add current indicator
 	var currentIndicator string
 	if item.isCurrent {
 		currentIndicator = ui.Muted.Render(" (current)")
+	} else if item.disallowedReason != "" {
+		currentIndicator = ui.Muted.Render(" (not allowed)")
 	}
 
 	fmt.Fprint(w, cursor+statusText+currentIndicator)
@@ -82,9 +89,10 @@ type statusPickerModel struct {
 	height        int
 }
 
-func newStatusPickerModel(beanIDs []string, beanTitle, currentStatus string, cfg *config.Config, width, height int) statusPickerModel {
-	// Get all statuses (hardcoded in config package)
-	statuses := config.DefaultStatuses
+// disallowed maps statuses forbidden by the workflow to the reason (may be nil).
+func newStatusPickerModel(beanIDs []string, beanTitle, currentStatus string, disallowed map[string]string, cfg *config.Config, width, height int) statusPickerModel {
+	// Get all configured statuses
+	statuses := cfg.GetStatuses()
 
 	delegate := statusItemDelegate{}
 
@@ -103,6 +111,8 @@ func newStatusPickerModel(beanIDs []string, beanTitle, currentStatus string, cfg
 			color:       s.Color,
 			isArchive:   s.Archive,
 			isCurrent:   isCurrent,
+
+			disallowedReason: disallowed[s.Name],
 		})
 	}
 
@@ -160,6 +170,10 @@ func (m statusPickerModel) Update(msg tea.Msg) (statusPickerModel, tea.Cmd) {
 			switch msg.String() {
 			case "enter":
 				if item, ok := m.list.SelectedItem().(statusItem); ok {
+					if item.disallowedReason != "" {
+						// Forbidden by the workflow; the reason is shown in the description
+						return m, nil
+					}
 					return m, func() tea.Msg {
 						return statusSelectedMsg{beanIDs: m.beanIDs, status: item.name}
 					}
@@ -183,8 +197,12 @@ func (m statusPickerModel) View() string {
 
 	// Get description of currently selected status
 	var description string
-	if item, ok := m.list.SelectedItem().(statusItem); ok && item.description != "" {
-		description = item.description
+	if item, ok := m.list.SelectedItem().(statusItem); ok {
+		if item.disallowedReason != "" {
+			description = "Not allowed: " + item.disallowedReason
+		} else {
+			description = item.description
+		}
 	}
 
 	// For multi-select, don't show individual bean ID
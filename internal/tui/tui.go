@@ -25,6 +25,7 @@ const (
 	viewList viewState = iota
 	viewDetail
 	viewTagPicker
+	viewViewPicker
 	viewParentPicker
 	viewStatusPicker
 	viewTypePicker
@@ -67,6 +68,14 @@ type tagSelectedMsg struct {
 	tag string
 }
 
+// openViewPickerMsg requests opening the named view switcher
+type openViewPickerMsg struct{}
+
+// viewSelectedMsg is sent when a named view is selected from the switcher
+type viewSelectedMsg struct {
+	name string
+}
+
 // clearFilterMsg is sent to clear any active filter
 type clearFilterMsg struct{}
 
@@ -101,6 +110,7 @@ type App struct {
 	detail         detailModel
 	preview        previewModel
 	tagPicker      tagPickerModel
+	viewPicker     viewPickerModel
 	parentPicker   parentPickerModel
 	statusPicker   statusPickerModel
 	typePicker     typePickerModel
@@ -179,6 +189,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				case "t":
 					// "g t" - go to tags
 					return a, func() tea.Msg { return openTagPickerMsg{} }
+				case "v":
+					// "g v" - switch views
+					return a, func() tea.Msg { return openViewPickerMsg{} }
 				default:
 					// Invalid second key, ignore the chord
 				}
@@ -208,7 +221,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return a, a.helpOverlay.Init()
 			}
 		case "q":
-			if a.state == viewDetail || a.state == viewTagPicker || a.state == viewParentPicker || a.state == viewStatusPicker || a.state == viewTypePicker || a.state == viewBlockingPicker || a.state == viewPriorityPicker || a.state == viewHelpOverlay {
+			if a.state == viewDetail || a.state == viewTagPicker || a.state == viewViewPicker || a.state == viewParentPicker || a.state == viewStatusPicker || a.state == viewTypePicker || a.state == viewBlockingPicker || a.state == viewPriorityPicker || a.state == viewHelpOverlay {
 				return a, tea.Quit
 			}
 			// For list, only quit if not filtering
@@ -252,8 +265,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.state = viewList
 				a.history = nil
 			} else {
-				// Recreate detail view with fresh bean data
+				// Recreate detail view with fresh bean data, keeping the comments pane open
+				showComments := a.detail.showComments
 				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
+				if showComments {
+					a.detail.setShowComments(true)
+					a.detail.linksActive = false
+				}
 			}
 		}
 		// Trigger list refresh
@@ -275,10 +293,24 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.list.setTagFilter(msg.tag)
 		return a, a.list.loadBeans
 
+	case openViewPickerMsg:
+		if len(a.config.Views) == 0 {
+			// No views declared, don't open picker
+			return a, nil
+		}
+		a.viewPicker = newViewPickerModel(a.config.Views, a.width, a.height)
+		a.state = viewViewPicker
+		return a, a.viewPicker.Init()
+
+	case viewSelectedMsg:
+		a.state = viewList
+		a.list.setView(msg.name)
+		return a, a.list.loadBeans
+
 	case openParentPickerMsg:
 		// Check if all bean types can have parents
 		for _, beanType := range msg.beanTypes {
-			if beancore.ValidParentTypes(beanType) == nil {
+			if a.config.ValidParentTypes(beanType) == nil {
 				// At least one bean type (e.g., milestone) cannot have parents - don't open the picker
 				return a, nil
 			}
@@ -295,7 +327,17 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case openStatusPickerMsg:
 		a.previousState = a.state
-		a.statusPicker = newStatusPickerModel(msg.beanIDs, msg.beanTitle, msg.currentStatus, a.config, a.width, a.height)
+		// For a single bean, mark statuses the workflow doesn't allow
+		var disallowed map[string]string
+		if len(msg.beanIDs) == 1 {
+			if b, err := a.resolver.Core.Get(msg.beanIDs[0]); err == nil {
+				disallowed = make(map[string]string)
+				for status, terr := range a.resolver.Core.TransitionErrors(b) {
+					disallowed[status] = terr.Reason
+				}
+			}
+		}
+		a.statusPicker = newStatusPickerModel(msg.beanIDs, msg.beanTitle, msg.currentStatus, disallowed, a.config, a.width, a.height)
 		a.state = viewStatusPicker
 		return a, a.statusPicker.Init()
 
@@ -306,12 +348,16 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case statusSelectedMsg:
 		// Update all beans' status via GraphQL mutations
+		var failed []string
+		var lastErr error
 		for _, beanID := range msg.beanIDs {
 			_, err := a.resolver.UpdateBean(context.Background(), beanID, model.UpdateBeanInput{
 				Status: &msg.status,
 			})
 			if err != nil {
 				// Continue with other beans even if one fails
+				failed = append(failed, beanID)
+				lastErr = err
 				continue
 			}
 		}
@@ -325,6 +371,18 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
 			}
 		}
+		// Surface workflow violations and other failures
+		if len(failed) > 0 {
+			statusMsg := lastErr.Error()
+			if len(failed) > 1 {
+				statusMsg = fmt.Sprintf("%d beans not updated (last error: %v)", len(failed), lastErr)
+			}
+			if a.state == viewDetail {
+				a.detail.statusMessage = statusMsg
+			} else {
+				a.list.statusMessage = statusMsg
+			}
+		}
 		return a, a.list.loadBeans
 
 	case openTypePickerMsg:
@@ -444,7 +502,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case openCreateModalMsg:
 		a.previousState = a.state
-		a.createModal = newCreateModalModel(a.width, a.height)
+		templates, _ := a.core.Templates()
+		a.createModal = newCreateModalModel(templates, a.width, a.height)
 		a.state = viewCreateModal
 		return a, a.createModal.Init()
 
@@ -454,11 +513,19 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case beanCreatedMsg:
 		// Create the bean via GraphQL mutation with draft status
+		// (falling back to the default status if draft isn't configured)
 		draftStatus := "draft"
-		createdBean, err := a.resolver.CreateBean(context.Background(), model.CreateBeanInput{
+		if !a.config.IsValidStatus(draftStatus) {
+			draftStatus = a.config.GetDefaultStatus()
+		}
+		input := model.CreateBeanInput{
 			Title:  msg.title,
 			Status: &draftStatus,
-		})
+		}
+		if msg.template != "" {
+			input.Template = &msg.template
+		}
+		createdBean, err := a.resolver.CreateBean(context.Background(), input)
 		if err != nil {
 			// TODO: Show error to user
 			a.state = a.previousState
@@ -600,6 +667,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.detail, cmd = a.detail.Update(msg)
 	case viewTagPicker:
 		a.tagPicker, cmd = a.tagPicker.Update(msg)
+	case viewViewPicker:
+		a.viewPicker, cmd = a.viewPicker.Update(msg)
 	case viewParentPicker:
 		a.parentPicker, cmd = a.parentPicker.Update(msg)
 	case viewStatusPicker:
@@ -671,6 +740,8 @@ func (a *App) View() string {
 		return a.detail.View()
 	case viewTagPicker:
 		return a.tagPicker.View()
+	case viewViewPicker:
+		return a.viewPicker.View()
 	case viewParentPicker:
 		return a.parentPicker.ModalView(a.getBackgroundView(), a.width, a.height)
 	case viewStatusPicker:
@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// viewItem wraps a configured view to implement list.Item
+type viewItem struct {
+	name        string
+	description string
+}
+
+func (i viewItem) Title() string       { return i.name }
+func (i viewItem) Description() string { return i.description }
+func (i viewItem) FilterValue() string { return i.name + " " + i.description }
+
+// viewItemDelegate handles rendering of view items
+type viewItemDelegate struct{}
+
+func (d viewItemDelegate) Height() int                             { return 1 }
+func (d viewItemDelegate) Spacing() int                            { return 0 }
+func (d viewItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d viewItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(viewItem)
+	if !ok {
+		return
+	}
+
+	var cursor string
+	if index == m.Index() {
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+	} else {
+		cursor = "  "
+	}
+
+	name := ui.Bold.Render(item.name)
+	var description string
+	if item.description != "" {
+		description = ui.Muted.Render("  " + item.description)
+	}
+
+	fmt.Fprint(w, cursor+name+description)
+}
+
+// viewPickerModel is the model for the named view switcher
+type viewPickerModel struct {
+	list   list.Model
+	width  int
+	height int
+}
+
+func newViewPickerModel(views []config.ViewConfig, width, height int) viewPickerModel {
+	delegate := viewItemDelegate{}
+
+	// Views keep their configured order
+	items := make([]list.Item, len(views))
+	for i, v := range views {
+		items[i] = viewItem{name: v.Name, description: v.Description}
+	}
+
+	l := list.New(items, delegate, width-4, height-6)
+	l.Title = "Switch View"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.Title = listTitleStyle
+	l.Styles.TitleBar = lipgloss.NewStyle().Padding(0, 0, 1, 1)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+
+	return viewPickerModel{
+		list:   l,
+		width:  width,
+		height: height,
+	}
+}
+
+func (m viewPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m viewPickerModel) Update(msg tea.Msg) (viewPickerModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.list.SetSize(msg.Width-4, msg.Height-6)
+
+	case tea.KeyMsg:
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "enter":
+				if item, ok := m.list.SelectedItem().(viewItem); ok {
+					return m, func() tea.Msg {
+						return viewSelectedMsg{name: item.name}
+					}
+				}
+			case "esc", "backspace":
+				// Return to list without switching views
+				return m, func() tea.Msg {
+					return backToListMsg{}
+				}
+			}
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m viewPickerModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	// Simple bordered container
+	border := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ui.ColorPrimary).
+		Width(m.width - 2).
+		Height(m.height - 4)
+
+	content := border.Render(m.list.View())
+
+	// Footer
+	help := helpKeyStyle.Render("enter") + " " + helpStyle.Render("select") + "  " +
+		helpKeyStyle.Render("/") + " " + helpStyle.Render("filter") + "  " +
+		helpKeyStyle.Render("esc") + " " + helpStyle.Render("cancel") + "  " +
+		helpKeyStyle.Render("q") + " " + helpStyle.Render("quit")
+
+	return content + "\n" + help
+}
@@ -345,6 +345,23 @@ func RenderPrioritySymbol(priority, color string) string {
 	return style.Render(symbol)
 }
 
+// ProgressBar returns a plain text progress bar of the given width for a
+// fraction between 0 and 1, e.g. "███░░░░░░░".
+func ProgressBar(fraction float64, width int) string {
+	fraction = max(0, min(1, fraction))
+	filled := int(fraction*float64(width) + 0.5)
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// RenderProgressBar renders a colored progress bar followed by the percentage.
+func RenderProgressBar(fraction float64, width int) string {
+	bar := ProgressBar(fraction, width)
+	filled := strings.TrimRight(bar, "░")
+	style := lipgloss.NewStyle().Foreground(ColorSuccess)
+	return style.Render(filled) + Muted.Render(bar[len(filled):]) +
+		fmt.Sprintf(" %3d%%", int(max(0, min(1, fraction))*100+0.5))
+}
+
 // BeanRowConfig holds configuration for rendering a bean row
 type BeanRowConfig struct {
 	StatusColor   string
@@ -365,6 +382,7 @@ type BeanRowConfig struct {
 	IDColWidth      int      // Width of ID column (0 = default of ColWidthID)
 	UseFullNames    bool     // Use full type/status names instead of single-char abbreviations
 	ImplicitStatus string   // Implicit terminal status from an ancestor (e.g., "scrapped")
+	Overdue        bool     // Past its due date and not completed (title highlighted)
 }
 
 // Base column widths for bean lists (minimum sizes)
@@ -572,6 +590,8 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 			cursor = " "
 			if cfg.Dimmed {
 				titleStyled = Muted.Render(displayTitle)
+			} else if cfg.Overdue {
+				titleStyled = Danger.Render(displayTitle)
 			} else {
 				titleStyled = displayTitle
 			}
@@ -580,6 +600,8 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 		cursor = ""
 		if cfg.Dimmed {
 			titleStyled = Muted.Render(displayTitle)
+		} else if cfg.Overdue {
+			titleStyled = Danger.Render(displayTitle)
 		} else {
 			titleStyled = displayTitle
 		}
@@ -590,6 +612,9 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 	if cfg.ImplicitStatus != "" && !cfg.Dimmed {
 		implicitAnnotation = Muted.Render(" ↑" + cfg.ImplicitStatus)
 	}
+	if cfg.Overdue && !cfg.Dimmed {
+		implicitAnnotation += Danger.Render(" overdue")
+	}
 
 	if cfg.ShowTags {
 		// Pad title column to fixed width so tags align in a column
@@ -131,3 +131,23 @@ func TestShortStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		fraction float64
+		width    int
+		want     string
+	}{
+		{0, 4, "░░░░"},
+		{0.5, 4, "██░░"},
+		{1, 4, "████"},
+		{1.5, 4, "████"},
+		{-1, 4, "░░░░"},
+		{0.33, 10, "███░░░░░░░"},
+	}
+	for _, tt := range tests {
+		if got := ProgressBar(tt.fraction, tt.width); got != tt.want {
+			t.Errorf("ProgressBar(%v, %d) = %q, want %q", tt.fraction, tt.width, got, tt.want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"html"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -14,6 +15,7 @@ type TreeNode struct {
 	Children        []*TreeNode
 	Matched         bool   // true if this bean matched the filter (vs. shown for context)
 	ImplicitStatus string // implicit terminal status from an ancestor, if any
+	Snippet        string // highlighted search match, rendered below the bean (see RenderSnippet)
 }
 
 // TreeNodeJSON is the JSON-serializable version of TreeNode.
@@ -304,6 +306,92 @@ func renderNode(sb *strings.Builder, node *TreeNode, depth int, isLast bool, anc
 
 	sb.WriteString(row)
 	sb.WriteString("\n")
+
+	if node.Snippet != "" {
+		// Continue the tree lines past the snippet
+		var linePrefix string
+		if depth > 0 {
+			for _, wasLast := range ancestry {
+				if wasLast {
+					linePrefix += treeSpace
+				} else {
+					linePrefix += treePipe
+				}
+			}
+			if isLast {
+				linePrefix += treeSpace
+			} else {
+				linePrefix += treePipe
+			}
+		}
+		if len(node.Children) > 0 {
+			linePrefix += treePipe
+		}
+		indent := renderCfg.treeColWidth + ColWidthType + ColWidthStatus - len([]rune(linePrefix))
+		sb.WriteString(TreeLine.Render(linePrefix) + strings.Repeat(" ", max(indent, 1)))
+		sb.WriteString(RenderSnippet(node.Snippet, renderCfg.titleWidth))
+		sb.WriteString("\n")
+	}
+}
+
+// RenderSnippet renders a search snippet (HTML-escaped text with matches
+// wrapped in <mark> tags) on a single line of at most maxWidth characters,
+// with the matches highlighted. Leading text is cut if the first match
+// wouldn't fit otherwise.
+func RenderSnippet(snippet string, maxWidth int) string {
+	type segment struct {
+		text   []rune
+		marked bool
+	}
+	var segments []segment
+	firstMatch, firstMatchLen := -1, 0 // rune offset and length of the first match
+	offset := 0
+	for i, part := range strings.Split(strings.Join(strings.Fields(snippet), " "), "<mark>") {
+		// Every part but the first starts with a match
+		marked, plain := "", part
+		if i > 0 {
+			marked, plain, _ = strings.Cut(part, "</mark>")
+		}
+		for _, seg := range []segment{{[]rune(html.UnescapeString(marked)), true}, {[]rune(html.UnescapeString(plain)), false}} {
+			if len(seg.text) == 0 {
+				continue
+			}
+			if seg.marked && firstMatch < 0 {
+				firstMatch, firstMatchLen = offset, len(seg.text)
+			}
+			offset += len(seg.text)
+			segments = append(segments, seg)
+		}
+	}
+
+	var sb strings.Builder
+	width := 0
+	skip := 0
+	if firstMatch+firstMatchLen >= maxWidth {
+		skip = firstMatch - maxWidth/3
+		sb.WriteString(Muted.Render("…"))
+		width++
+	}
+	for _, seg := range segments {
+		text := seg.text
+		if skip >= len(text) {
+			skip -= len(text)
+			continue
+		}
+		text, skip = text[skip:], 0
+
+		style := Muted
+		if seg.marked {
+			style = Warning.Bold(true)
+		}
+		if width+len(text) > maxWidth {
+			sb.WriteString(style.Render(string(text[:max(maxWidth-width-1, 0)]) + "…"))
+			break
+		}
+		width += len(text)
+		sb.WriteString(style.Render(string(text)))
+	}
+	return sb.String()
 }
 
 // FlatItem represents a flattened tree node with rendering context.
@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/charmbracelet/x/ansi"
 	"github.com/hmans/beans/pkg/bean"
 )
 
@@ -208,3 +209,26 @@ func TestTreeNodeToJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		snippet  string
+		maxWidth int
+		want     string
+	}{
+		{"plain", "a <mark>match</mark> here", 40, "a match here"},
+		{"unescapes and joins lines", "x &lt; <mark>y</mark>\n\nz", 40, "x < y z"},
+		{"truncates", "<mark>match</mark> and a long tail", 12, "match and a…"},
+		{"scrolls to first match", "a long introduction before the <mark>match</mark>", 20, "…e the match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Strip styling, which depends on the terminal
+			got := ansi.Strip(RenderSnippet(tt.snippet, tt.maxWidth))
+			if got != tt.want {
+				t.Errorf("RenderSnippet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
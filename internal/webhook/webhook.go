@@ -0,0 +1,365 @@
+// Package webhook delivers bean change events to the webhooks declared in
+// .beans.yml. Each event matching a webhook's events and filter is POSTed as
+// a signed JSON payload; failed deliveries are retried with exponential
+// backoff. Recent deliveries are kept in memory so their status can be queried.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hmans/beans/internal/version"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Headers sent with every delivery.
+const (
+	EventHeader     = "X-Beans-Event"
+	DeliveryHeader  = "X-Beans-Delivery"
+	SignatureHeader = "X-Beans-Signature"
+)
+
+const (
+	// historySize is the number of deliveries kept for Deliveries.
+	historySize = 200
+	// queueSize is the number of deliveries a webhook can have waiting.
+	queueSize = 100
+)
+
+// Status is the state of a delivery.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Delivery records an event sent (or being sent) to a webhook.
+type Delivery struct {
+	ID      string
+	Webhook string
+	Event   string
+	BeanID  string
+	Status  Status
+	// Attempts is the number of requests made so far.
+	Attempts int
+	// StatusCode is the HTTP status of the last response (0 if there was none).
+	StatusCode int
+	// Error describes why the last attempt failed.
+	Error         string
+	CreatedAt     time.Time
+	LastAttemptAt time.Time
+	// NextAttemptAt is set while a retry is scheduled.
+	NextAttemptAt time.Time
+
+	payload []byte
+}
+
+// Payload is the JSON body POSTed to a webhook.
+type Payload struct {
+	// ID identifies the delivery; retries of a delivery reuse it.
+	ID        string    `json:"id"`
+	Webhook   string    `json:"webhook"`
+	Event     string    `json:"event"`
+	BeanID    string    `json:"bean_id"`
+	Timestamp time.Time `json:"timestamp"`
+	// Bean is the bean after the change, or before it for deleted events.
+	Bean *bean.Bean `json:"bean"`
+	// Changes lists the fields changed by an updated event.
+	Changes []beancore.FieldChange `json:"changes,omitempty"`
+}
+
+// Sign returns the signature of a payload, as sent in the X-Beans-Signature
+// header: "sha256=" followed by the hex HMAC-SHA256 of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher subscribes to a Core's bean events and delivers them to webhooks.
+type Dispatcher struct {
+	core   *beancore.Core
+	hooks  []*hook
+	client *http.Client
+
+	// Backoff is the delay before the first retry. It doubles with each
+	// further retry, up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	mu         sync.Mutex
+	deliveries []*Delivery // oldest first, at most historySize
+}
+
+// hook is a configured webhook and its queue of pending deliveries.
+type hook struct {
+	config.WebhookConfig
+	queue chan *Delivery
+}
+
+// New creates a dispatcher for the given webhooks. It returns an error if a
+// webhook's filter can't be resolved.
+func New(core *beancore.Core, webhooks []config.WebhookConfig) (*Dispatcher, error) {
+	d := &Dispatcher{
+		core:       core,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		Backoff:    time.Second,
+		MaxBackoff: 5 * time.Minute,
+	}
+	for _, w := range webhooks {
+		h := &hook{WebhookConfig: w, queue: make(chan *Delivery, queueSize)}
+		if _, err := d.filter(h); err != nil {
+			return nil, err
+		}
+		d.hooks = append(d.hooks, h)
+	}
+	return d, nil
+}
+
+// Start subscribes to bean events and starts delivering them until ctx is
+// canceled. The Core must be watching for changes to see edits made outside
+// this process.
+func (d *Dispatcher) Start(ctx context.Context) {
+	if len(d.hooks) == 0 {
+		return
+	}
+
+	events, unsubscribe := d.core.Subscribe()
+	tracker := d.core.NewChangeTracker()
+
+	for _, h := range d.hooks {
+		go d.work(ctx, h)
+	}
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case batch, ok := <-events:
+				if !ok {
+					return
+				}
+				for _, event := range batch {
+					if change, ok := tracker.Track(event); ok {
+						d.handle(change)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Deliveries returns recorded deliveries, newest first. An empty webhook or
+// status matches all deliveries; limit <= 0 returns all of them.
+func (d *Dispatcher) Deliveries(webhook string, status Status, limit int) []Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var result []Delivery
+	for _, del := range slices.Backward(d.deliveries) {
+		if (webhook != "" && del.Webhook != webhook) || (status != "" && del.Status != status) {
+			continue
+		}
+		result = append(result, *del)
+		if limit > 0 && len(result) == limit {
+			break
+		}
+	}
+	return result
+}
+
+// filter resolves a webhook's filter. Relative dates are resolved against
+// today, so the filter is rebuilt for every event.
+func (d *Dispatcher) filter(h *hook) (*model.BeanFilter, error) {
+	filter, err := beangraph.ConfigFilter(d.core.Config(), &h.Filter, d.core.CurrentUser(), bean.Today())
+	if err != nil {
+		return nil, fmt.Errorf("webhook %s: %w", h.Name, err)
+	}
+	return filter, nil
+}
+
+// matches reports whether a bean passes a webhook's filter.
+func (d *Dispatcher) matches(h *hook, b *bean.Bean) bool {
+	filter, err := d.filter(h)
+	if err == nil {
+		var ok bool
+		if ok, err = beangraph.Matches(b, filter, d.core); err == nil {
+			return ok
+		}
+	}
+	log.Printf("[webhook] %s: %v", h.Name, err)
+	return false
+}
+
+// handle queues deliveries of a change to the webhooks that want it.
+func (d *Dispatcher) handle(change beancore.Change) {
+	payload := Payload{
+		Event:     change.Type.String(),
+		BeanID:    change.BeanID,
+		Timestamp: time.Now().UTC(),
+		Bean:      change.Bean,
+		Changes:   change.Fields,
+	}
+	for _, h := range d.hooks {
+		if !h.WantsEvent(payload.Event) || !d.matches(h, change.Bean) {
+			continue
+		}
+		if h.OnEnter && change.Type == beancore.EventUpdated && d.matches(h, change.Previous) {
+			continue
+		}
+		d.enqueue(h, payload)
+	}
+}
+
+// enqueue records a delivery of the payload to a webhook and queues it.
+func (d *Dispatcher) enqueue(h *hook, payload Payload) {
+	payload.ID = newDeliveryID()
+	payload.Webhook = h.Name
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("[webhook] %s: failed to encode payload: %v", h.Name, err)
+		return
+	}
+
+	del := &Delivery{
+		ID:        payload.ID,
+		Webhook:   h.Name,
+		Event:     payload.Event,
+		BeanID:    payload.BeanID,
+		Status:    StatusPending,
+		CreatedAt: payload.Timestamp,
+		payload:   body,
+	}
+	d.mu.Lock()
+	d.deliveries = append(d.deliveries, del)
+	if len(d.deliveries) > historySize {
+		d.deliveries = slices.Delete(d.deliveries, 0, len(d.deliveries)-historySize)
+	}
+	d.mu.Unlock()
+
+	select {
+	case h.queue <- del:
+	default:
+		d.finish(del, StatusFailed, "delivery queue full")
+	}
+}
+
+// work delivers a webhook's queued deliveries in order until ctx is canceled.
+func (d *Dispatcher) work(ctx context.Context, h *hook) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case del := <-h.queue:
+			d.deliver(ctx, h, del)
+		}
+	}
+}
+
+// deliver sends a delivery, retrying with exponential backoff on network
+// errors, timeouts, rate limiting, and server errors.
+func (d *Dispatcher) deliver(ctx context.Context, h *hook, del *Delivery) {
+	backoff := d.Backoff
+	for attempt := 1; ; attempt++ {
+		code, err := d.send(ctx, h, del)
+
+		d.mu.Lock()
+		del.Attempts = attempt
+		del.StatusCode = code
+		del.LastAttemptAt = time.Now().UTC()
+		del.NextAttemptAt = time.Time{}
+		d.mu.Unlock()
+
+		if err == nil {
+			d.finish(del, StatusSucceeded, "")
+			return
+		}
+		if !retryable(code) || attempt >= h.GetMaxAttempts() || ctx.Err() != nil {
+			log.Printf("[webhook] %s: delivery %s of %s %s failed after %d attempt(s): %v", h.Name, del.ID, del.BeanID, del.Event, attempt, err)
+			d.finish(del, StatusFailed, err.Error())
+			return
+		}
+
+		d.mu.Lock()
+		del.Error = err.Error()
+		del.NextAttemptAt = time.Now().UTC().Add(backoff)
+		d.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			d.finish(del, StatusFailed, ctx.Err().Error())
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, d.MaxBackoff)
+	}
+}
+
+// send makes a single delivery attempt and returns the response status code
+// (0 if there was no response).
+func (d *Dispatcher) send(ctx context.Context, h *hook, del *Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(del.payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "beans/"+version.Version)
+	req.Header.Set(EventHeader, del.Event)
+	req.Header.Set(DeliveryHeader, del.ID)
+	if secret := h.GetSecret(); secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, del.payload))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// finish sets a delivery's final status.
+func (d *Dispatcher) finish(del *Delivery, status Status, errMsg string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	del.Status = status
+	del.Error = errMsg
+	del.NextAttemptAt = time.Time{}
+}
+
+// retryable reports whether a failed attempt with the given status code
+// (0 for no response) is worth retrying.
+func retryable(code int) bool {
+	return code == 0 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500
+}
+
+// newDeliveryID returns a random delivery ID.
+func newDeliveryID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
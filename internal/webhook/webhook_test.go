@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// receiver is a local webhook endpoint that records the requests it gets.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*receivedRequest
+	// status returns the response code for the nth request (1-based) to a path.
+	status func(path string, n int) int
+}
+
+type receivedRequest struct {
+	path    string
+	header  http.Header
+	body    []byte
+	payload Payload
+}
+
+func newReceiver(t *testing.T) *receiver {
+	t.Helper()
+	r := &receiver{status: func(string, int) int { return http.StatusOK }}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		got := &receivedRequest{path: req.URL.Path, header: req.Header, body: body}
+		if err := json.Unmarshal(body, &got.payload); err != nil {
+			t.Errorf("invalid payload: %v\n%s", err, body)
+		}
+		r.mu.Lock()
+		r.requests = append(r.requests, got)
+		n := len(r.received(req.URL.Path))
+		r.mu.Unlock()
+		w.WriteHeader(r.status(req.URL.Path, n))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// received returns the requests made to a path. Callers must hold r.mu.
+func (r *receiver) received(path string) []*receivedRequest {
+	var result []*receivedRequest
+	for _, req := range r.requests {
+		if req.path == path {
+			result = append(result, req)
+		}
+	}
+	return result
+}
+
+// waitFor returns the requests to a path once there are n of them.
+func (r *receiver) waitFor(t *testing.T, path string, n int) []*receivedRequest {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		got := r.received(path)
+		r.mu.Unlock()
+		if len(got) >= n {
+			return got
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d requests to %s", n, path)
+	return nil
+}
+
+// startDispatcher starts a dispatcher on a watching core with the given webhooks.
+func startDispatcher(t *testing.T, webhooks []config.WebhookConfig) (*Dispatcher, *beancore.Core) {
+	t.Helper()
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	core := beancore.New(beansDir, config.Default())
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.StartWatching(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { core.Unwatch() })
+
+	d, err := New(core, webhooks)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	d.Backoff = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	d.Start(ctx)
+	return d, core
+}
+
+// waitForDelivery returns the first delivery to a webhook once it is no longer pending.
+func waitForDelivery(t *testing.T, d *Dispatcher, webhook string) Delivery {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if got := d.Deliveries(webhook, "", 0); len(got) > 0 && got[0].Status != StatusPending {
+			return got[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for a delivery to %s", webhook)
+	return Delivery{}
+}
+
+func TestDispatcherEvents(t *testing.T) {
+	recv := newReceiver(t)
+	d, core := startDispatcher(t, []config.WebhookConfig{
+		{Name: "all", URL: recv.URL + "/all", Secret: "s3cret"},
+		{Name: "completed", URL: recv.URL + "/completed", Events: []string{"updated"}, Filter: config.ViewFilter{Status: []string{"completed"}}, OnEnter: true},
+		{Name: "critical-bugs", URL: recv.URL + "/critical", Events: []string{"created"}, Filter: config.ViewFilter{Type: []string{"bug"}, Priority: []string{"critical"}}},
+	})
+
+	task := &bean.Bean{ID: "task-1", Slug: "task", Title: "Task", Status: "todo", Type: "task"}
+	if err := core.Create(task); err != nil {
+		t.Fatal(err)
+	}
+	created := recv.waitFor(t, "/all", 1)[0]
+
+	t.Run("signed payload", func(t *testing.T) {
+		if got := created.header.Get(SignatureHeader); got != Sign("s3cret", created.body) {
+			t.Errorf("signature = %q, want %q", got, Sign("s3cret", created.body))
+		}
+		if created.header.Get(EventHeader) != "created" || created.header.Get(DeliveryHeader) != created.payload.ID {
+			t.Errorf("headers = %v", created.header)
+		}
+		p := created.payload
+		if p.Webhook != "all" || p.Event != "created" || p.BeanID != "task-1" || p.Bean == nil || p.Bean.Title != "Task" {
+			t.Errorf("payload = %+v", p)
+		}
+	})
+
+	bug := &bean.Bean{ID: "bug-1", Slug: "bug", Title: "Crash", Status: "todo", Type: "bug", Priority: "critical"}
+	if err := core.Create(bug); err != nil {
+		t.Fatal(err)
+	}
+	if got := recv.waitFor(t, "/critical", 1); got[0].payload.BeanID != "bug-1" {
+		t.Errorf("critical bug webhook got %s", got[0].payload.BeanID)
+	}
+
+	task.Status = "completed"
+	if err := core.Update(task, nil); err != nil {
+		t.Fatal(err)
+	}
+	completed := recv.waitFor(t, "/completed", 1)[0]
+	if completed.payload.BeanID != "task-1" || len(completed.payload.Changes) != 1 || completed.payload.Changes[0].Field != "status" || completed.payload.Changes[0].To != "completed" {
+		t.Errorf("completed payload = %+v", completed.payload)
+	}
+	if completed.header.Get(SignatureHeader) != "" {
+		t.Error("unsigned webhook sent a signature")
+	}
+
+	t.Run("on_enter skips updates of matching beans", func(t *testing.T) {
+		task.Title = "Renamed task"
+		if err := core.Update(task, nil); err != nil {
+			t.Fatal(err)
+		}
+		recv.waitFor(t, "/all", 4) // created task, created bug, completed, renamed
+		recv.mu.Lock()
+		defer recv.mu.Unlock()
+		if got := recv.received("/completed"); len(got) != 1 {
+			t.Errorf("completed webhook got %d requests, want 1", len(got))
+		}
+		if got := recv.received("/critical"); len(got) != 1 {
+			t.Errorf("critical bug webhook got %d requests, want 1", len(got))
+		}
+	})
+
+	t.Run("deleted beans carry their last version", func(t *testing.T) {
+		if err := core.Delete("bug-1"); err != nil {
+			t.Fatal(err)
+		}
+		deleted := recv.waitFor(t, "/all", 5)[4].payload
+		if deleted.Event != "deleted" || deleted.BeanID != "bug-1" || deleted.Bean == nil || deleted.Bean.Title != "Crash" {
+			t.Errorf("deleted payload = %+v", deleted)
+		}
+	})
+
+	t.Run("deliveries", func(t *testing.T) {
+		waitForDelivery(t, d, "all")
+		all := d.Deliveries("", "", 0)
+		if len(all) != 7 {
+			t.Fatalf("Deliveries() returned %d, want 7", len(all))
+		}
+		if all[0].Event != "deleted" || all[len(all)-1].Event != "created" {
+			t.Errorf("Deliveries() not newest first: %s ... %s", all[0].Event, all[len(all)-1].Event)
+		}
+		if got := d.Deliveries("completed", StatusSucceeded, 0); len(got) != 1 || got[0].Attempts != 1 || got[0].StatusCode != http.StatusOK {
+			t.Errorf("Deliveries(completed) = %+v", got)
+		}
+		if got := d.Deliveries("all", "", 2); len(got) != 2 {
+			t.Errorf("Deliveries(all, limit 2) returned %d", len(got))
+		}
+	})
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	recv := newReceiver(t)
+	recv.status = func(path string, n int) int {
+		switch {
+		case path == "/flaky" && n <= 2:
+			return http.StatusInternalServerError
+		case path == "/rejecting":
+			return http.StatusBadRequest
+		case path == "/down":
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	}
+	d, core := startDispatcher(t, []config.WebhookConfig{
+		{Name: "flaky", URL: recv.URL + "/flaky"},
+		{Name: "rejecting", URL: recv.URL + "/rejecting"},
+		{Name: "down", URL: recv.URL + "/down", MaxAttempts: 2},
+	})
+
+	if err := core.Create(&bean.Bean{ID: "b-1", Slug: "b", Title: "B", Status: "todo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		webhook    string
+		status     Status
+		attempts   int
+		statusCode int
+	}{
+		{"flaky", StatusSucceeded, 3, http.StatusOK},
+		{"rejecting", StatusFailed, 1, http.StatusBadRequest},
+		{"down", StatusFailed, 2, http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.webhook, func(t *testing.T) {
+			got := waitForDelivery(t, d, tt.webhook)
+			if got.Status != tt.status || got.Attempts != tt.attempts || got.StatusCode != tt.statusCode {
+				t.Errorf("delivery = %s after %d attempts (HTTP %d), want %s after %d (HTTP %d)",
+					got.Status, got.Attempts, got.StatusCode, tt.status, tt.attempts, tt.statusCode)
+			}
+			if tt.status == StatusFailed && got.Error == "" {
+				t.Error("failed delivery has no error")
+			}
+		})
+	}
+
+	recv.mu.Lock()
+	defer recv.mu.Unlock()
+	if flaky := recv.received("/flaky"); flaky[0].payload.ID != flaky[2].payload.ID {
+		t.Error("retries should reuse the delivery ID")
+	}
+}
@@ -0,0 +1,45 @@
+package bean
+
+import (
+	"slices"
+	"strings"
+)
+
+// HasAssignees returns true if the bean is assigned to anyone.
+func (b *Bean) HasAssignees() bool {
+	return len(b.Assignees) > 0
+}
+
+// IsAssignedTo returns true if the given user is one of the bean's assignees.
+// Comparison is case-insensitive, so email addresses match regardless of case.
+func (b *Bean) IsAssignedTo(user string) bool {
+	user = strings.TrimSpace(user)
+	for _, a := range b.Assignees {
+		if strings.EqualFold(a, user) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddAssignee adds a user to the assignee list if not already present.
+// Returns false if the user is empty or already assigned.
+func (b *Bean) AddAssignee(user string) bool {
+	user = strings.TrimSpace(user)
+	if user == "" || b.IsAssignedTo(user) {
+		return false
+	}
+	b.Assignees = append(b.Assignees, user)
+	return true
+}
+
+// RemoveAssignee removes a user from the assignee list (case-insensitive).
+func (b *Bean) RemoveAssignee(user string) {
+	user = strings.TrimSpace(user)
+	b.Assignees = slices.DeleteFunc(b.Assignees, func(a string) bool {
+		return strings.EqualFold(a, user)
+	})
+	if len(b.Assignees) == 0 {
+		b.Assignees = nil
+	}
+}
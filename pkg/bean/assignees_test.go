@@ -0,0 +1,70 @@
+package bean
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAssigneesParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		front string
+		want  []string
+	}{
+		{"single", "assignee: alice@example.com", []string{"alice@example.com"}},
+		{"list", "assignees: [alice, bob]", []string{"alice", "bob"}},
+		{"both", "assignee: alice\nassignees: [bob]", []string{"alice", "bob"}},
+		{"none", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "---\ntitle: Test\nstatus: todo\n" + tt.front + "\n---\n"
+			b, err := Parse(strings.NewReader(content))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if strings.Join(b.Assignees, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Assignees = %v, want %v", b.Assignees, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssigneesRender(t *testing.T) {
+	b := &Bean{Title: "Test", Status: "todo"}
+	b.AddAssignee("alice")
+
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(out), "assignee: alice\n") || strings.Contains(string(out), "assignees:") {
+		t.Errorf("single assignee should render as scalar:\n%s", out)
+	}
+
+	b.AddAssignee("bob")
+	out, err = b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(string(out), "assignee:") || !strings.Contains(string(out), "assignees:") {
+		t.Errorf("multiple assignees should render as list:\n%s", out)
+	}
+}
+
+func TestAddRemoveAssignee(t *testing.T) {
+	b := &Bean{}
+	if !b.AddAssignee(" alice@example.com ") {
+		t.Error("AddAssignee() = false, want true")
+	}
+	if b.AddAssignee("ALICE@example.com") || b.AddAssignee("  ") {
+		t.Error("AddAssignee() should reject duplicates and empty names")
+	}
+	if !b.IsAssignedTo("Alice@Example.com") {
+		t.Error("IsAssignedTo() should be case-insensitive")
+	}
+	b.RemoveAssignee("alice@EXAMPLE.com")
+	if b.HasAssignees() || b.Assignees != nil {
+		t.Errorf("Assignees = %v, want nil after removing the last one", b.Assignees)
+	}
+}
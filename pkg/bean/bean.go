@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"maps"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -164,21 +166,83 @@ type Bean struct {
 
 	// BlockedBy is a list of bean IDs that are blocking this bean.
 	BlockedBy []string `yaml:"blocked_by,omitempty" json:"blocked_by,omitempty"`
+
+	// Relations maps link types (e.g. related, duplicates, supersedes) to the
+	// IDs of the beans this bean links to.
+	Relations map[string][]string `yaml:"-" json:"relations,omitempty"`
+
+	// Start is the optional date work is planned to begin.
+	Start *Date `yaml:"-" json:"start,omitempty"`
+
+	// Due is the optional date the bean should be done by.
+	Due *Date `yaml:"-" json:"due,omitempty"`
+
+	// Estimate is the optional size of the work (points or hours, per project convention).
+	Estimate float64 `yaml:"-" json:"estimate,omitempty"`
+
+	// Recur is the optional recurrence rule (see ParseRecurrence). When a
+	// recurring bean is completed, its next occurrence is created.
+	Recur string `yaml:"-" json:"recur,omitempty"`
+
+	// Previous is the ID of the bean this one is the next occurrence of.
+	Previous string `yaml:"-" json:"previous,omitempty"`
+
+	// Assignees lists who is working on this bean (email addresses, names, or agent identities).
+	// Stored as "assignee" in front matter when there is exactly one, "assignees" otherwise.
+	Assignees []string `yaml:"-" json:"assignees,omitempty"`
+
+	// Comments is the bean's discussion thread, oldest first.
+	Comments []Comment `yaml:"-" json:"comments,omitempty"`
+
+	// Fields holds user-defined custom front matter values, keyed by field name.
+	// Values are stored in their canonical string form.
+	Fields map[string]string `yaml:"-" json:"fields,omitempty"`
+
+	// extra holds unknown front matter keys with non-scalar values (lists, maps),
+	// preserved verbatim so they survive a parse/render round trip.
+	extra map[string]any
+}
+
+// Clone returns a copy of the bean that can be modified without affecting the
+// original. Slices and maps are copied; unknown front matter values are shared.
+func (b *Bean) Clone() *Bean {
+	c := *b
+	c.Tags = slices.Clone(b.Tags)
+	c.Blocking = slices.Clone(b.Blocking)
+	c.BlockedBy = slices.Clone(b.BlockedBy)
+	c.Relations = cloneRelations(b.Relations)
+	c.Assignees = slices.Clone(b.Assignees)
+	c.Comments = slices.Clone(b.Comments)
+	c.Fields = maps.Clone(b.Fields)
+	c.extra = maps.Clone(b.extra)
+	return &c
 }
 
 // frontMatter is the subset of Bean that gets serialized to YAML front matter.
 type frontMatter struct {
-	Title     string     `yaml:"title"`
-	Status    string     `yaml:"status"`
-	Type      string     `yaml:"type,omitempty"`
-	Priority  string     `yaml:"priority,omitempty"`
-	Tags      []string   `yaml:"tags,omitempty"`
-	CreatedAt *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt *time.Time `yaml:"updated_at,omitempty"`
-	Order     string     `yaml:"order,omitempty"`
-	Parent    string     `yaml:"parent,omitempty"`
-	Blocking  []string   `yaml:"blocking,omitempty"`
-	BlockedBy []string   `yaml:"blocked_by,omitempty"`
+	Title     string              `yaml:"title"`
+	Status    string              `yaml:"status"`
+	Type      string              `yaml:"type,omitempty"`
+	Priority  string              `yaml:"priority,omitempty"`
+	Tags      []string            `yaml:"tags,omitempty"`
+	CreatedAt *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time          `yaml:"updated_at,omitempty"`
+	Order     string              `yaml:"order,omitempty"`
+	Parent    string              `yaml:"parent,omitempty"`
+	Blocking  []string            `yaml:"blocking,omitempty"`
+	BlockedBy []string            `yaml:"blocked_by,omitempty"`
+	Relations map[string][]string `yaml:"relations,omitempty"`
+	Start     string              `yaml:"start,omitempty"`
+	Due       string              `yaml:"due,omitempty"`
+	Estimate  float64             `yaml:"estimate,omitempty"`
+	Recur     string              `yaml:"recur,omitempty"`
+	Previous  string              `yaml:"previous,omitempty"`
+	Assignee  string              `yaml:"assignee,omitempty"`
+	Assignees []string            `yaml:"assignees,omitempty"`
+	Comments  []Comment           `yaml:"comments,omitempty"`
+
+	// Extra captures all keys not listed above (custom fields).
+	Extra map[string]any `yaml:",inline"`
 }
 
 // Parse reads a bean from a reader (markdown with YAML front matter).
@@ -192,6 +256,24 @@ func Parse(r io.Reader) (*Bean, error) {
 	// Trim trailing newline from body (POSIX files end with newline, but it's not part of content)
 	bodyStr := strings.TrimSuffix(string(body), "\n")
 
+	fields, extra := splitExtraFrontMatter(fm.Extra)
+
+	// Accept both the single "assignee" and the list "assignees" forms
+	var assignees []string
+	if fm.Assignee != "" {
+		assignees = append(assignees, fm.Assignee)
+	}
+	assignees = append(assignees, fm.Assignees...)
+
+	start, err := parseFrontMatterDate("start", fm.Start)
+	if err != nil {
+		return nil, err
+	}
+	due, err := parseFrontMatterDate("due", fm.Due)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Bean{
 		Title:     fm.Title,
 		Status:    fm.Status,
@@ -205,22 +287,60 @@ func Parse(r io.Reader) (*Bean, error) {
 		Parent:    fm.Parent,
 		Blocking:  fm.Blocking,
 		BlockedBy: fm.BlockedBy,
+		Relations: normalizeRelations(fm.Relations),
+		Start:     start,
+		Due:       due,
+		Estimate:  fm.Estimate,
+		Recur:     fm.Recur,
+		Previous:  fm.Previous,
+		Assignees: assignees,
+		Comments:  fm.Comments,
+		Fields:    fields,
+		extra:     extra,
 	}, nil
 }
 
+// parseFrontMatterDate parses a YYYY-MM-DD date from front matter. Full
+// timestamps are accepted and truncated to their date. Returns nil for an empty value.
+func parseFrontMatterDate(field, value string) (*Date, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if len(value) > len(DateLayout) && value[len(DateLayout)] == 'T' {
+		value = value[:len(DateLayout)]
+	}
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s date %q (expected YYYY-MM-DD)", field, value)
+	}
+	return &Date{t}, nil
+}
+
 // renderFrontMatter is used for YAML output with yaml.v3 (supports custom marshalers).
 type renderFrontMatter struct {
-	Title     string     `yaml:"title"`
-	Status    string     `yaml:"status"`
-	Type      string     `yaml:"type,omitempty"`
-	Priority  string     `yaml:"priority,omitempty"`
-	Tags      []string   `yaml:"tags,omitempty"`
-	CreatedAt *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt *time.Time `yaml:"updated_at,omitempty"`
-	Order     string     `yaml:"order,omitempty"`
-	Parent    string     `yaml:"parent,omitempty"`
-	Blocking  []string   `yaml:"blocking,omitempty"`
-	BlockedBy []string   `yaml:"blocked_by,omitempty"`
+	Title     string              `yaml:"title"`
+	Status    string              `yaml:"status"`
+	Type      string              `yaml:"type,omitempty"`
+	Priority  string              `yaml:"priority,omitempty"`
+	Tags      []string            `yaml:"tags,omitempty"`
+	CreatedAt *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time          `yaml:"updated_at,omitempty"`
+	Order     string              `yaml:"order,omitempty"`
+	Parent    string              `yaml:"parent,omitempty"`
+	Blocking  []string            `yaml:"blocking,omitempty"`
+	BlockedBy []string            `yaml:"blocked_by,omitempty"`
+	Relations map[string][]string `yaml:"relations,omitempty"`
+	Start     *Date               `yaml:"start,omitempty"`
+	Due       *Date               `yaml:"due,omitempty"`
+	Estimate  float64             `yaml:"estimate,omitempty"`
+	Recur     string              `yaml:"recur,omitempty"`
+	Previous  string              `yaml:"previous,omitempty"`
+	Assignee  string              `yaml:"assignee,omitempty"`
+	Assignees []string            `yaml:"assignees,omitempty"`
+
+	// Extra holds custom fields and preserved unknown keys, rendered after the built-in keys.
+	Extra map[string]*yaml.Node `yaml:",inline"`
 }
 
 // Render serializes the bean back to markdown with YAML front matter.
@@ -237,9 +357,43 @@ func (b *Bean) Render() ([]byte, error) {
 		Parent:    b.Parent,
 		Blocking:  b.Blocking,
 		BlockedBy: b.BlockedBy,
+		Relations: normalizeRelations(b.Relations),
+		Start:     b.Start,
+		Due:       b.Due,
+		Estimate:  b.Estimate,
+		Recur:     b.Recur,
+		Previous:  b.Previous,
+	}
+	if len(b.Assignees) == 1 {
+		fm.Assignee = b.Assignees[0]
+	} else {
+		fm.Assignees = b.Assignees
+	}
+
+	extra, err := b.extraFrontMatterNodes()
+	if err != nil {
+		return nil, err
+	}
+	fm.Extra = extra
+
+	var fmNode yaml.Node
+	if err := fmNode.Encode(&fm); err != nil {
+		return nil, fmt.Errorf("marshaling front matter: %w", err)
+	}
+
+	// Comments go last so the thread doesn't push custom fields out of view.
+	if len(b.Comments) > 0 {
+		var commentsNode yaml.Node
+		if err := commentsNode.Encode(b.Comments); err != nil {
+			return nil, fmt.Errorf("marshaling comments: %w", err)
+		}
+		fmNode.Content = append(fmNode.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "comments"},
+			&commentsNode,
+		)
 	}
 
-	fmBytes, err := yaml.Marshal(&fm)
+	fmBytes, err := yaml.Marshal(&fmNode)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling front matter: %w", err)
 	}
@@ -1709,3 +1709,31 @@ func TestETagChangesAfterModification(t *testing.T) {
 		t.Error("JSON etag should differ after modification")
 	}
 }
+
+func TestClone(t *testing.T) {
+	due := DateOf(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	b := &Bean{
+		ID:        "abc",
+		Title:     "Original",
+		Tags:      []string{"a"},
+		Blocking:  []string{"x"},
+		Assignees: []string{"alice"},
+		Fields:    map[string]string{"area": "ui"},
+		Due:       &due,
+	}
+
+	c := b.Clone()
+	c.Title = "Changed"
+	c.Tags[0] = "b"
+	c.Blocking = append(c.Blocking, "y")
+	c.Assignees[0] = "bob"
+	c.Fields["area"] = "api"
+
+	if b.Title != "Original" || b.Tags[0] != "a" || len(b.Blocking) != 1 ||
+		b.Assignees[0] != "alice" || b.Fields["area"] != "ui" {
+		t.Errorf("modifying clone changed original: %+v", b)
+	}
+	if c.Due == nil || !c.Due.Equal(due.Time) {
+		t.Errorf("clone Due = %v, want %v", c.Due, due)
+	}
+}
@@ -0,0 +1,32 @@
+package bean
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Comment is a single entry in a bean's discussion thread.
+type Comment struct {
+	// Author identifies who wrote the comment (typically an email address or name).
+	Author string `yaml:"author" json:"author"`
+	// CreatedAt is when the comment was added.
+	CreatedAt time.Time `yaml:"created_at" json:"created_at"`
+	// Body is the comment text as markdown.
+	Body string `yaml:"body" json:"body"`
+}
+
+// AddComment appends a comment to the bean's thread. The body is trimmed and
+// must not be empty.
+func (b *Bean) AddComment(author, body string, at time.Time) (*Comment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, errors.New("comment body cannot be empty")
+	}
+	b.Comments = append(b.Comments, Comment{
+		Author:    strings.TrimSpace(author),
+		CreatedAt: at.UTC().Truncate(time.Second),
+		Body:      body,
+	})
+	return &b.Comments[len(b.Comments)-1], nil
+}
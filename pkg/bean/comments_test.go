@@ -0,0 +1,78 @@
+package bean
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAddComment(t *testing.T) {
+	b := &Bean{Title: "Test", Status: "todo"}
+	at := time.Date(2024, 3, 1, 10, 30, 15, 500, time.FixedZone("CET", 3600))
+
+	c, err := b.AddComment(" alice@example.com ", "  Looks good  \n", at)
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if c.Author != "alice@example.com" || c.Body != "Looks good" {
+		t.Errorf("AddComment() = %+v, want trimmed author and body", c)
+	}
+	if want := time.Date(2024, 3, 1, 9, 30, 15, 0, time.UTC); !c.CreatedAt.Equal(want) || c.CreatedAt.Location() != time.UTC {
+		t.Errorf("CreatedAt = %v, want %v in UTC", c.CreatedAt, want)
+	}
+
+	if _, err := b.AddComment("bob", "   ", at); err == nil {
+		t.Error("AddComment() expected error for empty body")
+	}
+	if len(b.Comments) != 1 {
+		t.Errorf("len(Comments) = %d, want 1", len(b.Comments))
+	}
+}
+
+func TestCommentsRoundtrip(t *testing.T) {
+	b := &Bean{
+		ID:     "abc1",
+		Title:  "Test",
+		Status: "todo",
+		Body:   "Spec goes here.",
+		Fields: map[string]string{"points": "3"},
+	}
+	at := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	if _, err := b.AddComment("alice", "First point:\n\n- one\n- two", at); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.AddComment("bob", "yes: agreed", at.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	rendered := string(out)
+
+	// Comments are rendered after custom fields and don't leak into the body
+	if strings.Index(rendered, "comments:") < strings.Index(rendered, "points:") {
+		t.Errorf("comments should be rendered after custom fields:\n%s", rendered)
+	}
+
+	parsed, err := Parse(strings.NewReader(rendered))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if strings.TrimSpace(parsed.Body) != b.Body {
+		t.Errorf("Body = %q, want %q", parsed.Body, b.Body)
+	}
+	if _, ok := parsed.GetField("comments"); ok {
+		t.Error("comments should not be parsed as a custom field")
+	}
+	if len(parsed.Comments) != 2 {
+		t.Fatalf("len(Comments) = %d, want 2", len(parsed.Comments))
+	}
+	for i, want := range b.Comments {
+		got := parsed.Comments[i]
+		if got.Author != want.Author || got.Body != want.Body || !got.CreatedAt.Equal(want.CreatedAt) {
+			t.Errorf("Comments[%d] = %+v, want %+v", i, got, want)
+		}
+	}
+}
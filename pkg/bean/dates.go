@@ -0,0 +1,126 @@
+package bean
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DateLayout is the format of start and due dates in front matter and output.
+const DateLayout = "2006-01-02"
+
+// Date is a calendar date without a time of day, used for start and due dates.
+// It is stored as midnight UTC.
+type Date struct {
+	time.Time
+}
+
+// DateOf returns the calendar date of t in t's location.
+func DateOf(t time.Time) Date {
+	return Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// Today returns the current local calendar date.
+func Today() Date {
+	return DateOf(time.Now())
+}
+
+// ParseDate parses a date given as YYYY-MM-DD, "today", "tomorrow", or an
+// offset from today such as "+3d" or "+2w".
+func ParseDate(s string, today Date) (Date, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDays(1), nil
+	}
+
+	if rest, ok := strings.CutPrefix(s, "+"); ok && len(rest) > 1 {
+		n, err := strconv.Atoi(rest[:len(rest)-1])
+		if err == nil && n >= 0 {
+			switch rest[len(rest)-1] {
+			case 'd':
+				return today.AddDays(n), nil
+			case 'w':
+				return today.AddDays(7 * n), nil
+			}
+		}
+	}
+
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q (expected YYYY-MM-DD, today, tomorrow, +Nd, or +Nw)", s)
+	}
+	return Date{t}, nil
+}
+
+// AddDays returns the date n days later.
+func (d Date) AddDays(n int) Date {
+	return Date{d.Time.AddDate(0, 0, n)}
+}
+
+// IsZero reports whether the date is nil or unset. Defined on the pointer so
+// that omitempty works for nil *Date fields when encoding YAML.
+func (d *Date) IsZero() bool {
+	return d == nil || d.Time.IsZero()
+}
+
+// String returns the date formatted as YYYY-MM-DD.
+func (d Date) String() string {
+	return d.Format(DateLayout)
+}
+
+// MarshalJSON encodes the date as a "YYYY-MM-DD" string.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes a "YYYY-MM-DD" string.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid date %s", data)
+	}
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return fmt.Errorf("invalid date %q (expected YYYY-MM-DD)", s)
+	}
+	*d = Date{t}
+	return nil
+}
+
+// MarshalYAML encodes the date as an unquoted YYYY-MM-DD scalar.
+func (d Date) MarshalYAML() (any, error) {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!timestamp", Value: d.String()}, nil
+}
+
+// MarshalGQL implements graphql.Marshaler for the Date scalar.
+func (d Date) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(d.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for the Date scalar. Accepts the
+// same formats as ParseDate, relative to today.
+func (d *Date) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("date must be a string")
+	}
+	parsed, err := ParseDate(s, Today())
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// IsOverdue returns true if the bean has a due date before today. It does not
+// consider the bean's status; callers should exclude completed beans.
+func (b *Bean) IsOverdue(today Date) bool {
+	return b.Due != nil && b.Due.Before(today.Time)
+}
@@ -0,0 +1,94 @@
+package bean
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	today := DateOf(time.Date(2024, 3, 1, 15, 0, 0, 0, time.Local))
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"2024-04-15", "2024-04-15", false},
+		{"today", "2024-03-01", false},
+		{" Tomorrow ", "2024-03-02", false},
+		{"+3d", "2024-03-04", false},
+		{"+2w", "2024-03-15", false},
+		{"+0d", "2024-03-01", false},
+		{"2024-13-01", "", true},
+		{"next week", "", true},
+		{"+d", "", true},
+		{"+3m", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDate(tt.input, today)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDate(%q) expected error, got %s", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDate(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatesRoundtrip(t *testing.T) {
+	content := "---\ntitle: Test\nstatus: todo\nstart: 2024-03-01\ndue: \"2024-04-15\"\n---\n"
+	b, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if b.Start == nil || b.Start.String() != "2024-03-01" {
+		t.Errorf("Start = %v, want 2024-03-01", b.Start)
+	}
+	if b.Due == nil || b.Due.String() != "2024-04-15" {
+		t.Errorf("Due = %v, want 2024-04-15", b.Due)
+	}
+
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(out), "start: 2024-03-01\n") || !strings.Contains(string(out), "due: 2024-04-15\n") {
+		t.Errorf("dates should render unquoted:\n%s", out)
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"due":"2024-04-15"`) {
+		t.Errorf("JSON should contain due date: %s", data)
+	}
+
+	if _, err := Parse(strings.NewReader("---\ntitle: Test\ndue: soon\n---\n")); err == nil {
+		t.Error("Parse() expected error for invalid due date")
+	}
+}
+
+func TestIsOverdue(t *testing.T) {
+	today := DateOf(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	yesterday, tomorrow := today.AddDays(-1), today.AddDays(1)
+
+	if (&Bean{}).IsOverdue(today) {
+		t.Error("bean without due date should not be overdue")
+	}
+	if !(&Bean{Due: &yesterday}).IsOverdue(today) {
+		t.Error("bean due yesterday should be overdue")
+	}
+	if (&Bean{Due: &today}).IsOverdue(today) || (&Bean{Due: &tomorrow}).IsOverdue(today) {
+		t.Error("bean due today or later should not be overdue")
+	}
+}
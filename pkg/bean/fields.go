@@ -0,0 +1,134 @@
+package bean
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// integerPattern matches canonical integers, which are rendered unquoted.
+var integerPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+
+// yaml11Keywords are plain scalars that YAML 1.1 parsers (used when reading
+// front matter) resolve to booleans or null, so string values must be quoted.
+var yaml11Keywords = map[string]bool{
+	"y": true, "yes": true, "n": true, "no": true, "on": true, "off": true,
+	"true": true, "false": true, "null": true, "~": true,
+}
+
+// GetField returns the value of a custom field and whether it is set.
+func (b *Bean) GetField(name string) (string, bool) {
+	v, ok := b.Fields[name]
+	return v, ok
+}
+
+// SetField sets a custom field value. An empty value removes the field.
+func (b *Bean) SetField(name, value string) {
+	if value == "" {
+		b.RemoveField(name)
+		return
+	}
+	if b.Fields == nil {
+		b.Fields = make(map[string]string)
+	}
+	b.Fields[name] = value
+}
+
+// RemoveField removes a custom field.
+func (b *Bean) RemoveField(name string) {
+	delete(b.Fields, name)
+	if len(b.Fields) == 0 {
+		b.Fields = nil
+	}
+}
+
+// FieldNames returns the names of all custom fields set on the bean, sorted.
+func (b *Bean) FieldNames() []string {
+	names := make([]string, 0, len(b.Fields))
+	for name := range b.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitExtraFrontMatter separates unknown front matter keys into scalar custom
+// field values (converted to strings) and non-scalar values to be preserved as-is.
+func splitExtraFrontMatter(raw map[string]any) (fields map[string]string, extra map[string]any) {
+	for key, value := range raw {
+		var s string
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			s = v
+		case int:
+			s = strconv.Itoa(v)
+		case int64:
+			s = strconv.FormatInt(v, 10)
+		case uint64:
+			s = strconv.FormatUint(v, 10)
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			s = strconv.FormatBool(v)
+		case time.Time:
+			s = v.Format(time.RFC3339)
+			if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+				s = v.Format("2006-01-02")
+			}
+		default:
+			if extra == nil {
+				extra = make(map[string]any)
+			}
+			extra[key] = value
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]string)
+		}
+		fields[key] = s
+	}
+	return fields, extra
+}
+
+// extraFrontMatterNodes builds the YAML nodes for custom fields and preserved
+// unknown keys. Returns nil if there is nothing to render.
+func (b *Bean) extraFrontMatterNodes() (map[string]*yaml.Node, error) {
+	if len(b.Fields) == 0 && len(b.extra) == 0 {
+		return nil, nil
+	}
+
+	nodes := make(map[string]*yaml.Node, len(b.Fields)+len(b.extra))
+	for key, value := range b.extra {
+		node := &yaml.Node{}
+		if err := node.Encode(value); err != nil {
+			return nil, fmt.Errorf("encoding front matter key %s: %w", key, err)
+		}
+		nodes[key] = node
+	}
+	for key, value := range b.Fields {
+		node := &yaml.Node{Kind: yaml.ScalarNode, Value: value, Tag: "!!str"}
+		switch {
+		case integerPattern.MatchString(value):
+			node.Tag = "!!int"
+		case isDate(value):
+			node.Tag = "!!timestamp"
+		case yaml11Keywords[strings.ToLower(value)]:
+			node.Style = yaml.DoubleQuotedStyle
+		}
+		nodes[key] = node
+	}
+	return nodes, nil
+}
+
+// isDate returns true if value is a valid YYYY-MM-DD date.
+func isDate(value string) bool {
+	_, err := time.Parse("2006-01-02", value)
+	return err == nil
+}
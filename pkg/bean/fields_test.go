@@ -0,0 +1,133 @@
+package bean
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseWithCustomFields(t *testing.T) {
+	input := `---
+title: Custom Fields
+status: todo
+points: 3
+component: backend
+review_on: 2024-03-01
+urgent: true
+---
+
+Body.
+`
+	b, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	want := map[string]string{
+		"points":    "3",
+		"component": "backend",
+		"review_on": "2024-03-01",
+		"urgent":    "true",
+	}
+	if len(b.Fields) != len(want) {
+		t.Fatalf("Fields = %v, want %v", b.Fields, want)
+	}
+	for k, v := range want {
+		if got, ok := b.GetField(k); !ok || got != v {
+			t.Errorf("GetField(%q) = %q, %v; want %q", k, got, ok, v)
+		}
+	}
+}
+
+func TestCustomFieldsRoundtrip(t *testing.T) {
+	b := &Bean{
+		Title:  "Roundtrip",
+		Status: "todo",
+		Fields: map[string]string{
+			"points":    "5",
+			"component": "frontend",
+			"review_on": "2024-12-24",
+			"zip":       "007",
+			"answer":    "yes",
+		},
+	}
+
+	rendered, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	content := string(rendered)
+
+	// Numbers and dates are written unquoted; ambiguous strings are quoted
+	for _, line := range []string{"points: 5\n", "review_on: 2024-12-24\n", "component: frontend\n", "zip: \"007\"\n"} {
+		if !strings.Contains(content, line) {
+			t.Errorf("rendered output missing %q:\n%s", line, content)
+		}
+	}
+
+	parsed, err := Parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	for k, v := range b.Fields {
+		if got := parsed.Fields[k]; got != v {
+			t.Errorf("Fields[%q] roundtrip: got %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestUnknownFrontMatterPreserved(t *testing.T) {
+	input := `---
+title: Preserve Me
+status: todo
+customer: acme
+links:
+    - https://example.com/a
+    - https://example.com/b
+---
+`
+	b, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	// Simulate an update that touches a built-in field
+	b.Status = "in-progress"
+
+	rendered, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	content := string(rendered)
+
+	for _, want := range []string{"customer: acme", "links:", "https://example.com/a", "https://example.com/b", "status: in-progress"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("rendered output missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestBeanFieldMethods(t *testing.T) {
+	b := &Bean{}
+
+	b.SetField("points", "3")
+	if got, ok := b.GetField("points"); !ok || got != "3" {
+		t.Errorf("GetField(points) = %q, %v; want \"3\", true", got, ok)
+	}
+
+	b.SetField("component", "api")
+	names := b.FieldNames()
+	if len(names) != 2 || names[0] != "component" || names[1] != "points" {
+		t.Errorf("FieldNames() = %v, want [component points]", names)
+	}
+
+	// Empty value removes the field
+	b.SetField("component", "")
+	if _, ok := b.GetField("component"); ok {
+		t.Error("SetField with empty value should remove the field")
+	}
+
+	b.RemoveField("points")
+	if b.Fields != nil {
+		t.Errorf("Fields = %v, want nil after removing all fields", b.Fields)
+	}
+}
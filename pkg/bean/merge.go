@@ -0,0 +1,277 @@
+package bean
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Conflict marker labels used in merged bodies.
+const (
+	ConflictStart  = "<<<<<<< ours"
+	ConflictMiddle = "======="
+	ConflictEnd    = ">>>>>>> theirs"
+)
+
+// Merge performs a three-way merge of two versions of a bean (ours and theirs)
+// that diverged from a common ancestor (base, which may be nil when both sides
+// added the file). It returns the merged bean and whether the body contains
+// conflict markers.
+//
+// Fields changed on one side only take that side's value. Scalars changed on
+// both sides take the value from the side with the newest updated_at (ours on
+// a tie). List fields (tags, blocking, blocked_by, relations, assignees) keep additions
+// from both sides and drop values removed on either side. Comments are united.
+// The body is merged line by line; only overlapping edits conflict.
+func Merge(base, ours, theirs *Bean) (*Bean, bool) {
+	if base == nil {
+		base = &Bean{}
+	}
+	oursNewer := !updatedAt(theirs).After(updatedAt(ours))
+
+	m := ours.Clone()
+	m.Title = merge3(base.Title, ours.Title, theirs.Title, oursNewer)
+	m.Status = merge3(base.Status, ours.Status, theirs.Status, oursNewer)
+	m.Type = merge3(base.Type, ours.Type, theirs.Type, oursNewer)
+	m.Priority = merge3(base.Priority, ours.Priority, theirs.Priority, oursNewer)
+	m.Order = merge3(base.Order, ours.Order, theirs.Order, oursNewer)
+	m.Parent = merge3(base.Parent, ours.Parent, theirs.Parent, oursNewer)
+	m.Estimate = merge3(base.Estimate, ours.Estimate, theirs.Estimate, oursNewer)
+	m.Recur = merge3(base.Recur, ours.Recur, theirs.Recur, oursNewer)
+	m.Previous = merge3(base.Previous, ours.Previous, theirs.Previous, oursNewer)
+	m.Start = mergeDate(base.Start, ours.Start, theirs.Start, oursNewer)
+	m.Due = mergeDate(base.Due, ours.Due, theirs.Due, oursNewer)
+
+	// Keep the earliest creation time and the latest update time
+	m.CreatedAt = ours.CreatedAt
+	if theirs.CreatedAt != nil && (m.CreatedAt == nil || theirs.CreatedAt.Before(*m.CreatedAt)) {
+		m.CreatedAt = theirs.CreatedAt
+	}
+	if !oursNewer {
+		m.UpdatedAt = theirs.UpdatedAt
+	}
+
+	m.Tags = mergeLists(base.Tags, ours.Tags, theirs.Tags)
+	m.Blocking = mergeLists(base.Blocking, ours.Blocking, theirs.Blocking)
+	m.BlockedBy = mergeLists(base.BlockedBy, ours.BlockedBy, theirs.BlockedBy)
+	m.Relations = mergeRelations(base.Relations, ours.Relations, theirs.Relations)
+	m.Assignees = mergeLists(base.Assignees, ours.Assignees, theirs.Assignees)
+	m.Comments = mergeComments(ours.Comments, theirs.Comments)
+	m.Fields = mergeMaps(base.Fields, ours.Fields, theirs.Fields, oursNewer)
+	m.extra = mergeMaps(base.extra, ours.extra, theirs.extra, oursNewer)
+
+	body, conflict := MergeText(base.Body, ours.Body, theirs.Body)
+	m.Body = body
+	return m, conflict
+}
+
+// updatedAt returns the bean's update time, or the zero time if unset.
+func updatedAt(b *Bean) time.Time {
+	if b.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *b.UpdatedAt
+}
+
+// merge3 merges a scalar value: a change on one side wins over the base; if
+// both sides changed it differently, the newer side wins.
+func merge3[T comparable](base, ours, theirs T, oursNewer bool) T {
+	return mergeValue(base, ours, theirs, oursNewer, func(a, b T) bool { return a == b })
+}
+
+// mergeDate merges an optional date like merge3.
+func mergeDate(base, ours, theirs *Date, oursNewer bool) *Date {
+	return mergeValue(base, ours, theirs, oursNewer, func(a, b *Date) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(b.Time)
+	})
+}
+
+func mergeValue[T any](base, ours, theirs T, oursNewer bool, equal func(a, b T) bool) T {
+	switch {
+	case equal(ours, theirs), equal(theirs, base):
+		return ours
+	case equal(ours, base):
+		return theirs
+	case oursNewer:
+		return ours
+	default:
+		return theirs
+	}
+}
+
+// mergeLists merges a list of unique values: values added on either side are
+// kept (in order, ours first), values removed on either side are dropped.
+func mergeLists(base, ours, theirs []string) []string {
+	if ours == nil && theirs == nil {
+		return nil
+	}
+	removed := func(v string) bool {
+		return slices.Contains(base, v) && (!slices.Contains(ours, v) || !slices.Contains(theirs, v))
+	}
+	result := []string{}
+	for _, v := range slices.Concat(ours, theirs) {
+		if !removed(v) && !slices.Contains(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// mergeRelations merges the targets of each link type like mergeLists.
+func mergeRelations(base, ours, theirs map[string][]string) map[string][]string {
+	merged := map[string][]string{}
+	for linkType := range ours {
+		merged[linkType] = nil
+	}
+	for linkType := range theirs {
+		merged[linkType] = nil
+	}
+	for linkType := range merged {
+		merged[linkType] = mergeLists(base[linkType], ours[linkType], theirs[linkType])
+	}
+	return normalizeRelations(merged)
+}
+
+// mergeMaps merges maps key by key like merge3. A key deleted on one side and
+// unchanged on the other is deleted.
+func mergeMaps[V any](base, ours, theirs map[string]V, oursNewer bool) map[string]V {
+	if ours == nil && theirs == nil {
+		return nil
+	}
+	type entry struct {
+		value V
+		ok    bool
+	}
+	get := func(m map[string]V, k string) entry {
+		v, ok := m[k]
+		return entry{v, ok}
+	}
+	equal := func(a, b entry) bool {
+		return a.ok == b.ok && (!a.ok || reflect.DeepEqual(a.value, b.value))
+	}
+
+	keys := slices.Concat(slices.Collect(maps.Keys(ours)), slices.Collect(maps.Keys(theirs)))
+	result := make(map[string]V)
+	for _, k := range keys {
+		if e := mergeValue(get(base, k), get(ours, k), get(theirs, k), oursNewer, equal); e.ok {
+			result[k] = e.value
+		}
+	}
+	return result
+}
+
+// mergeComments unites two comment threads, dropping duplicates and keeping
+// the thread in chronological order.
+func mergeComments(ours, theirs []Comment) []Comment {
+	result := slices.Clone(ours)
+	for _, c := range theirs {
+		if !slices.ContainsFunc(result, func(o Comment) bool {
+			return o.Author == c.Author && o.CreatedAt.Equal(c.CreatedAt) && o.Body == c.Body
+		}) {
+			result = append(result, c)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result
+}
+
+// MergeText performs a line-based three-way merge of two texts that diverged
+// from base. Edits to different regions are combined; lines inserted at the
+// same place on both sides are kept, ours first. Only overlapping edits to the
+// same base lines conflict, in which case both versions are emitted between
+// conflict markers and the second return value is true.
+func MergeText(base, ours, theirs string) (string, bool) {
+	if ours == theirs || theirs == base {
+		return ours, false
+	}
+	if ours == base {
+		return theirs, false
+	}
+
+	o := strings.Split(base, "\n")
+	a := strings.Split(ours, "\n")
+	b := strings.Split(theirs, "\n")
+	matchA := matchLines(o, a)
+	matchB := matchLines(o, b)
+
+	var out []string
+	conflict := false
+	emit := func(oEnd, aEnd, bEnd, oPos, aPos, bPos int) {
+		baseChunk, oursChunk, theirsChunk := o[oPos:oEnd], a[aPos:aEnd], b[bPos:bEnd]
+		switch {
+		case slices.Equal(oursChunk, theirsChunk), slices.Equal(theirsChunk, baseChunk):
+			out = append(out, oursChunk...)
+		case slices.Equal(oursChunk, baseChunk):
+			out = append(out, theirsChunk...)
+		case len(baseChunk) == 0:
+			// Both sides inserted lines at the same place
+			out = append(out, oursChunk...)
+			out = append(out, theirsChunk...)
+		default:
+			conflict = true
+			out = append(out, ConflictStart)
+			out = append(out, oursChunk...)
+			out = append(out, ConflictMiddle)
+			out = append(out, theirsChunk...)
+			out = append(out, ConflictEnd)
+		}
+	}
+
+	// Walk the base lines that are unchanged on both sides; the regions between
+	// them are merged chunk by chunk
+	oPos, aPos, bPos := 0, 0, 0
+	for i := range o {
+		if matchA[i] < 0 || matchB[i] < 0 {
+			continue
+		}
+		emit(i, matchA[i], matchB[i], oPos, aPos, bPos)
+		out = append(out, o[i])
+		oPos, aPos, bPos = i+1, matchA[i]+1, matchB[i]+1
+	}
+	emit(len(o), len(a), len(b), oPos, aPos, bPos)
+
+	return strings.Join(out, "\n"), conflict
+}
+
+// matchLines returns, for each line of x, the index of the matching line in y
+// according to a longest common subsequence, or -1 if it has no match.
+func matchLines(x, y []string) []int {
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	match := make([]int, len(x))
+	i, j := 0, 0
+	for i < len(x) {
+		switch {
+		case j < len(y) && x[i] == y[j]:
+			match[i] = j
+			i++
+			j++
+		case j < len(y) && lcs[i][j+1] > lcs[i+1][j]:
+			j++
+		default:
+			match[i] = -1
+			i++
+		}
+	}
+	return match
+}
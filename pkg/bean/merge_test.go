@@ -0,0 +1,151 @@
+package bean
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	t2 := t0.Add(2 * time.Hour)
+
+	base := &Bean{
+		Title:     "Login",
+		Status:    "todo",
+		Priority:  "normal",
+		Tags:      []string{"auth", "old"},
+		Blocking:  []string{"a"},
+		CreatedAt: &t0,
+		UpdatedAt: &t0,
+		Fields:    map[string]string{"team": "core"},
+		Body:      "Intro\n\n- [ ] one\n- [ ] two",
+	}
+
+	ours := base.Clone()
+	ours.Status = "in-progress"
+	ours.Priority = "high"
+	ours.Tags = []string{"auth", "old", "backend"}
+	ours.UpdatedAt = &t1
+	ours.Body = "Intro\n\n- [x] one\n- [ ] two"
+
+	theirs := base.Clone()
+	theirs.Priority = "low"
+	theirs.Tags = []string{"auth", "frontend"}
+	theirs.Blocking = []string{"a", "b"}
+	theirs.Fields = map[string]string{"team": "web"}
+	theirs.UpdatedAt = &t2
+	theirs.Body = "Intro\n\n- [ ] one\n- [ ] two\n\n## Notes\nDone"
+
+	m, conflict := Merge(base, ours, theirs)
+	if conflict {
+		t.Fatalf("Merge() reported a conflict, body:\n%s", m.Body)
+	}
+	if m.Status != "in-progress" {
+		t.Errorf("Status = %q, want ours (only ours changed it)", m.Status)
+	}
+	if m.Priority != "low" {
+		t.Errorf("Priority = %q, want theirs (both changed it, theirs is newer)", m.Priority)
+	}
+	if want := []string{"auth", "backend", "frontend"}; !slices.Equal(m.Tags, want) {
+		t.Errorf("Tags = %v, want %v", m.Tags, want)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(m.Blocking, want) {
+		t.Errorf("Blocking = %v, want %v", m.Blocking, want)
+	}
+	if m.Fields["team"] != "web" {
+		t.Errorf("Fields[team] = %q, want web", m.Fields["team"])
+	}
+	if !m.UpdatedAt.Equal(t2) || !m.CreatedAt.Equal(t0) {
+		t.Errorf("UpdatedAt = %v, CreatedAt = %v, want newest update and original creation", m.UpdatedAt, m.CreatedAt)
+	}
+	if want := "Intro\n\n- [x] one\n- [ ] two\n\n## Notes\nDone"; m.Body != want {
+		t.Errorf("Body = %q, want %q", m.Body, want)
+	}
+}
+
+func TestMergeNoBase(t *testing.T) {
+	ours := &Bean{Title: "A", Status: "todo", Tags: []string{"x"}}
+	theirs := &Bean{Title: "A", Status: "todo", Tags: []string{"y"}}
+
+	m, conflict := Merge(nil, ours, theirs)
+	if conflict {
+		t.Error("Merge() reported a conflict")
+	}
+	if want := []string{"x", "y"}; !slices.Equal(m.Tags, want) {
+		t.Errorf("Tags = %v, want %v", m.Tags, want)
+	}
+}
+
+func TestMergeRelations(t *testing.T) {
+	base := &Bean{Title: "A", Relations: map[string][]string{"related": {"x", "y"}}}
+	ours := base.Clone()
+	ours.RemoveRelation("related", "y")
+	ours.AddRelation("supersedes", "z")
+	theirs := base.Clone()
+	theirs.AddRelation("related", "w")
+
+	m, _ := Merge(base, ours, theirs)
+	if want := []string{"x", "w"}; !slices.Equal(m.Relations["related"], want) {
+		t.Errorf("Relations[related] = %v, want %v", m.Relations["related"], want)
+	}
+	if want := []string{"z"}; !slices.Equal(m.Relations["supersedes"], want) {
+		t.Errorf("Relations[supersedes] = %v, want %v", m.Relations["supersedes"], want)
+	}
+}
+
+func TestMergeComments(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	shared := Comment{Author: "a", CreatedAt: t0, Body: "first"}
+	base := &Bean{Comments: []Comment{shared}}
+	ours := &Bean{Comments: []Comment{shared, {Author: "a", CreatedAt: t0.Add(2 * time.Hour), Body: "ours"}}}
+	theirs := &Bean{Comments: []Comment{shared, {Author: "b", CreatedAt: t0.Add(time.Hour), Body: "theirs"}}}
+
+	m, _ := Merge(base, ours, theirs)
+	var bodies []string
+	for _, c := range m.Comments {
+		bodies = append(bodies, c.Body)
+	}
+	if want := []string{"first", "theirs", "ours"}; !slices.Equal(bodies, want) {
+		t.Errorf("Comments = %v, want %v", bodies, want)
+	}
+}
+
+func TestMergeText(t *testing.T) {
+	tests := []struct {
+		name         string
+		base         string
+		ours         string
+		theirs       string
+		want         string
+		wantConflict bool
+	}{
+		{"unchanged", "a\nb", "a\nb", "a\nb", "a\nb", false},
+		{"only ours", "a\nb", "a\nB", "a\nb", "a\nB", false},
+		{"only theirs", "a\nb", "a\nb", "A\nb", "A\nb", false},
+		{"different lines", "a\nb\nc", "A\nb\nc", "a\nb\nC", "A\nb\nC", false},
+		{"same change", "a\nb", "a\nB", "a\nB", "a\nB", false},
+		{"both append", "a", "a\nours", "a\ntheirs", "a\nours\ntheirs", false},
+		{
+			"same line changed",
+			"a\nb\nc", "a\nours\nc", "a\ntheirs\nc",
+			"a\n" + ConflictStart + "\nours\n" + ConflictMiddle + "\ntheirs\n" + ConflictEnd + "\nc",
+			true,
+		},
+		{"deleted and unchanged", "a\nb\nc", "a\nc", "a\nb\nc", "a\nc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflict := MergeText(tt.base, tt.ours, tt.theirs)
+			if got != tt.want || conflict != tt.wantConflict {
+				t.Errorf("MergeText() = %q, %v; want %q, %v", got, conflict, tt.want, tt.wantConflict)
+			}
+			if !conflict && strings.Contains(got, ConflictStart) {
+				t.Error("conflict markers without conflict")
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package bean
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a rule for repeating a bean: every Interval days, weeks,
+// months, or years.
+type Recurrence struct {
+	Interval int
+	// Unit is one of 'd', 'w', 'm', or 'y'.
+	Unit byte
+}
+
+var recurrenceNames = map[string]Recurrence{
+	"daily":   {1, 'd'},
+	"weekly":  {1, 'w'},
+	"monthly": {1, 'm'},
+	"yearly":  {1, 'y'},
+}
+
+var rruleFreqs = map[string]byte{"DAILY": 'd', "WEEKLY": 'w', "MONTHLY": 'm', "YEARLY": 'y'}
+
+// ParseRecurrence parses a recurrence rule: daily, weekly, monthly, yearly,
+// "every <n><unit>" with unit d, w, m, or y (e.g. "every 2w"), or the RRULE
+// subset "FREQ=<DAILY|WEEKLY|MONTHLY|YEARLY>[;INTERVAL=<n>]".
+func ParseRecurrence(s string) (Recurrence, error) {
+	s = strings.TrimSpace(s)
+	if r, ok := recurrenceNames[strings.ToLower(s)]; ok {
+		return r, nil
+	}
+
+	if rest, ok := strings.CutPrefix(strings.ToLower(s), "every "); ok {
+		rest = strings.ReplaceAll(rest, " ", "")
+		if len(rest) > 1 && strings.ContainsRune("dwmy", rune(rest[len(rest)-1])) {
+			if n, err := strconv.Atoi(rest[:len(rest)-1]); err == nil && n > 0 {
+				return Recurrence{Interval: n, Unit: rest[len(rest)-1]}, nil
+			}
+		}
+	}
+
+	if rule := strings.TrimPrefix(strings.ToUpper(s), "RRULE:"); strings.HasPrefix(rule, "FREQ=") {
+		r := Recurrence{Interval: 1}
+		valid := true
+		for _, part := range strings.Split(rule, ";") {
+			key, value, _ := strings.Cut(part, "=")
+			switch key {
+			case "FREQ":
+				r.Unit = rruleFreqs[value]
+			case "INTERVAL":
+				n, err := strconv.Atoi(value)
+				if err != nil || n <= 0 {
+					valid = false
+				}
+				r.Interval = n
+			default:
+				valid = false
+			}
+		}
+		if valid && r.Unit != 0 {
+			return r, nil
+		}
+	}
+
+	return Recurrence{}, fmt.Errorf("invalid recurrence %q (expected daily, weekly, monthly, yearly, every <n>d/w/m/y, or FREQ=...;INTERVAL=<n>)", s)
+}
+
+// String returns the canonical form of the rule, e.g. "weekly" or "every 2w".
+func (r Recurrence) String() string {
+	if r.Interval == 1 {
+		for name, named := range recurrenceNames {
+			if named == r {
+				return name
+			}
+		}
+	}
+	return fmt.Sprintf("every %d%c", r.Interval, r.Unit)
+}
+
+// Next returns the date one interval after d. Months and years that don't
+// have d's day (e.g. January 31 plus one month) end on their last day.
+func (r Recurrence) Next(d Date) Date {
+	switch r.Unit {
+	case 'd':
+		return d.AddDays(r.Interval)
+	case 'w':
+		return d.AddDays(7 * r.Interval)
+	case 'm':
+		return addMonths(d, r.Interval)
+	default:
+		return addMonths(d, 12*r.Interval)
+	}
+}
+
+// addMonths adds n months to d, clamping the day to the end of the month.
+func addMonths(d Date, n int) Date {
+	first := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, n, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return Date{first.AddDate(0, 0, min(d.Day(), lastDay)-1)}
+}
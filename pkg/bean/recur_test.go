@@ -0,0 +1,104 @@
+package bean
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"daily", "daily", false},
+		{" Weekly ", "weekly", false},
+		{"monthly", "monthly", false},
+		{"yearly", "yearly", false},
+		{"every 2w", "every 2w", false},
+		{"every 1m", "monthly", false},
+		{"every 3 d", "every 3d", false},
+		{"FREQ=WEEKLY", "weekly", false},
+		{"RRULE:FREQ=MONTHLY;INTERVAL=3", "every 3m", false},
+		{"freq=daily;interval=10", "every 10d", false},
+		{"every 0d", "", true},
+		{"every 2x", "", true},
+		{"every week", "", true},
+		{"FREQ=HOURLY", "", true},
+		{"FREQ=WEEKLY;BYDAY=MO", "", true},
+		{"FREQ=WEEKLY;INTERVAL=0", "", true},
+		{"sometimes", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseRecurrence(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseRecurrence(%q) expected error, got %s", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseRecurrence(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	date := func(s string) Date {
+		d, err := time.Parse(DateLayout, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Date{d}
+	}
+	tests := []struct {
+		rule string
+		from string
+		want string
+	}{
+		{"daily", "2024-12-31", "2025-01-01"},
+		{"every 2w", "2024-03-01", "2024-03-15"},
+		{"monthly", "2024-03-15", "2024-04-15"},
+		{"monthly", "2024-01-31", "2024-02-29"},
+		{"monthly", "2023-01-31", "2023-02-28"},
+		{"every 3m", "2024-11-30", "2025-02-28"},
+		{"yearly", "2024-02-29", "2025-02-28"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule+" "+tt.from, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := r.Next(date(tt.from)); got.String() != tt.want {
+				t.Errorf("Next(%s) = %s, want %s", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurRoundTrip(t *testing.T) {
+	b := &Bean{ID: "a1", Title: "A", Status: "todo", Type: "task", Recur: "weekly", Previous: "z9"}
+
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(out), "recur: weekly\nprevious: z9\n") {
+		t.Errorf("Render() output missing recur/previous:\n%s", out)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(out)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed.Recur != "weekly" || parsed.Previous != "z9" {
+		t.Errorf("Parse() recur = %q, previous = %q", parsed.Recur, parsed.Previous)
+	}
+}
@@ -0,0 +1,70 @@
+package bean
+
+import (
+	"slices"
+	"sort"
+)
+
+// RelationTypes returns the link types the bean has relations of, sorted.
+func (b *Bean) RelationTypes() []string {
+	types := make([]string, 0, len(b.Relations))
+	for linkType := range b.Relations {
+		types = append(types, linkType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// HasRelation returns true if the bean links to id with the given link type.
+func (b *Bean) HasRelation(linkType, id string) bool {
+	return slices.Contains(b.Relations[linkType], id)
+}
+
+// AddRelation adds a link of the given type to id. Returns false if the bean
+// already has that link.
+func (b *Bean) AddRelation(linkType, id string) bool {
+	if b.HasRelation(linkType, id) {
+		return false
+	}
+	if b.Relations == nil {
+		b.Relations = make(map[string][]string)
+	}
+	b.Relations[linkType] = append(b.Relations[linkType], id)
+	return true
+}
+
+// RemoveRelation removes a link of the given type to id. Returns false if the
+// bean has no such link.
+func (b *Bean) RemoveRelation(linkType, id string) bool {
+	if !b.HasRelation(linkType, id) {
+		return false
+	}
+	b.Relations[linkType] = slices.DeleteFunc(b.Relations[linkType], func(target string) bool { return target == id })
+	b.Relations = normalizeRelations(b.Relations)
+	return true
+}
+
+// normalizeRelations drops link types without targets, returning nil if none are left.
+func normalizeRelations(relations map[string][]string) map[string][]string {
+	for linkType, targets := range relations {
+		if len(targets) == 0 {
+			delete(relations, linkType)
+		}
+	}
+	if len(relations) == 0 {
+		return nil
+	}
+	return relations
+}
+
+// cloneRelations returns a deep copy of a relations map.
+func cloneRelations(relations map[string][]string) map[string][]string {
+	if relations == nil {
+		return nil
+	}
+	c := make(map[string][]string, len(relations))
+	for linkType, targets := range relations {
+		c[linkType] = slices.Clone(targets)
+	}
+	return c
+}
@@ -0,0 +1,70 @@
+package bean
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRelations(t *testing.T) {
+	b := &Bean{ID: "a1", Title: "A"}
+
+	if !b.AddRelation("related", "b2") {
+		t.Error("AddRelation(related, b2) = false, want true")
+	}
+	if b.AddRelation("related", "b2") {
+		t.Error("AddRelation(related, b2) again = true, want false")
+	}
+	b.AddRelation("duplicates", "c3")
+	if want := []string{"duplicates", "related"}; !slices.Equal(b.RelationTypes(), want) {
+		t.Errorf("RelationTypes() = %v, want %v", b.RelationTypes(), want)
+	}
+	if !b.HasRelation("duplicates", "c3") || b.HasRelation("duplicates", "b2") {
+		t.Error("HasRelation() returned wrong results")
+	}
+
+	if b.RemoveRelation("related", "c3") {
+		t.Error("RemoveRelation(related, c3) = true, want false")
+	}
+	if !b.RemoveRelation("related", "b2") {
+		t.Error("RemoveRelation(related, b2) = false, want true")
+	}
+	if _, ok := b.Relations["related"]; ok {
+		t.Error("empty link type should be dropped")
+	}
+
+	clone := b.Clone()
+	clone.AddRelation("duplicates", "d4")
+	if b.HasRelation("duplicates", "d4") {
+		t.Error("Clone() should deep-copy relations")
+	}
+}
+
+func TestRelationsRoundTrip(t *testing.T) {
+	b := &Bean{ID: "a1", Title: "A", Status: "todo", Type: "task"}
+	b.AddRelation("supersedes", "b2")
+	b.AddRelation("related", "c3")
+
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(out), "relations:\n    related:\n        - c3\n    supersedes:\n        - b2\n") {
+		t.Errorf("Render() output missing relations:\n%s", out)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(out)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !parsed.HasRelation("supersedes", "b2") || !parsed.HasRelation("related", "c3") {
+		t.Errorf("Parse() relations = %v", parsed.Relations)
+	}
+
+	b.RemoveRelation("supersedes", "b2")
+	b.RemoveRelation("related", "c3")
+	out, _ = b.Render()
+	if strings.Contains(string(out), "relations:") {
+		t.Errorf("Render() should omit empty relations:\n%s", out)
+	}
+}
@@ -1,87 +1,209 @@
 package bean
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
 	"sort"
 	"strings"
 )
 
-// SortByStatusPriorityAndType sorts beans by status order, then priority, then type, then title.
+// SortByStatusPriorityAndType sorts beans by status order, then priority, then type, then title, then ID.
 // This is the default sorting used by both CLI and TUI.
 // Unrecognized statuses, priorities, and types are sorted last within their category.
 // Beans without priority are treated as "normal" priority for sorting purposes.
 func SortByStatusPriorityAndType(beans []*Bean, statusNames, priorityNames, typeNames []string) {
-	statusOrder := make(map[string]int)
-	for i, s := range statusNames {
-		statusOrder[s] = i
+	slices.SortFunc(beans, newBeanOrder(nil, statusNames, priorityNames, typeNames).compare)
+}
+
+// SortOrders lists the orderings accepted by SortBy, besides the default.
+var SortOrders = []string{"created", "updated", "due", "status", "priority", "type", "order", "id"}
+
+// SortKey is an ordering with a direction.
+type SortKey struct {
+	// Field is one of SortOrders.
+	Field      string
+	Descending bool
+}
+
+// ParseSort parses an ordering given as one of SortOrders, optionally followed
+// by ":asc" or ":desc" (e.g. "created:asc"). Without a direction, created and
+// updated sort newest first and everything else ascending. An empty string
+// selects the default order and returns a zero SortKey.
+func ParseSort(s string) (SortKey, error) {
+	if s == "" {
+		return SortKey{}, nil
 	}
-	priorityOrder := make(map[string]int)
-	for i, p := range priorityNames {
-		priorityOrder[p] = i
+	field, dir, hasDir := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	if !slices.Contains(SortOrders, field) {
+		return SortKey{}, fmt.Errorf("invalid sort %q (must be %s, optionally followed by :asc or :desc)", s, strings.Join(SortOrders, ", "))
 	}
-	typeOrder := make(map[string]int)
-	for i, t := range typeNames {
-		typeOrder[t] = i
+	key := SortKey{Field: field, Descending: field == "created" || field == "updated"}
+	if hasDir {
+		switch dir {
+		case "asc":
+			key.Descending = false
+		case "desc":
+			key.Descending = true
+		default:
+			return SortKey{}, fmt.Errorf("invalid sort direction %q (must be asc or desc)", dir)
+		}
 	}
+	return key, nil
+}
 
-	// Find the index of "normal" priority for beans without priority set
-	normalPriorityOrder := len(priorityNames) // default to last if "normal" not found
-	for i, p := range priorityNames {
-		if p == "normal" {
-			normalPriorityOrder = i
-			break
-		}
+// SortBy sorts beans by an ordering accepted by ParseSort, e.g. "due" or
+// "created:asc". Any other value, including "", uses SortByStatusPriorityAndType.
+func SortBy(beans []*Bean, sortBy string, statusNames, priorityNames, typeNames []string) {
+	key, err := ParseSort(sortBy)
+	if err != nil || key.Field == "" {
+		SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+		return
+	}
+	SortByKeys(beans, []SortKey{key}, statusNames, priorityNames, typeNames)
+}
+
+// SortByKeys sorts beans by each key in turn. Beans without a value for a key
+// (no timestamp, due date, or manual order) come last in either direction, as
+// do unrecognized statuses, priorities, and types; beans without priority
+// count as "normal". Beans that tie on every key keep the default order of
+// SortByStatusPriorityAndType.
+func SortByKeys(beans []*Bean, keys []SortKey, statusNames, priorityNames, typeNames []string) {
+	slices.SortFunc(beans, newBeanOrder(keys, statusNames, priorityNames, typeNames).compare)
+}
+
+// SearchAfter returns the index of the first bean in beans, which must be
+// sorted by SortByKeys with the same arguments, that sorts after pos. pos only
+// needs the fields the order looks at and doesn't have to be one of beans, so
+// a page can continue from where the previous one ended even if that bean has
+// since changed or gone.
+func SearchAfter(beans []*Bean, pos *Bean, keys []SortKey, statusNames, priorityNames, typeNames []string) int {
+	order := newBeanOrder(keys, statusNames, priorityNames, typeNames)
+	return sort.Search(len(beans), func(i int) bool {
+		return order.compare(beans[i], pos) > 0
+	})
+}
+
+// beanOrder compares beans by sort keys, breaking ties with the default
+// order of SortByStatusPriorityAndType.
+type beanOrder struct {
+	keys                               []SortKey
+	statusRank, priorityRank, typeRank func(string) int
+}
+
+func newBeanOrder(keys []SortKey, statusNames, priorityNames, typeNames []string) beanOrder {
+	return beanOrder{
+		keys:         keys,
+		statusRank:   rankOf(statusNames, ""),
+		priorityRank: rankOf(priorityNames, "normal"),
+		typeRank:     rankOf(typeNames, ""),
 	}
+}
 
-	// Helper to get order with unrecognized values sorted last
-	getStatusOrder := func(status string) int {
-		if order, ok := statusOrder[status]; ok {
-			return order
+// compare returns a negative number if a sorts before b, a positive number if
+// it sorts after, and 0 only if both have the same ID.
+func (o beanOrder) compare(a, b *Bean) int {
+	for _, key := range o.keys {
+		if c := compareBeans(key, a, b, o.statusRank, o.priorityRank, o.typeRank); c != 0 {
+			return c
 		}
-		return len(statusNames) // Unrecognized statuses come last
 	}
-	getPriorityOrder := func(priority string) int {
-		if priority == "" {
-			return normalPriorityOrder // No priority = normal
+
+	// Primary: status order
+	if c := cmp.Compare(o.statusRank(a.Status), o.statusRank(b.Status)); c != 0 {
+		return c
+	}
+	// Secondary: manual order (fractional index) — beans with order come first
+	if aHas, bHas := a.Order != "", b.Order != ""; aHas && bHas {
+		if c := strings.Compare(a.Order, b.Order); c != 0 {
+			return c
 		}
-		if order, ok := priorityOrder[priority]; ok {
-			return order
+	} else if aHas != bHas {
+		// Beans with explicit order come before those without
+		if aHas {
+			return -1
 		}
-		return len(priorityNames) // Unrecognized priorities come last
+		return 1
 	}
-	getTypeOrder := func(typ string) int {
-		if order, ok := typeOrder[typ]; ok {
-			return order
-		}
-		return len(typeNames) // Unrecognized types come last
+	// Tertiary: priority order (for beans without manual order, or as tiebreaker)
+	if c := cmp.Compare(o.priorityRank(a.Priority), o.priorityRank(b.Priority)); c != 0 {
+		return c
 	}
+	// Quaternary: type order
+	if c := cmp.Compare(o.typeRank(a.Type), o.typeRank(b.Type)); c != 0 {
+		return c
+	}
+	// Then title (case-insensitive) for user-friendly ordering
+	if c := strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title)); c != 0 {
+		return c
+	}
+	// Final: ID, so beans that tie on everything else keep a fixed order
+	// (Core.All returns beans in map order, and cursors depend on it)
+	return strings.Compare(a.ID, b.ID)
+}
 
-	sort.Slice(beans, func(i, j int) bool {
-		// Primary: status order
-		oi, oj := getStatusOrder(beans[i].Status), getStatusOrder(beans[j].Status)
-		if oi != oj {
-			return oi < oj
+// rankOf returns a function giving the position of a value in names, with
+// unrecognized values last. An empty value ranks as emptyAs.
+func rankOf(names []string, emptyAs string) func(string) int {
+	order := make(map[string]int, len(names))
+	for i, name := range names {
+		order[name] = i
+	}
+	return func(value string) int {
+		if value == "" {
+			value = emptyAs
 		}
-		// Secondary: manual order (fractional index) — beans with order come first
-		oiHas, ojHas := beans[i].Order != "", beans[j].Order != ""
-		if oiHas && ojHas {
-			if beans[i].Order != beans[j].Order {
-				return beans[i].Order < beans[j].Order
-			}
-		} else if oiHas != ojHas {
-			// Beans with explicit order come before those without
-			return oiHas
+		if i, ok := order[value]; ok {
+			return i
 		}
-		// Tertiary: priority order (for beans without manual order, or as tiebreaker)
-		pi, pj := getPriorityOrder(beans[i].Priority), getPriorityOrder(beans[j].Priority)
-		if pi != pj {
-			return pi < pj
+		return len(names)
+	}
+}
+
+// compareBeans compares two beans by a sort key, returning a negative number
+// if a sorts first, a positive number if b does, and 0 if they tie.
+func compareBeans(key SortKey, a, b *Bean, statusRank, priorityRank, typeRank func(string) int) int {
+	// Missing values sort last regardless of direction
+	var aMissing, bMissing bool
+	var c int
+	switch key.Field {
+	case "created":
+		aMissing, bMissing = a.CreatedAt == nil, b.CreatedAt == nil
+		if !aMissing && !bMissing {
+			c = a.CreatedAt.Compare(*b.CreatedAt)
 		}
-		// Quaternary: type order
-		ti, tj := getTypeOrder(beans[i].Type), getTypeOrder(beans[j].Type)
-		if ti != tj {
-			return ti < tj
+	case "updated":
+		aMissing, bMissing = a.UpdatedAt == nil, b.UpdatedAt == nil
+		if !aMissing && !bMissing {
+			c = a.UpdatedAt.Compare(*b.UpdatedAt)
 		}
-		// Final: title (case-insensitive) for stable, user-friendly ordering
-		return strings.ToLower(beans[i].Title) < strings.ToLower(beans[j].Title)
-	})
+	case "due":
+		aMissing, bMissing = a.Due == nil, b.Due == nil
+		if !aMissing && !bMissing {
+			c = a.Due.Compare(b.Due.Time)
+		}
+	case "order":
+		aMissing, bMissing = a.Order == "", b.Order == ""
+		c = strings.Compare(a.Order, b.Order)
+	case "status":
+		c = cmp.Compare(statusRank(a.Status), statusRank(b.Status))
+	case "priority":
+		c = cmp.Compare(priorityRank(a.Priority), priorityRank(b.Priority))
+	case "type":
+		c = cmp.Compare(typeRank(a.Type), typeRank(b.Type))
+	case "id":
+		c = strings.Compare(a.ID, b.ID)
+	}
+
+	switch {
+	case aMissing && bMissing:
+		return 0
+	case aMissing:
+		return 1
+	case bMissing:
+		return -1
+	case key.Descending:
+		return -c
+	}
+	return c
 }
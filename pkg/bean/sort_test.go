@@ -1,7 +1,9 @@
 package bean
 
 import (
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSortByStatusPriorityAndType(t *testing.T) {
@@ -141,3 +143,113 @@ func TestSortByStatusPriorityAndType(t *testing.T) {
 	})
 }
 
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    SortKey
+		wantErr bool
+	}{
+		{"", SortKey{}, false},
+		{"priority", SortKey{Field: "priority"}, false},
+		{"created", SortKey{Field: "created", Descending: true}, false},
+		{"created:asc", SortKey{Field: "created"}, false},
+		{"Due:DESC", SortKey{Field: "due", Descending: true}, false},
+		{"title", SortKey{}, true},
+		{"status:up", SortKey{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSort(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseSort(%q) expected error, got %+v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSort(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSort(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByKeys(t *testing.T) {
+	statusNames := []string{"todo", "completed"}
+	priorityNames := []string{"high", "normal", "low"}
+	typeNames := []string{"bug", "task"}
+	at := func(day int) *time.Time {
+		t := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	ids := func(beans []*Bean) string {
+		var s []string
+		for _, b := range beans {
+			s = append(s, b.ID)
+		}
+		return strings.Join(s, ",")
+	}
+	newBeans := func() []*Bean {
+		return []*Bean{
+			{ID: "a", Title: "A", Status: "todo", Priority: "low", Type: "task", CreatedAt: at(2), Order: "b"},
+			{ID: "b", Title: "B", Status: "completed", Priority: "high", Type: "bug", CreatedAt: at(3)},
+			{ID: "c", Title: "C", Status: "todo", Type: "bug", Order: "a"},
+			{ID: "d", Title: "D", Status: "todo", Priority: "high", Type: "task", CreatedAt: at(1)},
+		}
+	}
+
+	tests := []struct {
+		name string
+		keys []SortKey
+		want string
+	}{
+		{"default", nil, "c,a,d,b"},
+		{"created ascending", []SortKey{{Field: "created"}}, "d,a,b,c"},
+		{"created descending, missing last", []SortKey{{Field: "created", Descending: true}}, "b,a,d,c"},
+		{"order, missing last", []SortKey{{Field: "order"}}, "c,a,d,b"},
+		{"type descending", []SortKey{{Field: "type", Descending: true}}, "a,d,c,b"},
+		{"priority then id descending", []SortKey{{Field: "priority"}, {Field: "id", Descending: true}}, "d,b,c,a"},
+		{"status ties keep default order", []SortKey{{Field: "status"}}, "c,a,d,b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beans := newBeans()
+			SortByKeys(beans, tt.keys, statusNames, priorityNames, typeNames)
+			if got := ids(beans); got != tt.want {
+				t.Errorf("SortByKeys() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchAfter(t *testing.T) {
+	statusNames := []string{"todo", "completed"}
+	keys := []SortKey{{Field: "status"}}
+	beans := []*Bean{
+		{ID: "a", Title: "A", Status: "todo"},
+		{ID: "c", Title: "C", Status: "todo"},
+		{ID: "b", Title: "B", Status: "completed"},
+	}
+	SortByKeys(beans, keys, statusNames, nil, nil)
+
+	tests := []struct {
+		name string
+		pos  *Bean
+		want int
+	}{
+		{"first bean", &Bean{ID: "a", Title: "A", Status: "todo"}, 1},
+		{"last bean", &Bean{ID: "b", Title: "B", Status: "completed"}, 3},
+		{"position of a bean that's gone", &Bean{ID: "b", Title: "B", Status: "todo"}, 1},
+		{"before everything", &Bean{ID: "0", Title: "0", Status: "todo"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchAfter(beans, tt.pos, keys, statusNames, nil, nil); got != tt.want {
+				t.Errorf("SearchAfter() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
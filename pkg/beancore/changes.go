@@ -0,0 +1,60 @@
+package beancore
+
+import "github.com/hmans/beans/pkg/bean"
+
+// Change is a bean event together with what it changed.
+type Change struct {
+	Type   EventType
+	BeanID string
+	// Bean is the bean after the change, or its last version for deletes.
+	Bean *bean.Bean
+	// Previous is the bean before the change (nil for creates).
+	Previous *bean.Bean
+	// Fields lists the changes made by an update.
+	Fields []FieldChange
+}
+
+// ChangeTracker remembers the last seen version of every bean, so that
+// subscribers can tell what each event changed.
+type ChangeTracker struct {
+	beans map[string]*bean.Bean
+}
+
+// NewChangeTracker returns a tracker that knows the currently loaded beans.
+// Subscribe before creating it, so that no change is missed in between.
+func (c *Core) NewChangeTracker() *ChangeTracker {
+	t := &ChangeTracker{beans: make(map[string]*bean.Bean)}
+	for _, b := range c.All() {
+		t.beans[b.ID] = b.Clone()
+	}
+	return t
+}
+
+// Track records an event and returns the change it made. ok is false for
+// events that changed nothing worth reporting: updates that only touched
+// timestamps or ordering, and deletes of beans the tracker never saw.
+//
+// Beans created by this process reach subscribers as updates (they are
+// already loaded when the watcher sees their file); Track reports them as
+// creates.
+func (t *ChangeTracker) Track(event BeanEvent) (change Change, ok bool) {
+	prev := t.beans[event.BeanID]
+	if event.Bean != nil {
+		t.beans[event.BeanID] = event.Bean.Clone()
+	} else {
+		delete(t.beans, event.BeanID)
+	}
+
+	change = Change{Type: event.Type, BeanID: event.BeanID, Bean: event.Bean, Previous: prev}
+	switch {
+	case event.Type == EventDeleted:
+		change.Bean = prev
+		return change, prev != nil
+	case prev == nil:
+		change.Type = EventCreated
+	case event.Type == EventUpdated:
+		change.Fields = Diff(prev, event.Bean)
+		return change, len(change.Fields) > 0
+	}
+	return change, true
+}
@@ -0,0 +1,52 @@
+package beancore
+
+import (
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestChangeTracker(t *testing.T) {
+	core, _ := setupTestCore(t)
+	existing := &bean.Bean{ID: "old1", Slug: "old", Title: "Old", Status: "todo"}
+	if err := core.Create(existing); err != nil {
+		t.Fatal(err)
+	}
+	tracker := core.NewChangeTracker()
+
+	t.Run("update of a known bean", func(t *testing.T) {
+		updated := existing.Clone()
+		updated.Status = "completed"
+		change, ok := tracker.Track(BeanEvent{Type: EventUpdated, Bean: updated, BeanID: "old1"})
+		if !ok || change.Type != EventUpdated || change.Previous.Status != "todo" ||
+			len(change.Fields) != 1 || change.Fields[0].Field != "status" {
+			t.Errorf("Track() = %+v, %v", change, ok)
+		}
+	})
+
+	t.Run("update without field changes", func(t *testing.T) {
+		touched := existing.Clone()
+		touched.Status = "completed"
+		if _, ok := tracker.Track(BeanEvent{Type: EventUpdated, Bean: touched, BeanID: "old1"}); ok {
+			t.Error("Track() reported an update that changed nothing")
+		}
+	})
+
+	t.Run("update of an unknown bean is a create", func(t *testing.T) {
+		b := &bean.Bean{ID: "new1", Title: "New", Status: "todo"}
+		change, ok := tracker.Track(BeanEvent{Type: EventUpdated, Bean: b, BeanID: "new1"})
+		if !ok || change.Type != EventCreated || change.Previous != nil || change.Fields != nil {
+			t.Errorf("Track() = %+v, %v", change, ok)
+		}
+	})
+
+	t.Run("delete carries the last version", func(t *testing.T) {
+		change, ok := tracker.Track(BeanEvent{Type: EventDeleted, BeanID: "new1"})
+		if !ok || change.Bean == nil || change.Bean.Title != "New" {
+			t.Errorf("Track() = %+v, %v", change, ok)
+		}
+		if _, ok := tracker.Track(BeanEvent{Type: EventDeleted, BeanID: "new1"}); ok {
+			t.Error("Track() reported deleting an unknown bean")
+		}
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,9 @@ import (
 const BeansDir = ".beans"
 const ArchiveDir = "archive"
 
+// SearchIndexDir is the directory (inside .beans) holding the persistent search index.
+const SearchIndexDir = ".index"
+
 var ErrNotFound = errors.New("bean not found")
 
 // ETagMismatchError is returned when an ETag validation fails.
@@ -52,6 +56,9 @@ type Core struct {
 	beans          map[string]*bean.Bean // ID -> Bean
 	dirty          map[string]bool       // IDs of beans modified in runtime but not yet persisted to disk
 	worktreeLinks  map[string]string     // bean ID -> worktree path (beans linked to a worktree)
+	loadErrors     map[string]string     // relative path -> parse error of bean files that failed to load
+	linkSources    map[string]map[string]bool // bean ID -> IDs of beans with links to it
+	linkTargets    map[string][]string        // bean ID -> IDs its links point to, as recorded in linkSources
 
 	// Search index (optional, lazy-initialized)
 	searchIndex *search.Index
@@ -80,6 +87,9 @@ func New(root string, cfg *config.Config) *Core {
 		beans:         make(map[string]*bean.Bean),
 		dirty:         make(map[string]bool),
 		worktreeLinks: make(map[string]string),
+		loadErrors:    make(map[string]string),
+		linkSources:   make(map[string]map[string]bool),
+		linkTargets:   make(map[string][]string),
 		subscribers: make(map[uint64]*subscription),
 		warnWriter:  os.Stderr,
 	}
@@ -120,18 +130,32 @@ func (c *Core) Load() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// Without a config file there is no recorded format version, so tell it
+	// from the data itself
+	if c.config != nil && !c.config.FromFile() {
+		version, err := detectFormatVersion(c.root)
+		if err != nil {
+			return err
+		}
+		c.config.Beans.FormatVersion = version
+	}
+
+	if err := c.checkFormatVersion(); err != nil {
+		return err
+	}
 	return c.loadFromDisk()
 }
 
 // loadFromDisk reads all beans from disk (must be called with lock held).
-// Loads all .md files from the root directory and any subdirectories.
+// Loads all .md files from the root directory and any subdirectories. Files
+// that fail to parse are skipped and reported by LoadErrors.
 func (c *Core) loadFromDisk() error {
-	// Migrate legacy directory names (worktrees/ → .worktrees/, conversations/ → .conversations/)
-	c.migrateLegacyDirs()
-
-	// Clear existing beans and dirty state
+	// Clear existing beans, dirty state, load errors, and the link index
 	c.beans = make(map[string]*bean.Bean)
 	c.dirty = make(map[string]bool)
+	c.loadErrors = make(map[string]string)
+	c.linkSources = make(map[string]map[string]bool)
+	c.linkTargets = make(map[string][]string)
 
 	// Walk the .beans directory tree, loading all .md files
 	err := filepath.WalkDir(c.root, func(path string, d os.DirEntry, err error) error {
@@ -140,9 +164,14 @@ func (c *Core) loadFromDisk() error {
 		}
 
 		// Skip dot-prefixed subdirectories (e.g. .worktrees/, .conversations/)
-		// — these contain non-bean data and should never be walked.
+		// and templates/ — these contain non-bean data and should never be walked.
 		if d.IsDir() && path != c.root {
-			if strings.HasPrefix(d.Name(), ".") {
+			if isNonBeanDir(c.root, path) {
+				return filepath.SkipDir
+			}
+			// Legacy worktrees/ and conversations/ directories are skipped
+			// until `beans migrate` renames them
+			if filepath.Dir(path) == c.root && c.config.Beans.FormatVersion < 1 && slices.Contains(legacyDirs, d.Name()) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -153,25 +182,25 @@ func (c *Core) loadFromDisk() error {
 			return nil
 		}
 
+		// A broken file (e.g. after a bad merge) shouldn't make all other
+		// beans unusable; record the error and skip it
 		b, loadErr := c.loadBean(path)
 		if loadErr != nil {
-			return fmt.Errorf("loading %s: %w", path, loadErr)
+			c.setLoadErrorLocked(path, loadErr)
+			return nil
 		}
 
-		c.beans[b.ID] = b
+		c.setBeanLocked(b)
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
-	// Reinitialize search index if it was active: close and re-create (best-effort, don't fail load)
+	// Bring the search index up to date if it was active (best-effort, don't fail load)
 	if c.searchIndex != nil {
-		c.searchIndex.Close()
-		c.searchIndex = nil
-
-		if err := c.ensureSearchIndexLocked(); err != nil {
-			c.logWarn("failed to reinitialize search index after reload: %v", err)
+		if err := c.syncSearchIndexLocked(); err != nil {
+			c.logWarn("failed to update search index after reload: %v", err)
 		}
 	}
 
@@ -234,29 +263,67 @@ func (c *Core) loadBean(path string) (*bean.Bean, error) {
 	return b, nil
 }
 
-// ensureSearchIndexLocked initializes the in-memory search index if not already created.
+// ensureSearchIndexLocked initializes the search index if not already created.
+// The index is persisted in SearchIndexDir, so only beans that changed since
+// the last run are re-indexed. If the persistent index can't be used (e.g.
+// because another process has it open), an in-memory index is built instead.
 // Must be called with lock held or from a method that holds the lock.
 func (c *Core) ensureSearchIndexLocked() error {
 	if c.searchIndex != nil {
 		return nil
 	}
 
-	idx, err := search.NewIndex()
+	idx, err := search.Open(filepath.Join(c.root, SearchIndexDir))
 	if err != nil {
-		return fmt.Errorf("initializing search index: %w", err)
+		if !errors.Is(err, search.ErrLocked) {
+			c.logWarn("failed to open search index, falling back to in-memory index: %v", err)
+		}
+		idx, err = search.NewIndex()
+		if err != nil {
+			return fmt.Errorf("initializing search index: %w", err)
+		}
 	}
 
+	// Children are indexed with their parent's title. The function is only
+	// called while indexing, with the lock held.
+	idx.SetParentTitleFunc(func(id string) string {
+		if p, ok := c.beans[id]; ok {
+			return p.Title
+		}
+		return ""
+	})
+
 	c.searchIndex = idx
+	return c.syncSearchIndexLocked()
+}
+
+// refreshChildrenInSearchIndexLocked re-indexes the children of a bean whose
+// title may have changed, since they're indexed with their parent's title.
+// Must be called with lock held and an active search index.
+func (c *Core) refreshChildrenInSearchIndexLocked(parentID string) error {
+	var children []*bean.Bean
+	for _, b := range c.beans {
+		if b.Parent == parentID {
+			children = append(children, b)
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	_, err := c.searchIndex.Refresh(children)
+	return err
+}
 
-	// Populate the in-memory index with existing beans
+// syncSearchIndexLocked re-indexes beans that changed and removes deleted
+// beans from the search index. Must be called with lock held.
+func (c *Core) syncSearchIndexLocked() error {
 	allBeans := make([]*bean.Bean, 0, len(c.beans))
 	for _, b := range c.beans {
 		allBeans = append(allBeans, b)
 	}
-	if err := c.searchIndex.IndexBeans(allBeans); err != nil {
+	if _, _, err := c.searchIndex.Sync(allBeans); err != nil {
 		return fmt.Errorf("populating search index: %w", err)
 	}
-
 	return nil
 }
 
@@ -519,7 +586,7 @@ func (c *Core) Create(b *bean.Bean, opts ...UpdateOption) error {
 	}
 
 	// Add to in-memory map
-	c.beans[b.ID] = b
+	c.setBeanLocked(b)
 
 	// Update search index if active (best-effort, don't fail create)
 	if c.searchIndex != nil {
@@ -616,18 +683,136 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string, opts ...UpdateOption) error
 	}
 
 	// Update in-memory map
-	c.beans[b.ID] = b
+	c.setBeanLocked(b)
 
 	// Update search index if active (best-effort, don't fail update)
 	if c.searchIndex != nil {
 		if err := c.searchIndex.IndexBean(b); err != nil {
 			c.logWarn("failed to update bean %s in search index: %v", b.ID, err)
 		}
+		if err := c.refreshChildrenInSearchIndexLocked(b.ID); err != nil {
+			c.logWarn("failed to update children of bean %s in search index: %v", b.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// BeanUpdate is a bean to write with UpdateMany.
+type BeanUpdate struct {
+	// Bean is the updated bean; it must not be the stored bean itself.
+	Bean *bean.Bean
+	// ETag, if set, is the etag of the version the update was based on. The
+	// batch is refused if the bean has changed since.
+	ETag string
+}
+
+// UpdateMany writes several updated beans as a single change, holding the
+// lock throughout: every bean is checked before any is written, and if a
+// write fails, the files already written are restored and nothing is
+// updated. Beans linked to a worktree are written there, as with Update.
+func (c *Core) UpdateMany(updates []BeanUpdate) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, u := range updates {
+		stored, ok := c.beans[u.Bean.ID]
+		if !ok {
+			return fmt.Errorf("%s: %w", u.Bean.ID, ErrNotFound)
+		}
+		if u.ETag != "" {
+			if current := stored.ETag(); current != u.ETag {
+				return fmt.Errorf("%s: %w", u.Bean.ID, &ETagMismatchError{Provided: u.ETag, Current: current})
+			}
+		}
+	}
+
+	// Each written file's previous content, to restore if a later write fails
+	type written struct {
+		path     string
+		previous []byte
+		existed  bool
+	}
+	var done []written
+	restore := func() error {
+		var errs []error
+		for _, w := range slices.Backward(done) {
+			var err error
+			if !w.existed {
+				err = os.Remove(w.path)
+			} else {
+				err = os.WriteFile(w.path, w.previous, 0644)
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, u := range updates {
+		b := u.Bean
+		b.UpdatedAt = &now
+
+		wtPath := c.worktreeLinks[b.ID]
+		var path string
+		switch {
+		case wtPath != "":
+			path = filepath.Join(wtPath, BeansDir, bean.BuildFilename(b.ID, b.Slug))
+		case b.Path != "":
+			path = filepath.Join(c.root, b.Path)
+		default:
+			path = filepath.Join(c.root, bean.BuildFilename(b.ID, b.Slug))
+		}
+		previous, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return updateManyError(b.ID, err, restore())
+		}
+		existed := err == nil
+
+		if wtPath != "" {
+			err = c.saveToWorktree(b, wtPath)
+		} else {
+			err = c.saveToDisk(b)
+		}
+		if err != nil {
+			return updateManyError(b.ID, err, restore())
+		}
+		done = append(done, written{path: path, previous: previous, existed: existed})
+	}
+
+	for _, u := range updates {
+		b := u.Bean
+		if c.worktreeLinks[b.ID] != "" {
+			c.dirty[b.ID] = true
+		} else {
+			delete(c.dirty, b.ID)
+		}
+		c.setBeanLocked(b)
+
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(b); err != nil {
+				c.logWarn("failed to update bean %s in search index: %v", b.ID, err)
+			}
+			if err := c.refreshChildrenInSearchIndexLocked(b.ID); err != nil {
+				c.logWarn("failed to update children of bean %s in search index: %v", b.ID, err)
+			}
+		}
 	}
 
 	return nil
 }
 
+// updateManyError returns the error for a batch whose write of beanID failed
+// with err, mentioning any files that couldn't be restored.
+func updateManyError(beanID string, err, restoreErr error) error {
+	if restoreErr != nil {
+		return fmt.Errorf("writing %s: %w (restoring the beans written before it also failed: %v)", beanID, err, restoreErr)
+	}
+	return fmt.Errorf("writing %s: %w (no beans were updated)", beanID, err)
+}
+
 // saveToDisk writes a bean to the filesystem.
 func (c *Core) saveToDisk(b *bean.Bean) error {
 	// Determine the file path
@@ -687,7 +872,6 @@ func (c *Core) saveToWorktree(b *bean.Bean, worktreePath string) error {
 // Supports short IDs (without prefix) if a prefix is configured.
 func (c *Core) Delete(id string) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	// Find the bean by exact match
 	targetID := id
@@ -704,17 +888,19 @@ func (c *Core) Delete(id string) error {
 	}
 
 	if !ok {
+		c.mu.Unlock()
 		return ErrNotFound
 	}
 
 	// Remove from disk
 	path := filepath.Join(c.root, targetBean.Path)
 	if err := os.Remove(path); err != nil {
+		c.mu.Unlock()
 		return err
 	}
 
 	// Remove from in-memory map
-	delete(c.beans, targetID)
+	c.deleteBeanLocked(targetID)
 
 	// Update search index if active (best-effort, don't fail delete)
 	if c.searchIndex != nil {
@@ -722,6 +908,14 @@ func (c *Core) Delete(id string) error {
 			c.logWarn("failed to remove bean %s from search index: %v", targetID, err)
 		}
 	}
+	c.mu.Unlock()
+
+	// Notify subscribers here: the watcher won't, as the bean is already gone
+	// from the map when it sees the file removed
+	c.fanOut([]BeanEvent{{
+		Type:   EventDeleted,
+		BeanID: targetID,
+	}})
 
 	return nil
 }
@@ -763,7 +957,7 @@ func (c *Core) Archive(id string) error {
 
 	// Update bean's path in store and notify subscribers
 	targetBean.Path = newRelPath
-	c.beans[targetID] = targetBean
+	c.setBeanLocked(targetBean)
 	c.mu.Unlock()
 
 	c.fanOut([]BeanEvent{{
@@ -782,7 +976,7 @@ func (c *Core) Unarchive(id string) error {
 	defer c.mu.Unlock()
 
 	// Find the bean
-	targetBean, targetID, err := c.findBeanLocked(id)
+	targetBean, _, err := c.findBeanLocked(id)
 	if err != nil {
 		return err
 	}
@@ -803,7 +997,7 @@ func (c *Core) Unarchive(id string) error {
 
 	// Update bean's path
 	targetBean.Path = newRelPath
-	c.beans[targetID] = targetBean
+	c.setBeanLocked(targetBean)
 
 	return nil
 }
@@ -895,7 +1089,7 @@ func (c *Core) LoadAndUnarchive(id string) (*bean.Bean, error) {
 	defer c.mu.Unlock()
 
 	// Find the bean (always loaded since we now include archived beans)
-	b, targetID, err := c.findBeanLocked(id)
+	b, _, err := c.findBeanLocked(id)
 	if err != nil {
 		return nil, ErrNotFound
 	}
@@ -916,7 +1110,7 @@ func (c *Core) LoadAndUnarchive(id string) (*bean.Bean, error) {
 
 	// Update bean's path
 	b.Path = newRelPath
-	c.beans[targetID] = b
+	c.setBeanLocked(b)
 
 	return b, nil
 }
@@ -966,23 +1160,9 @@ func Init(dir string) error {
 }
 
 // writeGitignore creates or overwrites a .gitignore in the beans directory
-// to exclude conversation logs from version control.
+// to exclude conversation logs and the search index from version control.
 // Note: worktrees are stored outside the repo (in ~/.beans/worktrees/<project>/).
 func writeGitignore(beansDir string) error {
-	content := "# Generated by beans init\n.conversations/\n"
+	content := "# Generated by beans init\n.conversations/\n" + SearchIndexDir + "/\n"
 	return os.WriteFile(filepath.Join(beansDir, ".gitignore"), []byte(content), 0644)
 }
-
-// migrateLegacyDirs renames old-style directories (worktrees/, conversations/)
-// to their dot-prefixed equivalents (.worktrees/, .conversations/). Best-effort.
-func (c *Core) migrateLegacyDirs() {
-	for _, name := range []string{"worktrees", "conversations"} {
-		oldPath := filepath.Join(c.root, name)
-		newPath := filepath.Join(c.root, "."+name)
-		if _, err := os.Stat(oldPath); err == nil {
-			if _, err := os.Stat(newPath); os.IsNotExist(err) {
-				_ = os.Rename(oldPath, newPath)
-			}
-		}
-	}
-}
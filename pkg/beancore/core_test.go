@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -330,6 +331,77 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateMany(t *testing.T) {
+	// setup creates two beans and returns updated copies of them with their etags
+	setup := func(t *testing.T) (*Core, string, []BeanUpdate) {
+		core, beansDir := setupTestCore(t)
+		var updates []BeanUpdate
+		for _, id := range []string{"many1", "many2"} {
+			b := createTestBean(t, core, id, "Original", "todo")
+			c := b.Clone()
+			c.Title = "Updated"
+			updates = append(updates, BeanUpdate{Bean: c, ETag: b.ETag()})
+		}
+		return core, beansDir, updates
+	}
+
+	t.Run("writes all beans", func(t *testing.T) {
+		core, beansDir, updates := setup(t)
+		if err := core.UpdateMany(updates); err != nil {
+			t.Fatalf("UpdateMany() error = %v", err)
+		}
+		for _, u := range updates {
+			if got, _ := core.Get(u.Bean.ID); got.Title != "Updated" {
+				t.Errorf("%s title = %q, want Updated", u.Bean.ID, got.Title)
+			}
+			data, _ := os.ReadFile(filepath.Join(beansDir, u.Bean.Path))
+			if !strings.Contains(string(data), "title: Updated") {
+				t.Errorf("%s file not updated:\n%s", u.Bean.ID, data)
+			}
+		}
+	})
+
+	t.Run("refuses stale beans", func(t *testing.T) {
+		core, _, updates := setup(t)
+		other, _ := core.Get("many2")
+		other.Status = "in-progress"
+		if err := core.Update(other, nil); err != nil {
+			t.Fatal(err)
+		}
+		var mismatch *ETagMismatchError
+		if err := core.UpdateMany(updates); !errors.As(err, &mismatch) {
+			t.Fatalf("UpdateMany() error = %v, want etag mismatch", err)
+		}
+		if got, _ := core.Get("many1"); got.Title != "Original" {
+			t.Errorf("many1 title = %q, want nothing written", got.Title)
+		}
+	})
+
+	t.Run("restores written files when a write fails", func(t *testing.T) {
+		core, beansDir, updates := setup(t)
+		first := filepath.Join(beansDir, updates[0].Bean.Path)
+		original, _ := os.ReadFile(first)
+		// Replace the second bean's file with a directory, so it can't be written
+		second := filepath.Join(beansDir, updates[1].Bean.Path)
+		if err := os.Remove(second); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Mkdir(second, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := core.UpdateMany(updates); err == nil {
+			t.Fatal("UpdateMany() should fail")
+		}
+		if data, _ := os.ReadFile(first); string(data) != string(original) {
+			t.Errorf("many1 file not restored:\n%s", data)
+		}
+		if got, _ := core.Get("many1"); got.Title != "Original" {
+			t.Errorf("many1 title = %q, want unchanged", got.Title)
+		}
+	})
+}
+
 func TestUpdateNotFound(t *testing.T) {
 	core, _ := setupTestCore(t)
 
@@ -0,0 +1,9 @@
+package beancore
+
+import "github.com/hmans/beans/pkg/bean"
+
+// IsOverdue returns true if the bean's due date is before today and it isn't
+// in a resolved (archive) status.
+func (c *Core) IsOverdue(b *bean.Bean, today bean.Date) bool {
+	return b.IsOverdue(today) && !c.isResolvedStatus(b.Status)
+}
@@ -0,0 +1,48 @@
+package beancore
+
+import "sort"
+
+// FieldIssue represents a custom field value that doesn't match its declaration in config.
+type FieldIssue struct {
+	BeanID string `json:"bean_id"`
+	Field  string `json:"field"`
+	Value  string `json:"value"`
+	Error  string `json:"error"`
+}
+
+// CheckFields validates all custom field values against the field declarations
+// in config. Fields that are not declared are preserved as-is and not reported.
+func (c *Core) CheckFields() []FieldIssue {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	issues := []FieldIssue{}
+	if c.config == nil || len(c.config.Fields) == 0 {
+		return issues
+	}
+
+	for _, b := range c.beans {
+		for name, value := range b.Fields {
+			f := c.config.GetField(name)
+			if f == nil {
+				continue
+			}
+			if _, err := f.Normalize(value); err != nil {
+				issues = append(issues, FieldIssue{
+					BeanID: b.ID,
+					Field:  name,
+					Value:  value,
+					Error:  err.Error(),
+				})
+			}
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].BeanID != issues[j].BeanID {
+			return issues[i].BeanID < issues[j].BeanID
+		}
+		return issues[i].Field < issues[j].Field
+	})
+	return issues
+}
@@ -0,0 +1,61 @@
+package beancore
+
+import (
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+func TestCheckFields(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.Config().Fields = []config.FieldConfig{
+		{Name: "points", Type: config.FieldTypeInt},
+		{Name: "area", Type: config.FieldTypeEnum, Values: []string{"ui", "api"}},
+	}
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Valid", Status: "todo", Fields: map[string]string{"points": "3", "area": "ui"}},
+		{ID: "bbb2", Title: "Bad int", Status: "todo", Fields: map[string]string{"points": "lots"}},
+		{ID: "ccc3", Title: "Bad enum", Status: "todo", Fields: map[string]string{"area": "db"}},
+		{ID: "ddd4", Title: "Undeclared", Status: "todo", Fields: map[string]string{"customer": "acme"}},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	issues := core.CheckFields()
+	if len(issues) != 2 {
+		t.Fatalf("CheckFields() = %+v, want 2 issues", issues)
+	}
+	if issues[0].BeanID != "bbb2" || issues[0].Field != "points" {
+		t.Errorf("issues[0] = %+v, want bbb2/points", issues[0])
+	}
+	if issues[1].BeanID != "ccc3" || issues[1].Field != "area" {
+		t.Errorf("issues[1] = %+v, want ccc3/area", issues[1])
+	}
+}
+
+func TestCustomFieldsPersisted(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	b := &bean.Bean{ID: "fld1", Title: "With fields", Status: "todo", Fields: map[string]string{"points": "5"}}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	reloaded := New(beansDir, config.Default())
+	reloaded.SetWarnWriter(nil)
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	got, err := reloaded.Get("fld1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if v, _ := got.GetField("points"); v != "5" {
+		t.Errorf("GetField(points) = %q, want \"5\"", v)
+	}
+}
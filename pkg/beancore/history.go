@@ -0,0 +1,298 @@
+package beancore
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/gitutil"
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// ErrNoHistory is returned by History when the beans directory is not tracked in git.
+var ErrNoHistory = gitutil.ErrNotRepository
+
+// HistoryEntry is a single commit that changed a bean.
+type HistoryEntry struct {
+	Commit  string    `json:"commit"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+	// Created is true for the commit that added the bean.
+	Created bool `json:"created,omitempty"`
+	// Deleted is true for a commit that removed the bean's file.
+	Deleted bool          `json:"deleted,omitempty"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange describes how a single bean field changed in one commit.
+// Custom fields are reported under their configured name.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+	// Added and Removed list the values added to or removed from list fields
+	// (tags, blocking, blocked_by, assignees).
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	// Diff is a line diff of the body, set for body changes only.
+	Diff string `json:"diff,omitempty"`
+}
+
+// History reconstructs the field-level change history of a bean from the git
+// history of its file, newest first. Commits that only touched timestamps or
+// ordering are omitted. Returns ErrNoHistory if .beans is not in a git repository.
+func (c *Core) History(id string) ([]HistoryEntry, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := gitutil.FileHistory(c.root, filepath.Join(c.root, b.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []HistoryEntry
+	var prev *bean.Bean
+	for _, rev := range revisions {
+		entry := HistoryEntry{
+			Commit:  rev.Commit,
+			Author:  rev.Author,
+			Email:   rev.Email,
+			Date:    rev.Date,
+			Message: rev.Subject,
+		}
+
+		if rev.Content == nil {
+			if prev == nil {
+				continue
+			}
+			entry.Deleted = true
+			entry.Changes = []FieldChange{}
+			entries = append(entries, entry)
+			prev = nil
+			continue
+		}
+
+		cur, err := bean.Parse(bytes.NewReader(rev.Content))
+		if err != nil {
+			// Skip revisions that can't be parsed; the next valid one is diffed
+			// against the last valid revision.
+			continue
+		}
+
+		if prev == nil {
+			entry.Created = true
+			entry.Changes = diffBeans(&bean.Bean{}, cur, false)
+		} else {
+			entry.Changes = diffBeans(prev, cur, true)
+			if len(entry.Changes) == 0 {
+				prev = cur
+				continue
+			}
+		}
+		entries = append(entries, entry)
+		prev = cur
+	}
+
+	slices.Reverse(entries)
+	return entries, nil
+}
+
+// Diff returns the field-level changes between two versions of a bean,
+// including a line diff of the body.
+func Diff(old, cur *bean.Bean) []FieldChange {
+	return diffBeans(old, cur, true)
+}
+
+// diffBeans returns the changes between two versions of a bean. Body changes
+// are only reported if includeBody is true.
+func diffBeans(old, cur *bean.Bean, includeBody bool) []FieldChange {
+	changes := []FieldChange{}
+
+	scalar := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+	list := func(field string, from, to []string) {
+		added, removed := diffLists(from, to)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, FieldChange{
+				Field:   field,
+				From:    strings.Join(from, ", "),
+				To:      strings.Join(to, ", "),
+				Added:   added,
+				Removed: removed,
+			})
+		}
+	}
+
+	scalar("title", old.Title, cur.Title)
+	scalar("status", old.Status, cur.Status)
+	scalar("type", old.Type, cur.Type)
+	scalar("priority", old.Priority, cur.Priority)
+	scalar("parent", old.Parent, cur.Parent)
+	scalar("start", dateString(old.Start), dateString(cur.Start))
+	scalar("due", dateString(old.Due), dateString(cur.Due))
+	scalar("estimate", estimateString(old.Estimate), estimateString(cur.Estimate))
+	scalar("recur", old.Recur, cur.Recur)
+	list("tags", old.Tags, cur.Tags)
+	list("blocking", old.Blocking, cur.Blocking)
+	list("blocked_by", old.BlockedBy, cur.BlockedBy)
+	list("assignees", old.Assignees, cur.Assignees)
+
+	linkTypes := old.RelationTypes()
+	for _, linkType := range cur.RelationTypes() {
+		if !slices.Contains(linkTypes, linkType) {
+			linkTypes = append(linkTypes, linkType)
+		}
+	}
+	sort.Strings(linkTypes)
+	for _, linkType := range linkTypes {
+		list(linkType, old.Relations[linkType], cur.Relations[linkType])
+	}
+
+	names := make([]string, 0, len(old.Fields)+len(cur.Fields))
+	for name := range old.Fields {
+		names = append(names, name)
+	}
+	for name := range cur.Fields {
+		if _, ok := old.Fields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		scalar(name, old.Fields[name], cur.Fields[name])
+	}
+
+	if len(old.Comments) != len(cur.Comments) {
+		scalar("comments", strconv.Itoa(len(old.Comments)), strconv.Itoa(len(cur.Comments)))
+	}
+
+	if includeBody && old.Body != cur.Body {
+		changes = append(changes, FieldChange{Field: "body", Diff: lineDiff(old.Body, cur.Body)})
+	}
+
+	return changes
+}
+
+// dateString formats an optional date, returning "" if it is nil.
+func dateString(d *bean.Date) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// estimateString formats an estimate, returning "" if it is unset.
+func estimateString(estimate float64) string {
+	if estimate == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(estimate, 'f', -1, 64)
+}
+
+// diffLists returns the values present in to but not in from (added) and
+// those present in from but not in to (removed).
+func diffLists(from, to []string) (added, removed []string) {
+	for _, v := range to {
+		if !slices.Contains(from, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range from {
+		if !slices.Contains(to, v) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// diffContext is the number of unchanged lines shown around each change in a body diff.
+const diffContext = 2
+
+// lineDiff returns a compact line diff between two texts, ignoring leading and
+// trailing blank lines. Removed lines are prefixed with "-", added lines with
+// "+", and unchanged context lines with a space. Runs of unchanged lines beyond
+// the context are collapsed to "...".
+func lineDiff(a, b string) string {
+	a, b = strings.Trim(a, "\n"), strings.Trim(b, "\n")
+	var x, y []string
+	if a != "" {
+		x = strings.Split(a, "\n")
+	}
+	if b != "" {
+		y = strings.Split(b, "\n")
+	}
+
+	// Longest common subsequence table
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	type diffLine struct {
+		op   byte
+		text string
+	}
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			lines = append(lines, diffLine{' ', x[i]})
+			i++
+			j++
+		case i < len(x) && (j == len(y) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', x[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', y[j]})
+			j++
+		}
+	}
+
+	// Mark which lines are within context of a change
+	keep := make([]bool, len(lines))
+	for k, l := range lines {
+		if l.op == ' ' {
+			continue
+		}
+		for c := max(0, k-diffContext); c <= min(len(lines)-1, k+diffContext); c++ {
+			keep[c] = true
+		}
+	}
+
+	var sb strings.Builder
+	skipped := false
+	for k, l := range lines {
+		if !keep[k] {
+			skipped = true
+			continue
+		}
+		if skipped {
+			sb.WriteString("...\n")
+		}
+		skipped = false
+		fmt.Fprintf(&sb, "%c%s\n", l.op, l.text)
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -0,0 +1,129 @@
+package beancore
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// gitInit initializes a git repository in dir with a test identity.
+func gitInit(t *testing.T, dir string) {
+	t.Helper()
+	for _, args := range [][]string{
+		{"init", "-b", "main"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+	} {
+		gitCommit(t, dir, args...)
+	}
+}
+
+// gitCommit runs a git command in dir.
+func gitCommit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %s\n%s", args, err, out)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	repo := filepath.Dir(beansDir)
+	gitInit(t, repo)
+
+	b := createTestBean(t, core, "hist-1", "History", "todo")
+	b.Body = "First line\nSecond line\n"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	gitCommit(t, repo, "add", "-A")
+	gitCommit(t, repo, "commit", "-m", "create bean")
+
+	b.Status = "in-progress"
+	b.Tags = []string{"backend"}
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	gitCommit(t, repo, "commit", "-am", "start work")
+
+	// A commit that only changes ordering is omitted
+	b.Order = "a0"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	gitCommit(t, repo, "commit", "-am", "touch")
+
+	b.Body = "First line\nSecond line, edited\n"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	gitCommit(t, repo, "commit", "-am", "edit body")
+
+	entries, err := core.History("hist-1")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("History() returned %d entries, want 3: %+v", len(entries), entries)
+	}
+
+	// Newest first
+	if entries[0].Message != "edit body" || len(entries[0].Changes) != 1 || entries[0].Changes[0].Field != "body" {
+		t.Errorf("entries[0] = %+v, want body change", entries[0])
+	}
+	if want := " First line\n-Second line\n+Second line, edited"; entries[0].Changes[0].Diff != want {
+		t.Errorf("body diff = %q, want %q", entries[0].Changes[0].Diff, want)
+	}
+
+	start := entries[1]
+	if start.Message != "start work" || len(start.Changes) != 2 {
+		t.Fatalf("entries[1] = %+v, want status and tags changes", start)
+	}
+	if ch := start.Changes[0]; ch.Field != "status" || ch.From != "todo" || ch.To != "in-progress" {
+		t.Errorf("status change = %+v, want todo -> in-progress", ch)
+	}
+	if ch := start.Changes[1]; ch.Field != "tags" || !slices.Equal(ch.Added, []string{"backend"}) {
+		t.Errorf("tags change = %+v, want +backend", ch)
+	}
+
+	if !entries[2].Created || entries[2].Author != "Test" {
+		t.Errorf("entries[2] = %+v, want creating commit by Test", entries[2])
+	}
+}
+
+func TestHistoryWithoutGit(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "hist-2", "No git", "todo")
+
+	if _, err := core.History("hist-2"); !errors.Is(err, ErrNoHistory) {
+		t.Errorf("History() error = %v, want ErrNoHistory", err)
+	}
+	if _, err := core.History("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("History() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLineDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"added line", "a\nb", "a\nb\nc", " a\n b\n+c"},
+		{"from empty", "", "new", "+new"},
+		{"to empty", "old", "", "-old"},
+		{"collapses distant context", "1\n2\n3\n4\n5\n6\n7\n8", "1\n2\n3\n4\n5\n6\n7\nX", "...\n 6\n 7\n-8\n+X"},
+		{"separates hunks", "a\n1\n2\n3\n4\n5\n6\nb", "A\n1\n2\n3\n4\n5\n6\nB", "-a\n+A\n 1\n 2\n...\n 5\n 6\n-b\n+B"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lineDiff(tt.a, tt.b); got != tt.want {
+				t.Errorf("lineDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
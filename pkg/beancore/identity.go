@@ -0,0 +1,40 @@
+package beancore
+
+import (
+	"os"
+	"strings"
+
+	"github.com/hmans/beans/internal/gitutil"
+)
+
+// UserEnvVar is the environment variable that overrides the current user's identity.
+const UserEnvVar = "BEANS_USER"
+
+// CurrentUser returns the identity of the person (or agent) running beans:
+// the BEANS_USER environment variable if set, otherwise git's user.email for
+// the beans directory. Returns "" if neither is available.
+func (c *Core) CurrentUser() string {
+	if user := strings.TrimSpace(os.Getenv(UserEnvVar)); user != "" {
+		return user
+	}
+	if email, ok := gitutil.UserEmail(c.root); ok {
+		return email
+	}
+	return ""
+}
+
+// Assign adds user to the bean's assignees and saves it. Returns false without
+// writing anything if the user is already assigned.
+func (c *Core) Assign(id, user string) (bool, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return false, err
+	}
+	if !b.AddAssignee(user) {
+		return false, nil
+	}
+	if err := c.Update(b, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
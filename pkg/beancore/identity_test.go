@@ -0,0 +1,58 @@
+package beancore
+
+import "testing"
+
+func TestCurrentUserFromEnv(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	t.Setenv(UserEnvVar, "  agent-1 ")
+	if got := core.CurrentUser(); got != "agent-1" {
+		t.Errorf("CurrentUser() = %q, want \"agent-1\"", got)
+	}
+}
+
+func TestCurrentUserFromGit(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	gitInit(t, beansDir)
+
+	t.Setenv(UserEnvVar, "")
+	if got := core.CurrentUser(); got != "test@test.com" {
+		t.Errorf("CurrentUser() = %q, want git user.email", got)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "abc1", "Task", "todo")
+
+	assigned, err := core.Assign("abc1", "agent")
+	if err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if !assigned {
+		t.Error("Assign() = false, want true for a new assignee")
+	}
+
+	assigned, err = core.Assign("abc1", "AGENT")
+	if err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if assigned {
+		t.Error("Assign() = true, want false when already assigned")
+	}
+
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	b, err := core.Get("abc1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(b.Assignees) != 1 || b.Assignees[0] != "agent" {
+		t.Errorf("Assignees = %v, want [agent]", b.Assignees)
+	}
+
+	if _, err := core.Assign("missing", "agent"); err == nil {
+		t.Error("Assign() on missing bean should return an error")
+	}
+}
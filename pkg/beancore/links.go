@@ -2,9 +2,11 @@ package beancore
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
 )
 
 // IncomingLink represents a link from another bean to a target bean.
@@ -81,22 +83,32 @@ func (c *Core) FindIncomingLinks(targetID string) []IncomingLink {
 				})
 			}
 		}
+		// Check configured link types (related, duplicates, ...)
+		for _, linkType := range b.RelationTypes() {
+			if b.HasRelation(linkType, targetID) {
+				result = append(result, IncomingLink{
+					FromBean: b,
+					LinkType: linkType,
+				})
+			}
+		}
 	}
 	return result
 }
 
 // DetectCycle checks if adding a link from fromID to toID would create a cycle.
-// Checks for blocking, blocked_by, and parent link types.
+// Checks for blocking, blocked_by, and parent links and directed (non-symmetric)
+// configured link types such as supersedes.
 // Returns the cycle path if a cycle would be created, nil otherwise.
 func (c *Core) DetectCycle(fromID, linkType, toID string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	// Only check hierarchical link types
-	if linkType != "blocking" && linkType != "blocked_by" && linkType != "parent" {
+	if linkType != "blocking" && linkType != "blocked_by" && linkType != "parent" && !c.isDirectedLinkType(linkType) {
 		return nil
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
 	// Build adjacency list for the specific link type
 	// Adding edge: fromID -> toID
 	// Check if there's already a path from toID back to fromID
@@ -134,6 +146,8 @@ func (c *Core) findPathToTarget(current, target, linkType string, visited map[st
 		targets = b.Blocking
 	case "blocked_by":
 		targets = b.BlockedBy
+	default:
+		targets = b.Relations[linkType]
 	}
 
 	for _, t := range targets {
@@ -206,10 +220,35 @@ func (c *Core) CheckAllLinks() *LinkCheckResult {
 				})
 			}
 		}
+
+		// Check configured link types
+		for _, linkType := range b.RelationTypes() {
+			for _, target := range b.Relations[linkType] {
+				if target == b.ID {
+					result.SelfLinks = append(result.SelfLinks, SelfLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+					})
+				} else if _, ok := c.beans[target]; !ok {
+					result.BrokenLinks = append(result.BrokenLinks, BrokenLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+						Target:   target,
+					})
+				}
+			}
+		}
 	}
 
-	// Check for cycles in blocking, blocked_by, and parent links
-	for _, linkType := range []string{"blocking", "blocked_by", "parent"} {
+	// Check for cycles in blocking, blocked_by, and parent links, and in
+	// directed link types (a bean can't supersede a bean that supersedes it)
+	linkTypes := []string{"blocking", "blocked_by", "parent"}
+	for _, lt := range c.linkTypes() {
+		if !lt.Symmetric {
+			linkTypes = append(linkTypes, lt.Name)
+		}
+	}
+	for _, linkType := range linkTypes {
 		cycles := c.findCycles(linkType)
 		result.Cycles = append(result.Cycles, cycles...)
 	}
@@ -270,6 +309,8 @@ func (c *Core) findCycles(linkType string) []Cycle {
 				targets = b.Blocking
 			case "blocked_by":
 				targets = b.BlockedBy
+			default:
+				targets = b.Relations[linkType]
 			}
 
 			for _, target := range targets {
@@ -357,10 +398,19 @@ func (c *Core) RemoveLinksTo(targetID string) (int, error) {
 			removed += originalBlockedByLen - len(b.BlockedBy)
 		}
 
+		// Remove configured links
+		for _, linkType := range b.RelationTypes() {
+			if b.RemoveRelation(linkType, targetID) {
+				changed = true
+				removed++
+			}
+		}
+
 		if changed {
 			if err := c.saveToDisk(b); err != nil {
 				return removed, err
 			}
+			c.indexLinksLocked(b)
 		}
 	}
 
@@ -431,31 +481,36 @@ func (c *Core) FixBrokenLinks() (int, error) {
 			fixed += originalBlockedByLen - len(newBlockedBy)
 		}
 
+		// Fix configured links
+		for _, linkType := range b.RelationTypes() {
+			for _, target := range slices.Clone(b.Relations[linkType]) {
+				if _, ok := c.beans[target]; (target == b.ID || !ok) && b.RemoveRelation(linkType, target) {
+					changed = true
+					fixed++
+				}
+			}
+		}
+
 		if changed {
 			if err := c.saveToDisk(b); err != nil {
 				return fixed, err
 			}
+			c.indexLinksLocked(b)
 		}
 	}
 
 	return fixed, nil
 }
 
-// ValidParentTypes returns the valid parent types for a given bean type.
+// ValidParentTypes returns the valid parent types for a given bean type,
+// according to the configured parent rules.
 // Returns nil if the bean type cannot have a parent.
-func ValidParentTypes(beanType string) []string {
-	switch beanType {
-	case "milestone":
-		return nil // milestones cannot have parents
-	case "epic":
-		return []string{"milestone"}
-	case "feature":
-		return []string{"milestone", "epic"}
-	case "task", "bug":
-		return []string{"milestone", "epic", "feature"}
-	default:
-		return []string{"milestone", "epic", "feature"} // default for unknown types
+func (c *Core) ValidParentTypes(beanType string) []string {
+	cfg := c.config
+	if cfg == nil {
+		cfg = config.Default()
 	}
+	return cfg.ValidParentTypes(beanType)
 }
 
 // ValidateParent checks if a parent is valid for the given bean.
@@ -465,7 +520,7 @@ func (c *Core) ValidateParent(b *bean.Bean, parentID string) error {
 		return nil
 	}
 
-	validTypes := ValidParentTypes(b.Type)
+	validTypes := c.ValidParentTypes(b.Type)
 	if validTypes == nil {
 		return fmt.Errorf("%s beans cannot have a parent", b.Type)
 	}
@@ -500,9 +555,21 @@ func joinWithOr(items []string) string {
 }
 
 // isResolvedStatus returns true if the status means the bean is "done"
-// (either completed or scrapped).
-func isResolvedStatus(status string) bool {
-	return status == "completed" || status == "scrapped"
+// (any archive status, by default completed or scrapped).
+func (c *Core) isResolvedStatus(status string) bool {
+	if c.config == nil {
+		return status == "completed" || status == "scrapped"
+	}
+	return c.config.IsArchiveStatus(status)
+}
+
+// isCompletedStatus returns true if the status is an archive status for
+// completed (not cancelled) work.
+func (c *Core) isCompletedStatus(status string) bool {
+	if c.config == nil {
+		return status == "completed"
+	}
+	return c.config.IsCompletedStatus(status)
 }
 
 // IsBlocked returns true if the bean is blocked, either explicitly (direct
@@ -581,7 +648,7 @@ func (c *Core) findActiveBlockersLocked(beanID string, seen map[string]bool) []*
 	// Check direct blocked_by field
 	for _, blockerID := range b.BlockedBy {
 		if blocker, ok := c.beans[blockerID]; ok {
-			if !isResolvedStatus(blocker.Status) && !seen[blockerID] {
+			if !c.isResolvedStatus(blocker.Status) && !seen[blockerID] {
 				seen[blockerID] = true
 				blockers = append(blockers, blocker)
 			}
@@ -591,7 +658,7 @@ func (c *Core) findActiveBlockersLocked(beanID string, seen map[string]bool) []*
 	// Check incoming blocking links (other beans that have this bean in their Blocking list)
 	for _, other := range c.beans {
 		for _, blocked := range other.Blocking {
-			if blocked == beanID && !isResolvedStatus(other.Status) && !seen[other.ID] {
+			if blocked == beanID && !c.isResolvedStatus(other.Status) && !seen[other.ID] {
 				seen[other.ID] = true
 				blockers = append(blockers, other)
 			}
@@ -643,7 +710,7 @@ func (c *Core) implicitStatusLocked(beanID string) (status, fromID string) {
 
 	var result struct{ status, fromID string }
 	c.walkParentChain(b.Parent, func(ancestor *bean.Bean) {
-		if result.status == "" && isResolvedStatus(ancestor.Status) {
+		if result.status == "" && c.isResolvedStatus(ancestor.Status) {
 			result.status = ancestor.Status
 			result.fromID = ancestor.ID
 		}
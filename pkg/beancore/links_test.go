@@ -1,9 +1,11 @@
 package beancore
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
 )
 
 func TestFindIncomingLinks(t *testing.T) {
@@ -832,6 +834,8 @@ func TestImplicitBlocking(t *testing.T) {
 }
 
 func TestIsResolvedStatus(t *testing.T) {
+	core, _ := setupTestCore(t)
+
 	tests := []struct {
 		status string
 		want   bool
@@ -846,10 +850,232 @@ func TestIsResolvedStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.status, func(t *testing.T) {
-			got := isResolvedStatus(tt.status)
+			got := core.isResolvedStatus(tt.status)
 			if got != tt.want {
 				t.Errorf("isResolvedStatus(%q) = %v, want %v", tt.status, got, tt.want)
 			}
 		})
 	}
 }
+
+func TestIsResolvedStatusCustom(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.Config().Statuses = []config.StatusConfig{
+		{Name: "open", Color: "green"},
+		{Name: "shipped", Color: "gray", Archive: true},
+	}
+
+	if !core.isResolvedStatus("shipped") {
+		t.Error("isResolvedStatus(\"shipped\") = false, want true")
+	}
+	if core.isResolvedStatus("completed") {
+		t.Error("isResolvedStatus(\"completed\") = true, want false (not a configured status)")
+	}
+}
+
+func TestValidateParentCustomRules(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.Config().Types = []config.TypeConfig{
+		{Name: "initiative", NoParent: true},
+		{Name: "spike", Parents: []string{"initiative"}},
+		{Name: "chore"},
+	}
+
+	initiative := &bean.Bean{ID: "init", Title: "Initiative", Status: "todo", Type: "initiative"}
+	chore := &bean.Bean{ID: "chor", Title: "Chore", Status: "todo", Type: "chore"}
+	for _, b := range []*bean.Bean{initiative, chore} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	spike := &bean.Bean{ID: "spik", Type: "spike"}
+	if err := core.ValidateParent(spike, "init"); err != nil {
+		t.Errorf("ValidateParent(spike, initiative) error = %v, want nil", err)
+	}
+	if err := core.ValidateParent(spike, "chor"); err == nil {
+		t.Error("ValidateParent(spike, chore) expected error")
+	}
+	if err := core.ValidateParent(&bean.Bean{ID: "i2", Type: "initiative"}, "chor"); err == nil {
+		t.Error("ValidateParent(initiative, ...) expected error for no_parent type")
+	}
+	if err := core.ValidateParent(&bean.Bean{ID: "c2", Type: "chore"}, "init"); err != nil {
+		t.Errorf("ValidateParent(chore, initiative) error = %v, want nil (no rules means any type)", err)
+	}
+}
+
+func TestLinks(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	// A related B (stored on A), C duplicates A, A supersedes D, A has an
+	// unconfigured "mentions" link to B
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "A", Status: "todo", Relations: map[string][]string{
+			"related":    {"bbb2"},
+			"supersedes": {"ddd4"},
+			"mentions":   {"bbb2"},
+		}},
+		{ID: "bbb2", Title: "B", Status: "todo", Relations: map[string][]string{"related": {"aaa1"}}},
+		{ID: "ccc3", Title: "C", Status: "scrapped", Relations: map[string][]string{"duplicates": {"aaa1"}}},
+		{ID: "ddd4", Title: "D", Status: "todo"},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create error: %v", err)
+		}
+	}
+
+	linkStrings := func(links []Link) []string {
+		var s []string
+		for _, l := range links {
+			s = append(s, l.Type+":"+l.Bean.ID)
+		}
+		return s
+	}
+
+	t.Run("both directions", func(t *testing.T) {
+		got := linkStrings(core.Links("aaa1"))
+		want := []string{"related:bbb2", "supersedes:ddd4", "mentions:bbb2", "duplicated_by:ccc3"}
+		if !slices.Equal(got, want) {
+			t.Errorf("Links(aaa1) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("inverse names", func(t *testing.T) {
+		if got := linkStrings(core.Links("ddd4")); !slices.Equal(got, []string{"superseded_by:aaa1"}) {
+			t.Errorf("Links(ddd4) = %v", got)
+		}
+		if got := core.Linked("ccc3", "duplicates"); len(got) != 1 || got[0].ID != "aaa1" {
+			t.Errorf("Linked(ccc3, duplicates) = %v", got)
+		}
+	})
+
+	t.Run("link names match Links", func(t *testing.T) {
+		names := core.LinkNames()
+		for _, b := range beans {
+			var fromLinks []string
+			for _, l := range core.Links(b.ID) {
+				if !slices.Contains(fromLinks, l.Type) {
+					fromLinks = append(fromLinks, l.Type)
+				}
+			}
+			var got []string
+			for name := range names[b.ID] {
+				got = append(got, name)
+			}
+			slices.Sort(fromLinks)
+			slices.Sort(got)
+			if !slices.Equal(got, fromLinks) {
+				t.Errorf("LinkNames()[%s] = %v, want %v", b.ID, got, fromLinks)
+			}
+		}
+	})
+
+	t.Run("symmetric links stored on both beans are listed once", func(t *testing.T) {
+		if got := linkStrings(core.Links("bbb2")); !slices.Equal(got, []string{"related:aaa1"}) {
+			t.Errorf("Links(bbb2) = %v", got)
+		}
+	})
+
+	t.Run("incoming links", func(t *testing.T) {
+		links := core.FindIncomingLinks("aaa1")
+		var types []string
+		for _, l := range links {
+			types = append(types, l.FromBean.ID+":"+l.LinkType)
+		}
+		slices.Sort(types)
+		if want := []string{"bbb2:related", "ccc3:duplicates"}; !slices.Equal(types, want) {
+			t.Errorf("FindIncomingLinks(aaa1) = %v, want %v", types, want)
+		}
+	})
+
+	t.Run("incoming links follow writes", func(t *testing.T) {
+		// D starts duplicating A, changed in place as callers of Get do
+		d, _ := core.Get("ddd4")
+		d.Relations = map[string][]string{"duplicates": {"aaa1"}}
+		if err := core.Update(d, nil); err != nil {
+			t.Fatalf("Update error: %v", err)
+		}
+		// C's link is dropped
+		c, _ := core.Get("ccc3")
+		c.Relations = nil
+		if err := core.Update(c, nil); err != nil {
+			t.Fatalf("Update error: %v", err)
+		}
+		want := []string{"related:bbb2", "supersedes:ddd4", "mentions:bbb2", "duplicated_by:ddd4"}
+		if got := linkStrings(core.Links("aaa1")); !slices.Equal(got, want) {
+			t.Errorf("Links(aaa1) after update = %v, want %v", got, want)
+		}
+
+		if err := core.Load(); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if got := linkStrings(core.Links("aaa1")); !slices.Equal(got, want) {
+			t.Errorf("Links(aaa1) after reload = %v, want %v", got, want)
+		}
+
+		if err := core.Delete("ddd4"); err != nil {
+			t.Fatalf("Delete error: %v", err)
+		}
+		want = []string{"related:bbb2", "mentions:bbb2"}
+		if got := linkStrings(core.Links("aaa1")); !slices.Equal(got, want) {
+			t.Errorf("Links(aaa1) after delete = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestCheckAndFixRelations(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "A", Status: "todo", Relations: map[string][]string{
+			"related":    {"missing", "aaa1"},
+			"supersedes": {"bbb2"},
+		}},
+		{ID: "bbb2", Title: "B", Status: "todo", Relations: map[string][]string{"supersedes": {"aaa1"}}},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create error: %v", err)
+		}
+	}
+
+	result := core.CheckAllLinks()
+	if len(result.BrokenLinks) != 1 || result.BrokenLinks[0].LinkType != "related" || result.BrokenLinks[0].Target != "missing" {
+		t.Errorf("BrokenLinks = %+v", result.BrokenLinks)
+	}
+	if len(result.SelfLinks) != 1 || result.SelfLinks[0].LinkType != "related" {
+		t.Errorf("SelfLinks = %+v", result.SelfLinks)
+	}
+	if len(result.Cycles) != 1 || result.Cycles[0].LinkType != "supersedes" {
+		t.Errorf("Cycles = %+v, want one supersedes cycle", result.Cycles)
+	}
+
+	if cycle := core.DetectCycle("ccc3", "supersedes", "aaa1"); cycle != nil {
+		t.Errorf("DetectCycle(new bean) = %v, want nil", cycle)
+	}
+	if cycle := core.DetectCycle("aaa1", "related", "bbb2"); cycle != nil {
+		t.Errorf("DetectCycle(related) = %v, want nil for symmetric links", cycle)
+	}
+
+	fixed, err := core.FixBrokenLinks()
+	if err != nil {
+		t.Fatalf("FixBrokenLinks error: %v", err)
+	}
+	if fixed != 2 {
+		t.Errorf("fixed = %d, want 2", fixed)
+	}
+	a, _ := core.Get("aaa1")
+	if _, ok := a.Relations["related"]; ok {
+		t.Errorf("related links should be removed, got %v", a.Relations)
+	}
+
+	removed, err := core.RemoveLinksTo("aaa1")
+	if err != nil {
+		t.Fatalf("RemoveLinksTo error: %v", err)
+	}
+	b, _ := core.Get("bbb2")
+	if removed != 1 || b.Relations != nil {
+		t.Errorf("RemoveLinksTo(aaa1) = %d, relations = %v", removed, b.Relations)
+	}
+}
@@ -0,0 +1,84 @@
+package beancore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// QuarantineDir is the directory inside .beans that unparseable bean files are
+// moved to by Quarantine. Like other dot-prefixed directories, it is never loaded.
+const QuarantineDir = ".quarantine"
+
+// LoadError describes a bean file that could not be loaded (e.g. because a bad
+// merge left its front matter broken). Such files are skipped; all other beans
+// remain usable.
+type LoadError struct {
+	Path  string `json:"path"` // relative to the .beans directory
+	Error string `json:"error"`
+}
+
+// LoadErrors returns the bean files that failed to load, sorted by path.
+func (c *Core) LoadErrors() []LoadError {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	errs := make([]LoadError, 0, len(c.loadErrors))
+	for path, msg := range c.loadErrors {
+		errs = append(errs, LoadError{Path: path, Error: msg})
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Path < errs[j].Path
+	})
+	return errs
+}
+
+// setLoadErrorLocked records that the bean file at path (absolute) failed to load.
+// Must be called with lock held.
+func (c *Core) setLoadErrorLocked(path string, err error) {
+	if rel, relErr := filepath.Rel(c.root, path); relErr == nil {
+		path = rel
+	}
+	c.loadErrors[path] = err.Error()
+}
+
+// clearLoadErrorLocked forgets a load error for the bean file at path (absolute),
+// e.g. after it was fixed or removed. Must be called with lock held.
+func (c *Core) clearLoadErrorLocked(path string) {
+	if rel, err := filepath.Rel(c.root, path); err == nil {
+		path = rel
+	}
+	delete(c.loadErrors, path)
+}
+
+// Quarantine moves a bean file that failed to load (path as reported by
+// LoadErrors) into QuarantineDir, keeping its relative path, so that it can be
+// repaired by hand. Returns the new path relative to the .beans directory.
+func (c *Core) Quarantine(path string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.loadErrors[path]; !ok {
+		return "", fmt.Errorf("%s is not a bean file that failed to load", path)
+	}
+
+	// Don't overwrite an earlier quarantined copy of the same file
+	dest := filepath.Join(QuarantineDir, path)
+	base := strings.TrimSuffix(dest, ".md")
+	for i := 1; c.fileExists(filepath.Join(c.root, dest)); i++ {
+		dest = fmt.Sprintf("%s-%d.md", base, i)
+	}
+
+	destPath := filepath.Join(c.root, dest)
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return "", fmt.Errorf("creating quarantine directory: %w", err)
+	}
+	if err := os.Rename(filepath.Join(c.root, path), destPath); err != nil {
+		return "", fmt.Errorf("moving %s to quarantine: %w", path, err)
+	}
+
+	delete(c.loadErrors, path)
+	return dest, nil
+}
@@ -0,0 +1,88 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const brokenBean = `---
+title: [unclosed bracket
+status: todo
+---
+`
+
+func TestLoadSkipsUnparseableFiles(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "good", "Good Bean", "todo")
+
+	if err := os.WriteFile(filepath.Join(beansDir, "bad1--broken.md"), []byte(brokenBean), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v, want broken files to be skipped", err)
+	}
+
+	if _, err := core.Get("good"); err != nil {
+		t.Errorf("Get(good) error = %v", err)
+	}
+	errs := core.LoadErrors()
+	if len(errs) != 1 || errs[0].Path != "bad1--broken.md" || errs[0].Error == "" {
+		t.Fatalf("LoadErrors() = %+v, want one error for bad1--broken.md", errs)
+	}
+
+	// Fixing the file and reloading clears the error
+	if err := os.WriteFile(filepath.Join(beansDir, "bad1--broken.md"), []byte("---\ntitle: Fixed\n---\n"), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if errs := core.LoadErrors(); len(errs) != 0 {
+		t.Errorf("LoadErrors() = %+v after fix, want none", errs)
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	for _, name := range []string{"bad1--broken.md", filepath.Join(QuarantineDir, "bad1--broken.md")} {
+		path := filepath.Join(beansDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(brokenBean), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// Files in the quarantine directory are never loaded
+	if errs := core.LoadErrors(); len(errs) != 1 {
+		t.Fatalf("LoadErrors() = %+v, want only the file outside quarantine", errs)
+	}
+
+	dest, err := core.Quarantine("bad1--broken.md")
+	if err != nil {
+		t.Fatalf("Quarantine() error = %v", err)
+	}
+	// An earlier quarantined copy is not overwritten
+	if want := filepath.Join(QuarantineDir, "bad1--broken-1.md"); dest != want {
+		t.Errorf("Quarantine() = %q, want %q", dest, want)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, dest)); err != nil {
+		t.Errorf("quarantined file missing: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, "bad1--broken.md")); !os.IsNotExist(err) {
+		t.Error("original file should have been moved")
+	}
+	if errs := core.LoadErrors(); len(errs) != 0 {
+		t.Errorf("LoadErrors() = %+v after quarantine, want none", errs)
+	}
+
+	if _, err := core.Quarantine("good--bean.md"); err == nil {
+		t.Error("Quarantine() of a file without a load error should fail")
+	}
+}
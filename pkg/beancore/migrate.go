@@ -0,0 +1,256 @@
+package beancore
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Migration upgrades the beans data from the previous format version to Version.
+type Migration struct {
+	Version     int
+	Description string
+	// Apply migrates the beans directory at root, or with dryRun only reports
+	// what it would do. It returns a description of each change.
+	Apply func(root string, dryRun bool) ([]string, error)
+}
+
+// migrations is the registry of data format migrations, in version order. The
+// last migration's version must equal config.FormatVersion.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "Rename worktrees/ and conversations/ to .worktrees/ and .conversations/",
+		Apply:       migrateLegacyDirs,
+	},
+	{
+		Version:     2,
+		Description: "Rename bean files from the id.slug.md and id-slug.md formats to id--slug.md",
+		Apply:       migrateLegacyFilenames,
+	},
+}
+
+// legacyDirs are directories that data format version 1 moved to dot-prefixed names.
+var legacyDirs = []string{"worktrees", "conversations"}
+
+// FormatVersionError is returned when the beans data uses a newer format
+// version than this version of beans understands.
+type FormatVersionError struct {
+	Version   int
+	Supported int
+}
+
+func (e *FormatVersionError) Error() string {
+	return fmt.Sprintf("beans data format version %d is newer than this version of beans supports (%d); please upgrade beans", e.Version, e.Supported)
+}
+
+// MigrationResult reports a migration that was (or, in a dry run, would be) applied.
+type MigrationResult struct {
+	Version     int      `json:"version"`
+	Description string   `json:"description"`
+	Changes     []string `json:"changes"`
+}
+
+// FormatVersion returns the format version of the beans data.
+func (c *Core) FormatVersion() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.config.Beans.FormatVersion
+}
+
+// PendingMigrations returns the migrations needed to bring the beans data up
+// to the current format version.
+func (c *Core) PendingMigrations() []Migration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return pendingMigrations(c.config.Beans.FormatVersion)
+}
+
+func pendingMigrations(version int) []Migration {
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > version {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// checkFormatVersion returns a FormatVersionError if the data is newer than
+// this version of beans understands.
+func (c *Core) checkFormatVersion() error {
+	if v := c.config.Beans.FormatVersion; v > config.FormatVersion {
+		return &FormatVersionError{Version: v, Supported: config.FormatVersion}
+	}
+	return nil
+}
+
+// Migrate applies all pending migrations in order, recording the format
+// version in the config file after each one, and reloads the beans. With
+// dryRun, nothing is changed and the results describe what would be done.
+func (c *Core) Migrate(dryRun bool) ([]MigrationResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.checkFormatVersion(); err != nil {
+		return nil, err
+	}
+
+	results := []MigrationResult{}
+	for _, m := range pendingMigrations(c.config.Beans.FormatVersion) {
+		changes, err := m.Apply(c.root, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("migrating to format version %d: %w", m.Version, err)
+		}
+		results = append(results, MigrationResult{Version: m.Version, Description: m.Description, Changes: changes})
+		if dryRun {
+			continue
+		}
+		if !c.config.FromFile() {
+			// Nothing to record it in; Load detects it from the data
+			c.config.Beans.FormatVersion = m.Version
+			continue
+		}
+		if err := c.config.SaveFormatVersion(m.Version); err != nil {
+			return results, fmt.Errorf("recording format version %d: %w", m.Version, err)
+		}
+	}
+
+	if !dryRun && len(results) > 0 {
+		if err := c.loadFromDisk(); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// detectFormatVersion tells the format version of the beans directory at root
+// from its contents, for projects without a config file to record it in: it
+// is the version before the first migration that would change anything.
+// Migrations that would only skip things (because both the old and the new
+// name exist) don't count, since migrating can't resolve those.
+func detectFormatVersion(root string) (int, error) {
+	for _, m := range migrations {
+		changes, err := m.Apply(root, true)
+		if err != nil {
+			return 0, fmt.Errorf("checking format version: %w", err)
+		}
+		for _, change := range changes {
+			if !strings.HasPrefix(change, "skipped ") {
+				return m.Version - 1, nil
+			}
+		}
+	}
+	return config.FormatVersion, nil
+}
+
+// migrateLegacyDirs renames old-style directories (worktrees/, conversations/)
+// to their dot-prefixed equivalents (.worktrees/, .conversations/).
+func migrateLegacyDirs(root string, dryRun bool) ([]string, error) {
+	var changes []string
+	for _, name := range legacyDirs {
+		oldPath := filepath.Join(root, name)
+		newPath := filepath.Join(root, "."+name)
+		if _, err := os.Stat(oldPath); err != nil {
+			continue
+		}
+		if _, err := os.Stat(newPath); err == nil {
+			changes = append(changes, fmt.Sprintf("skipped %s/: .%s/ already exists", name, name))
+			continue
+		}
+		if !dryRun {
+			if err := os.Rename(oldPath, newPath); err != nil {
+				return changes, err
+			}
+		}
+		changes = append(changes, fmt.Sprintf("renamed %s/ to .%s/", name, name))
+	}
+	return changes, nil
+}
+
+// migrateLegacyFilenames renames bean files in the dot (id.slug.md) and
+// single-dash (id-slug.md) formats to the current id--slug.md format.
+func migrateLegacyFilenames(root string, dryRun bool) ([]string, error) {
+	var changes []string
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && isNonBeanDir(root, path) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+
+		name, err := canonicalFilename(path)
+		if err != nil || name == "" || name == d.Name() {
+			return nil
+		}
+		rel, _ := filepath.Rel(root, path)
+		newPath := filepath.Join(filepath.Dir(path), name)
+		newRel, _ := filepath.Rel(root, newPath)
+		if _, err := os.Stat(newPath); err == nil {
+			changes = append(changes, fmt.Sprintf("skipped %s: %s already exists", rel, newRel))
+			return nil
+		}
+		if !dryRun {
+			if err := os.Rename(path, newPath); err != nil {
+				return err
+			}
+		}
+		changes = append(changes, fmt.Sprintf("renamed %s to %s", rel, newRel))
+		return nil
+	})
+	return changes, err
+}
+
+// canonicalFilename returns the id--slug.md filename for a bean file, or ""
+// if it can't be determined.
+//
+// A single-dash name is either a legacy id-slug.md or an id-only name whose ID
+// contains a dash (e.g. "myproj-abc1.md"), so those are only renamed when the
+// "# <id>" line at the top of the front matter says where the ID ends.
+func canonicalFilename(path string) (string, error) {
+	base := strings.TrimSuffix(filepath.Base(path), ".md")
+	if strings.Contains(base, "--") {
+		return "", nil
+	}
+	if strings.Contains(base, ".") {
+		return bean.BuildFilename(bean.ParseFilename(base)), nil
+	}
+
+	id, err := frontMatterID(path)
+	if err != nil || id == "" || id == base || !strings.HasPrefix(base, id+"-") {
+		return "", err
+	}
+	return bean.BuildFilename(id, strings.TrimPrefix(base, id+"-")), nil
+}
+
+// frontMatterID reads the ID from the "# <id>" comment that beans writes as
+// the first front matter line, or returns "" if there is none.
+func frontMatterID(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "---" || !scanner.Scan() {
+		return "", nil
+	}
+	line := strings.TrimSpace(scanner.Text())
+	if !strings.HasPrefix(line, "# ") {
+		return "", nil
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, "# ")), nil
+}
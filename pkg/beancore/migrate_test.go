@@ -0,0 +1,205 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/config"
+)
+
+// setupLegacyProject creates a project with a .beans.yml that predates format
+// versioning, and returns a core for it (not yet loaded).
+func setupLegacyProject(t *testing.T) (*Core, string) {
+	t.Helper()
+	projectDir := t.TempDir()
+	beansDir := filepath.Join(projectDir, BeansDir)
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	configPath := filepath.Join(projectDir, config.ConfigFileName)
+	if err := os.WriteFile(configPath, []byte("# My project\nbeans:\n    prefix: \"\"\n    id_length: 4\n"), 0644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("config.Load: %v", err)
+	}
+	core := New(beansDir, cfg)
+	core.SetWarnWriter(nil)
+	return core, beansDir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func TestMigrationsMatchFormatVersion(t *testing.T) {
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("migrations[%d].Version = %d, want %d", i, m.Version, i+1)
+		}
+	}
+	if last := migrations[len(migrations)-1].Version; last != config.FormatVersion {
+		t.Errorf("last migration version = %d, want config.FormatVersion (%d)", last, config.FormatVersion)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	core, beansDir := setupLegacyProject(t)
+
+	writeFile(t, filepath.Join(beansDir, "worktrees", "branch", "README.md"), "---\ntitle: Not a bean\nstatus: todo\n---\n")
+	writeFile(t, filepath.Join(beansDir, "abc1.dot-format.md"), "---\ntitle: Dot format\nstatus: todo\n---\n")
+	writeFile(t, filepath.Join(beansDir, "proj-def2-dash-format.md"), "---\n# proj-def2\ntitle: Dash format\nstatus: todo\n---\n")
+	writeFile(t, filepath.Join(beansDir, "proj-ghi3.md"), "---\n# proj-ghi3\ntitle: ID only\nstatus: todo\n---\n")
+	writeFile(t, filepath.Join(beansDir, "jkl4--current.md"), "---\n# jkl4\ntitle: Current\nstatus: todo\n---\n")
+
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := len(core.PendingMigrations()); got != len(migrations) {
+		t.Fatalf("PendingMigrations() = %d, want %d", got, len(migrations))
+	}
+	// The legacy worktrees/ directory isn't loaded before it is migrated
+	if _, err := core.Get("README"); err == nil {
+		t.Error("expected legacy worktrees/ directory to be skipped")
+	}
+
+	// A dry run reports the changes without making them
+	results, err := core.Migrate(true)
+	if err != nil {
+		t.Fatalf("Migrate(dry run) error = %v", err)
+	}
+	if len(results) != 2 || len(results[0].Changes) != 1 || len(results[1].Changes) != 2 {
+		t.Fatalf("Migrate(dry run) = %+v", results)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, "abc1.dot-format.md")); err != nil {
+		t.Errorf("dry run renamed a file: %v", err)
+	}
+	if core.FormatVersion() != 0 {
+		t.Errorf("dry run recorded format version %d", core.FormatVersion())
+	}
+
+	if _, err := core.Migrate(false); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	for _, name := range []string{".worktrees/branch/README.md", "abc1--dot-format.md", "proj-def2--dash-format.md", "proj-ghi3.md", "jkl4--current.md"} {
+		if _, err := os.Stat(filepath.Join(beansDir, name)); err != nil {
+			t.Errorf("expected %s after migration: %v", name, err)
+		}
+	}
+	if b, err := core.Get("proj-def2"); err != nil || b.Slug != "dash-format" {
+		t.Errorf("Get(proj-def2) = %+v, %v; want slug dash-format", b, err)
+	}
+	if core.FormatVersion() != config.FormatVersion || len(core.PendingMigrations()) != 0 {
+		t.Errorf("FormatVersion() = %d, want %d with no pending migrations", core.FormatVersion(), config.FormatVersion)
+	}
+
+	// The version is recorded in the config file, which otherwise stays as it was
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(beansDir), config.ConfigFileName))
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	if !strings.Contains(string(data), "# My project") || !strings.Contains(string(data), "format_version: 2") {
+		t.Errorf("config after migration:\n%s", data)
+	}
+
+	// Nothing left to do
+	results, err = core.Migrate(false)
+	if err != nil || len(results) != 0 {
+		t.Errorf("second Migrate() = %v, %v; want no migrations", results, err)
+	}
+}
+
+func TestLoad_NewerFormatVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, config.ConfigFileName)
+	writeFile(t, configPath, fmt.Sprintf("beans:\n    format_version: %d\n", config.FormatVersion+1))
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("config.Load: %v", err)
+	}
+	core := New(tmpDir, cfg)
+
+	err = core.Load()
+	var versionErr *FormatVersionError
+	if !errors.As(err, &versionErr) {
+		t.Fatalf("Load() error = %v, want FormatVersionError", err)
+	}
+	if versionErr.Version != config.FormatVersion+1 || versionErr.Supported != config.FormatVersion {
+		t.Errorf("FormatVersionError = %+v", versionErr)
+	}
+	if !strings.Contains(err.Error(), "upgrade beans") {
+		t.Errorf("Error() = %q, want upgrade hint", err.Error())
+	}
+}
+
+func TestLoad_DetectsFormatVersionWithoutConfig(t *testing.T) {
+	// newCore returns a core for beansDir without a config file, as with
+	// --beans-path outside a project
+	newCore := func(beansDir string) *Core {
+		core := New(beansDir, config.Default())
+		core.SetWarnWriter(nil)
+		if err := core.Load(); err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		return core
+	}
+
+	t.Run("current data", func(t *testing.T) {
+		beansDir := t.TempDir()
+		writeFile(t, filepath.Join(beansDir, "abc1--current.md"), "---\ntitle: Current\nstatus: todo\n---\n")
+		writeFile(t, filepath.Join(beansDir, ".worktrees", "x.md"), "")
+		if got := newCore(beansDir).FormatVersion(); got != config.FormatVersion {
+			t.Errorf("FormatVersion() = %d, want %d", got, config.FormatVersion)
+		}
+	})
+
+	t.Run("legacy filenames", func(t *testing.T) {
+		beansDir := t.TempDir()
+		writeFile(t, filepath.Join(beansDir, "abc1.dot-format.md"), "---\ntitle: Dot format\nstatus: todo\n---\n")
+		if got := newCore(beansDir).FormatVersion(); got != 1 {
+			t.Errorf("FormatVersion() = %d, want 1", got)
+		}
+	})
+
+	t.Run("legacy directories are migrated", func(t *testing.T) {
+		beansDir := t.TempDir()
+		writeFile(t, filepath.Join(beansDir, "worktrees", "branch", "README.md"), "---\ntitle: Not a bean\nstatus: todo\n---\n")
+		core := newCore(beansDir)
+		if got := len(core.PendingMigrations()); got != len(migrations) {
+			t.Fatalf("PendingMigrations() = %d, want %d", got, len(migrations))
+		}
+		if _, err := core.Get("README"); err == nil {
+			t.Error("expected legacy worktrees/ directory to be skipped")
+		}
+
+		if _, err := core.Migrate(false); err != nil {
+			t.Fatalf("Migrate() error = %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(beansDir, ".worktrees", "branch", "README.md")); err != nil {
+			t.Errorf("expected .worktrees/ after migration: %v", err)
+		}
+		if got := newCore(beansDir).FormatVersion(); got != config.FormatVersion {
+			t.Errorf("FormatVersion() after migration = %d, want %d", got, config.FormatVersion)
+		}
+	})
+
+	t.Run("conflicting directories don't count", func(t *testing.T) {
+		beansDir := t.TempDir()
+		writeFile(t, filepath.Join(beansDir, "worktrees", "a.txt"), "")
+		writeFile(t, filepath.Join(beansDir, ".worktrees", "b.txt"), "")
+		if got := newCore(beansDir).FormatVersion(); got != config.FormatVersion {
+			t.Errorf("FormatVersion() = %d, want %d", got, config.FormatVersion)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package beancore
+
+import "github.com/hmans/beans/pkg/bean"
+
+// Progress summarizes how far along a bean is, rolled up recursively from its
+// descendants (children, grandchildren, and so on).
+type Progress struct {
+	// TotalChildren is the number of descendants.
+	TotalChildren int `json:"total_children"`
+	// CompletedChildren is the number of descendants with a resolved (archive) status.
+	CompletedChildren int `json:"completed_children"`
+	// TotalEstimate is the summed estimate of the bean's leaf descendants, or
+	// the bean's own estimate if it has no children.
+	TotalEstimate float64 `json:"total_estimate"`
+	// RemainingEstimate is the part of TotalEstimate that isn't resolved yet.
+	RemainingEstimate float64 `json:"remaining_estimate"`
+	// Progress is the completed fraction from 0 to 1: by estimate if any
+	// estimates are set, otherwise by number of descendants.
+	Progress float64 `json:"progress"`
+}
+
+// Progress returns the rolled-up progress of a bean.
+func (c *Core) Progress(beanID string) (Progress, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if _, ok := c.beans[beanID]; !ok {
+		return Progress{}, ErrNotFound
+	}
+	return c.progressLocked()[beanID], nil
+}
+
+// AllProgress returns the rolled-up progress of every bean, keyed by ID.
+func (c *Core) AllProgress() map[string]Progress {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.progressLocked()
+}
+
+// progressLocked computes progress for all beans by walking each bean's parent
+// chain and adding it to every ancestor. Estimates are only taken from leaf
+// beans so that a parent's own estimate isn't counted twice once the work is
+// broken down. Must be called with c.mu held (at least for reading).
+func (c *Core) progressLocked() map[string]Progress {
+	hasChildren := make(map[string]bool)
+	for _, b := range c.beans {
+		if _, ok := c.beans[b.Parent]; ok {
+			hasChildren[b.Parent] = true
+		}
+	}
+
+	totals := make(map[string]*Progress, len(c.beans))
+	for id := range c.beans {
+		totals[id] = &Progress{}
+	}
+
+	for _, b := range c.beans {
+		resolved := c.isResolvedStatus(b.Status)
+		isLeaf := !hasChildren[b.ID]
+
+		if isLeaf {
+			p := totals[b.ID]
+			p.TotalEstimate = b.Estimate
+			if !resolved {
+				p.RemainingEstimate = b.Estimate
+			}
+		}
+
+		c.walkParentChain(b.Parent, func(ancestor *bean.Bean) {
+			if ancestor.ID == b.ID {
+				return // parent cycle leading back to the bean itself
+			}
+			p := totals[ancestor.ID]
+			p.TotalChildren++
+			if resolved {
+				p.CompletedChildren++
+			}
+			if isLeaf {
+				p.TotalEstimate += b.Estimate
+				if !resolved {
+					p.RemainingEstimate += b.Estimate
+				}
+			}
+		})
+	}
+
+	result := make(map[string]Progress, len(totals))
+	for id, p := range totals {
+		switch {
+		case p.TotalEstimate > 0:
+			p.Progress = (p.TotalEstimate - p.RemainingEstimate) / p.TotalEstimate
+		case p.TotalChildren > 0:
+			p.Progress = float64(p.CompletedChildren) / float64(p.TotalChildren)
+		case c.isResolvedStatus(c.beans[id].Status):
+			p.Progress = 1
+		}
+		result[id] = *p
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package beancore
+
+import (
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestProgress(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	create := func(id, status, parent string, estimate float64) {
+		t.Helper()
+		b := &bean.Bean{ID: id, Slug: id, Title: id, Status: status, Parent: parent, Estimate: estimate}
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", id, err)
+		}
+	}
+
+	// milestone
+	// ├── epic (estimate ignored: it has children)
+	// │   ├── t1 completed, 3
+	// │   └── t2 todo, 5
+	// └── t3 scrapped, 2
+	create("milestone", "todo", "", 0)
+	create("epic", "in-progress", "milestone", 100)
+	create("t1", "completed", "epic", 3)
+	create("t2", "todo", "epic", 5)
+	create("t3", "scrapped", "milestone", 2)
+	create("plain", "todo", "", 0)
+
+	tests := []struct {
+		id                  string
+		total, completed    int
+		totalEst, remaining float64
+		progress            float64
+	}{
+		{"milestone", 4, 2, 10, 5, 0.5},
+		{"epic", 2, 1, 8, 5, 3.0 / 8},
+		{"t1", 0, 0, 3, 0, 1},
+		{"t2", 0, 0, 5, 5, 0},
+		{"plain", 0, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			p, err := core.Progress(tt.id)
+			if err != nil {
+				t.Fatalf("Progress() error = %v", err)
+			}
+			if p.TotalChildren != tt.total || p.CompletedChildren != tt.completed {
+				t.Errorf("children = %d/%d, want %d/%d", p.CompletedChildren, p.TotalChildren, tt.completed, tt.total)
+			}
+			if p.TotalEstimate != tt.totalEst || p.RemainingEstimate != tt.remaining {
+				t.Errorf("estimate = %v remaining of %v, want %v of %v", p.RemainingEstimate, p.TotalEstimate, tt.remaining, tt.totalEst)
+			}
+			if p.Progress != tt.progress {
+				t.Errorf("Progress = %v, want %v", p.Progress, tt.progress)
+			}
+		})
+	}
+
+	t.Run("count-based without estimates", func(t *testing.T) {
+		create("epic2", "todo", "", 0)
+		create("c1", "completed", "epic2", 0)
+		create("c2", "todo", "epic2", 0)
+		create("c3", "todo", "epic2", 0)
+		create("c4", "todo", "epic2", 0)
+		p, _ := core.Progress("epic2")
+		if p.Progress != 0.25 {
+			t.Errorf("Progress = %v, want 0.25", p.Progress)
+		}
+	})
+
+	t.Run("missing bean", func(t *testing.T) {
+		if _, err := core.Progress("nope"); err != ErrNotFound {
+			t.Errorf("Progress() error = %v, want ErrNotFound", err)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package beancore
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// checkedBoxPattern matches checked markdown task list items.
+var checkedBoxPattern = regexp.MustCompile(`(?m)^(\s*[-*+] )\[[xX]\]`)
+
+// NextOccurrence returns the bean created as the next occurrence of the given
+// bean, or nil if there is none.
+func (c *Core) NextOccurrence(beanID string) *bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.nextOccurrenceLocked(beanID)
+}
+
+// nextOccurrenceLocked finds the next occurrence of a bean. Must be called
+// with c.mu held.
+func (c *Core) nextOccurrenceLocked(beanID string) *bean.Bean {
+	var next *bean.Bean
+	for _, b := range c.beans {
+		// Pick the oldest if an occurrence was created twice (e.g. on two branches)
+		if b.Previous == beanID && (next == nil || b.ID < next.ID) {
+			next = b
+		}
+	}
+	return next
+}
+
+// MissedOccurrences returns the recurring beans that are completed (have an
+// archive status that isn't cancelled) but whose next occurrence hasn't been
+// created, sorted by ID. This happens when a bean is completed outside of
+// beans, e.g. by editing its file. Cancelled beans (e.g. scrapped) end their series.
+func (c *Core) MissedOccurrences() []*bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var missed []*bean.Bean
+	for _, b := range c.beans {
+		if b.Recur != "" && c.isCompletedStatus(b.Status) && c.nextOccurrenceLocked(b.ID) == nil {
+			missed = append(missed, b)
+		}
+	}
+	sort.Slice(missed, func(i, j int) bool { return missed[i].ID < missed[j].ID })
+	return missed
+}
+
+// Recur creates the next occurrence of a completed recurring bean, unless it
+// already exists. Returns the new bean, or nil if none was created (the bean
+// isn't recurring, isn't completed, or already has a next occurrence).
+// Scrapping a recurring bean (or moving it to another cancelled status) ends
+// its series.
+//
+// The next occurrence starts over in the default status and keeps the bean's
+// title, type, priority, tags, parent, assignees, estimate, custom fields,
+// recurrence rule, and body (with task list items unchecked). Its due and
+// start dates move ahead by the recurrence interval to the first date that
+// isn't in the past; a bean without dates gets a due date one interval from today.
+func (c *Core) Recur(beanID string, today bean.Date) (*bean.Bean, error) {
+	b, err := c.Get(beanID)
+	if err != nil {
+		return nil, err
+	}
+	if b.Recur == "" || !c.isCompletedStatus(b.Status) || c.NextOccurrence(b.ID) != nil {
+		return nil, nil
+	}
+	rule, err := bean.ParseRecurrence(b.Recur)
+	if err != nil {
+		return nil, err
+	}
+
+	next := &bean.Bean{
+		Slug:      b.Slug,
+		Title:     b.Title,
+		Status:    "todo",
+		Type:      b.Type,
+		Priority:  b.Priority,
+		Tags:      slices.Clone(b.Tags),
+		Parent:    b.Parent,
+		Assignees: slices.Clone(b.Assignees),
+		Estimate:  b.Estimate,
+		Fields:    maps.Clone(b.Fields),
+		Recur:     rule.String(),
+		Previous:  b.ID,
+		Body:      checkedBoxPattern.ReplaceAllString(b.Body, "$1[ ]"),
+	}
+	if c.config != nil {
+		next.Status = c.config.GetDefaultStatus()
+	}
+	next.Start, next.Due = nextOccurrenceDates(b.Start, b.Due, rule, today)
+
+	if err := c.Create(next); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// nextOccurrenceDates moves start and due dates ahead by whole recurrence
+// intervals until the due date (or the start date, if there is no due date)
+// is today or later. The gap between start and due is kept.
+func nextOccurrenceDates(start, due *bean.Date, rule bean.Recurrence, today bean.Date) (*bean.Date, *bean.Date) {
+	anchor := due
+	if anchor == nil {
+		anchor = start
+	}
+	if anchor == nil {
+		d := rule.Next(today)
+		return nil, &d
+	}
+
+	// Step from the original date each time so that month ends stay month
+	// ends (Jan 31, Feb 29, Mar 31 rather than Mar 29)
+	next := rule.Next(*anchor)
+	for n := 2; next.Before(today.Time); n++ {
+		next = bean.Recurrence{Interval: n * rule.Interval, Unit: rule.Unit}.Next(*anchor)
+	}
+
+	if due == nil {
+		return &next, nil
+	}
+	if start == nil {
+		return nil, &next
+	}
+	nextStart := next.AddDays(-int(due.Sub(start.Time).Hours() / 24))
+	return &nextStart, &next
+}
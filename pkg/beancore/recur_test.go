@@ -0,0 +1,135 @@
+package beancore
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestRecur(t *testing.T) {
+	core, _ := setupTestCore(t)
+	today := bean.DateOf(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+	date := func(s string) *bean.Date {
+		d, err := bean.ParseDate(s, today)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &d
+	}
+
+	createTestBean(t, core, "epic", "Epic", "todo")
+	b := &bean.Bean{
+		ID:       "chore",
+		Title:    "Rotate credentials",
+		Status:   "todo",
+		Type:     "task",
+		Tags:     []string{"ops"},
+		Parent:   "epic",
+		Recur:    "FREQ=MONTHLY",
+		Start:    date("2024-01-28"),
+		Due:      date("2024-01-31"),
+		Estimate: 2,
+		Body:     "- [x] AWS\n- [X] GCP\n- [ ] Azure\n",
+	}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("not finished", func(t *testing.T) {
+		next, err := core.Recur("chore", today)
+		if err != nil || next != nil {
+			t.Fatalf("Recur() = %v, %v; want nil, nil", next, err)
+		}
+		if missed := core.MissedOccurrences(); len(missed) != 0 {
+			t.Errorf("MissedOccurrences() = %v, want none", missed)
+		}
+	})
+
+	b.Status = "completed"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if missed := core.MissedOccurrences(); len(missed) != 1 || missed[0].ID != "chore" {
+		t.Fatalf("MissedOccurrences() = %v, want [chore]", missed)
+	}
+
+	next, err := core.Recur("chore", today)
+	if err != nil {
+		t.Fatalf("Recur() error = %v", err)
+	}
+	if next == nil {
+		t.Fatal("Recur() = nil, want next occurrence")
+	}
+
+	t.Run("next occurrence", func(t *testing.T) {
+		if next.ID == "chore" || next.Previous != "chore" {
+			t.Errorf("ID = %q, Previous = %q", next.ID, next.Previous)
+		}
+		if next.Status != "todo" || next.Title != b.Title || next.Parent != "epic" || next.Estimate != 2 {
+			t.Errorf("unexpected copy: %+v", next)
+		}
+		if !slices.Equal(next.Tags, []string{"ops"}) {
+			t.Errorf("Tags = %v, want [ops]", next.Tags)
+		}
+		if next.Recur != "monthly" {
+			t.Errorf("Recur = %q, want monthly", next.Recur)
+		}
+		if want := "- [ ] AWS\n- [ ] GCP\n- [ ] Azure\n"; next.Body != want {
+			t.Errorf("Body = %q, want %q", next.Body, want)
+		}
+		// Jan 31 rolls past Feb 29 (overdue) to Mar 31; start keeps the 3-day gap
+		if next.Due.String() != "2024-03-31" || next.Start.String() != "2024-03-28" {
+			t.Errorf("Start, Due = %s, %s; want 2024-03-28, 2024-03-31", next.Start, next.Due)
+		}
+		if got := core.NextOccurrence("chore"); got == nil || got.ID != next.ID {
+			t.Errorf("NextOccurrence() = %v, want %s", got, next.ID)
+		}
+	})
+
+	t.Run("no duplicate", func(t *testing.T) {
+		again, err := core.Recur("chore", today)
+		if err != nil || again != nil {
+			t.Errorf("Recur() again = %v, %v; want nil, nil", again, err)
+		}
+		if missed := core.MissedOccurrences(); len(missed) != 0 {
+			t.Errorf("MissedOccurrences() = %v, want none", missed)
+		}
+	})
+}
+
+func TestRecurWithoutDates(t *testing.T) {
+	core, _ := setupTestCore(t)
+	today := bean.DateOf(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	b := &bean.Bean{ID: "chore", Title: "Audit", Status: "completed", Recur: "every 2w"}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := core.Recur("chore", today)
+	if err != nil || next == nil {
+		t.Fatalf("Recur() = %v, %v", next, err)
+	}
+	if next.Start != nil || next.Due == nil || next.Due.String() != "2024-03-24" {
+		t.Errorf("Start, Due = %v, %v; want nil, 2024-03-24", next.Start, next.Due)
+	}
+}
+
+func TestRecurScrapped(t *testing.T) {
+	core, _ := setupTestCore(t)
+	today := bean.DateOf(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	b := &bean.Bean{ID: "chore", Title: "Audit", Status: "scrapped", Recur: "every 2w"}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if next, err := core.Recur("chore", today); err != nil || next != nil {
+		t.Errorf("Recur() = %v, %v; want nil, nil for a scrapped bean", next, err)
+	}
+	if missed := core.MissedOccurrences(); len(missed) != 0 {
+		t.Errorf("MissedOccurrences() = %v, want none", missed)
+	}
+}
@@ -0,0 +1,209 @@
+package beancore
+
+import (
+	"sort"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Link is a link of a configured type (related, duplicates, ...) between two
+// beans, as seen from one of them.
+type Link struct {
+	// Type is the link's name as seen from this bean: the link type for
+	// links stored on this bean and for symmetric links, the inverse name
+	// (e.g. duplicated_by) for links stored on the other bean.
+	Type string
+	// Bean is the bean at the other end of the link.
+	Bean *bean.Bean
+	// Incoming is true if the link is stored on the other bean.
+	Incoming bool
+}
+
+// linkTypes returns the configured link types.
+func (c *Core) linkTypes() []config.LinkTypeConfig {
+	if c.config == nil {
+		return config.DefaultLinkTypes
+	}
+	return c.config.GetLinkTypes()
+}
+
+// isDirectedLinkType returns true if linkType is a configured link type that
+// is not symmetric (and so must not form cycles).
+func (c *Core) isDirectedLinkType(linkType string) bool {
+	for _, lt := range c.linkTypes() {
+		if lt.Name == linkType {
+			return !lt.Symmetric
+		}
+	}
+	return false
+}
+
+// Links returns the links between the given bean and other beans, in both
+// directions: its own links first (in configured link type order), then
+// incoming links from other beans. Links of types that are no longer
+// configured are included under their stored names; incoming links of types
+// without an inverse name are not. Links to missing beans are skipped.
+func (c *Core) Links(beanID string) []Link {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	b, ok := c.beans[beanID]
+	if !ok {
+		return nil
+	}
+
+	var links []Link
+	seen := make(map[Link]bool)
+	add := func(l Link) {
+		// Symmetric links may be stored on both beans; list them once
+		key := Link{Type: l.Type, Bean: l.Bean}
+		if !seen[key] {
+			seen[key] = true
+			links = append(links, l)
+		}
+	}
+
+	configured := make(map[string]bool)
+	for _, lt := range c.linkTypes() {
+		configured[lt.Name] = true
+		for _, target := range b.Relations[lt.Name] {
+			if t, ok := c.beans[target]; ok {
+				add(Link{Type: lt.Name, Bean: t})
+			}
+		}
+	}
+	for _, linkType := range b.RelationTypes() {
+		if configured[linkType] {
+			continue
+		}
+		for _, target := range b.Relations[linkType] {
+			if t, ok := c.beans[target]; ok {
+				add(Link{Type: linkType, Bean: t})
+			}
+		}
+	}
+
+	// Beans linking to this one, from the inverse link index
+	others := make([]*bean.Bean, 0, len(c.linkSources[beanID]))
+	for id := range c.linkSources[beanID] {
+		if other, ok := c.beans[id]; ok && id != beanID {
+			others = append(others, other)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].ID < others[j].ID })
+	for _, lt := range c.linkTypes() {
+		name := lt.Name
+		if !lt.Symmetric {
+			name = lt.Inverse
+		}
+		if name == "" {
+			continue
+		}
+		for _, other := range others {
+			if other.HasRelation(lt.Name, beanID) {
+				add(Link{Type: name, Bean: other, Incoming: true})
+			}
+		}
+	}
+
+	return links
+}
+
+// setBeanLocked stores b in memory and records its links in the inverse link
+// index (must be called with lock held). Every write to c.beans goes through
+// it or deleteBeanLocked, so Links can find incoming links without scanning
+// all beans.
+func (c *Core) setBeanLocked(b *bean.Bean) {
+	c.beans[b.ID] = b
+	c.indexLinksLocked(b)
+}
+
+// deleteBeanLocked removes a bean from memory and from the inverse link index
+// (must be called with lock held).
+func (c *Core) deleteBeanLocked(id string) {
+	delete(c.beans, id)
+	c.unindexLinksLocked(id)
+}
+
+// indexLinksLocked records the beans b links to in the inverse link index,
+// replacing what was recorded for b before (must be called with lock held).
+func (c *Core) indexLinksLocked(b *bean.Bean) {
+	c.unindexLinksLocked(b.ID)
+	var targets []string
+	for _, linkTargets := range b.Relations {
+		for _, target := range linkTargets {
+			if c.linkSources[target] == nil {
+				c.linkSources[target] = make(map[string]bool)
+			}
+			c.linkSources[target][b.ID] = true
+			targets = append(targets, target)
+		}
+	}
+	if len(targets) > 0 {
+		c.linkTargets[b.ID] = targets
+	}
+}
+
+// unindexLinksLocked removes the links of a bean from the inverse link index
+// (must be called with lock held).
+func (c *Core) unindexLinksLocked(id string) {
+	for _, target := range c.linkTargets[id] {
+		delete(c.linkSources[target], id)
+		if len(c.linkSources[target]) == 0 {
+			delete(c.linkSources, target)
+		}
+	}
+	delete(c.linkTargets, id)
+}
+
+// LinkNames returns the names of every bean's links in both directions, as
+// Links would report them, keyed by bean ID. It makes one pass over all
+// beans, so use it instead of Links when checking many beans.
+func (c *Core) LinkNames() map[string]map[string]bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Name of each configured link type as seen from the target bean
+	incoming := make(map[string]string)
+	for _, lt := range c.linkTypes() {
+		incoming[lt.Name] = lt.Name
+		if !lt.Symmetric {
+			incoming[lt.Name] = lt.Inverse
+		}
+	}
+
+	result := make(map[string]map[string]bool)
+	add := func(id, name string) {
+		if result[id] == nil {
+			result[id] = make(map[string]bool)
+		}
+		result[id][name] = true
+	}
+	for _, b := range c.beans {
+		for linkType, targets := range b.Relations {
+			for _, target := range targets {
+				if _, ok := c.beans[target]; !ok {
+					continue
+				}
+				add(b.ID, linkType)
+				if name := incoming[linkType]; name != "" && target != b.ID {
+					add(target, name)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Linked returns the beans linked to the given bean under a link name, which
+// may be a link type (e.g. duplicates) or an inverse name (e.g. duplicated_by).
+func (c *Core) Linked(beanID, name string) []*bean.Bean {
+	var result []*bean.Bean
+	for _, l := range c.Links(beanID) {
+		if l.Type == name {
+			result = append(result, l.Bean)
+		}
+	}
+	return result
+}
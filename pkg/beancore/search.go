@@ -0,0 +1,95 @@
+package beancore
+
+import (
+	"github.com/hmans/beans/internal/search"
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// SearchResults are the ranked matches of a full-text search.
+type SearchResults struct {
+	// Total is the number of matching beans, which may exceed len(Hits).
+	Total  int          `json:"total"`
+	Hits   []SearchHit  `json:"hits"`
+	Facets SearchFacets `json:"facets"`
+}
+
+// SearchHit is a bean matching a full-text search.
+type SearchHit struct {
+	Bean *bean.Bean `json:"bean"`
+	// Score is the BM25 relevance score (higher is more relevant).
+	Score float64 `json:"score"`
+	// Snippets are highlighted excerpts of the matching title, body, comments,
+	// or parent title.
+	Snippets []SearchSnippet `json:"snippets"`
+}
+
+// SearchSnippet is a highlighted excerpt of a matching field. Text is
+// HTML-escaped, with matched terms wrapped in <mark> tags.
+type SearchSnippet struct {
+	Field string `json:"field"`
+	Text  string `json:"text"`
+}
+
+// SearchFacets counts the matching beans by status, type, and tag.
+type SearchFacets struct {
+	Status []FacetCount `json:"status"`
+	Type   []FacetCount `json:"type"`
+	Tags   []FacetCount `json:"tags"`
+}
+
+// FacetCount is the number of matching beans with a given value.
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// SearchResults performs a full-text search like Search, but returns scored
+// hits with highlighted snippets and facet counts. The limit controls the
+// maximum number of hits (0 for the default).
+func (c *Core) SearchResults(query string, limit int) (*SearchResults, error) {
+	c.mu.Lock()
+	if err := c.ensureSearchIndexLocked(); err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	idx := c.searchIndex
+	c.mu.Unlock()
+
+	res, err := idx.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	results := &SearchResults{
+		Total: res.Total,
+		Hits:  make([]SearchHit, 0, len(res.Hits)),
+		Facets: SearchFacets{
+			Status: facetCounts(res.Facets["status"]),
+			Type:   facetCounts(res.Facets["type"]),
+			Tags:   facetCounts(res.Facets["tags"]),
+		},
+	}
+	for _, h := range res.Hits {
+		b, ok := c.beans[h.ID]
+		if !ok {
+			continue
+		}
+		hit := SearchHit{Bean: b, Score: h.Score, Snippets: []SearchSnippet{}}
+		for _, s := range h.Snippets {
+			hit.Snippets = append(hit.Snippets, SearchSnippet{Field: s.Field, Text: s.Text})
+		}
+		results.Hits = append(results.Hits, hit)
+	}
+	return results, nil
+}
+
+func facetCounts(counts []search.FacetCount) []FacetCount {
+	result := make([]FacetCount, len(counts))
+	for i, fc := range counts {
+		result[i] = FacetCount{Value: fc.Value, Count: fc.Count}
+	}
+	return result
+}
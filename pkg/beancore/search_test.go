@@ -2,6 +2,7 @@ package beancore
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
@@ -277,3 +278,139 @@ func TestClose_ClosesSearchIndex(t *testing.T) {
 func writeTestFile(dir, name, content string) error {
 	return os.WriteFile(dir+"/"+name, []byte(content), 0644)
 }
+
+func TestSearch_PersistentIndex(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	if err := core.Create(&bean.Bean{ID: "abc1", Title: "Persistent Bean"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := core.Search("Persistent"); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, SearchIndexDir)); err != nil {
+		t.Fatalf("search index should be persisted in %s: %v", SearchIndexDir, err)
+	}
+	core.Close()
+
+	// While a core has the index open, another one falls back to an in-memory index
+	core2 := New(beansDir, core.Config())
+	core2.SetWarnWriter(nil)
+	if err := core2.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	defer core2.Close()
+	if _, err := core2.Search("Persistent"); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	core3 := New(beansDir, core.Config())
+	core3.SetWarnWriter(nil)
+	if err := core3.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	defer core3.Close()
+	results, err := core3.Search("Persistent")
+	if err != nil {
+		t.Fatalf("Search() with locked index error = %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "abc1" {
+		t.Errorf("Search(Persistent) = %v, want [abc1]", results)
+	}
+}
+
+func TestSearch_LoadUpdatesIndexIncrementally(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	defer core.Close()
+
+	if err := core.Create(&bean.Bean{ID: "abc1", Title: "Doomed Bean"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := core.Search("Doomed"); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	// Remove the file behind the core's back and reload
+	if err := os.Remove(filepath.Join(beansDir, "abc1.md")); err != nil {
+		t.Fatalf("failed to remove bean file: %v", err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	results, err := core.Search("Doomed")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("Search(Doomed) after removal = %v, want []", results)
+	}
+}
+
+func TestSearchResults(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Login page", Body: "Add a login form", Status: "todo", Type: "feature", Tags: []string{"auth"}},
+		{ID: "bbb2", Title: "Session cookies", Body: "Keep users logged in after login", Status: "completed", Type: "task"},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	results, err := core.SearchResults("login", 0)
+	if err != nil {
+		t.Fatalf("SearchResults() error = %v", err)
+	}
+	if results.Total != 2 || len(results.Hits) != 2 {
+		t.Fatalf("SearchResults(login) = %d total, %d hits; want 2, 2", results.Total, len(results.Hits))
+	}
+	if results.Hits[0].Bean.ID != "aaa1" {
+		t.Errorf("first hit = %s, want aaa1", results.Hits[0].Bean.ID)
+	}
+	if len(results.Hits[1].Snippets) == 0 {
+		t.Error("expected snippets for second hit")
+	}
+	if len(results.Facets.Status) != 2 || len(results.Facets.Tags) != 1 || results.Facets.Tags[0] != (FacetCount{Value: "auth", Count: 1}) {
+		t.Errorf("facets = %+v", results.Facets)
+	}
+}
+
+func TestSearch_ParentRenameUpdatesChildren(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	parent := &bean.Bean{ID: "par1", Title: "Old Epic", Type: "epic"}
+	child := &bean.Bean{ID: "chi1", Title: "Child task", Parent: "par1"}
+	for _, b := range []*bean.Bean{parent, child} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	results, err := core.Search("parent_title:old")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "chi1" {
+		t.Errorf("Search(parent_title:old) = %v, want [chi1]", results)
+	}
+
+	parent.Title = "Renamed Epic"
+	if err := core.Update(parent, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	results, err = core.Search("parent_title:renamed")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "chi1" {
+		t.Errorf("Search(parent_title:renamed) = %v, want [chi1]", results)
+	}
+	if results, _ := core.Search("parent_title:old"); len(results) != 0 {
+		t.Errorf("Search(parent_title:old) = %v, want none after rename", results)
+	}
+}
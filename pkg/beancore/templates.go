@@ -0,0 +1,108 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// TemplatesDir is the directory (inside .beans) holding templates for new beans.
+const TemplatesDir = "templates"
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+// Template is a markdown file in .beans/templates/ that new beans start from.
+// A template named after a type (e.g. bug.md) applies to new beans of that
+// type; other templates are used by name.
+type Template struct {
+	Name string
+	// Defaults holds the values from the template's front matter (type,
+	// status, priority, tags, assignees, estimate, custom fields).
+	Defaults *bean.Bean
+
+	body *template.Template
+}
+
+// TemplateData holds the values available to template bodies, e.g. {{.Title}}.
+type TemplateData struct {
+	Title string
+	Type  string
+	// Date is today's date (YYYY-MM-DD).
+	Date string
+	// User is the current user (see Core.CurrentUser).
+	User string
+}
+
+// isNonBeanDir reports whether a directory below the beans directory holds
+// data other than beans: dot-prefixed directories (e.g. .worktrees/,
+// .conversations/) and the templates/ directory.
+func isNonBeanDir(root, path string) bool {
+	if strings.HasPrefix(filepath.Base(path), ".") {
+		return true
+	}
+	return filepath.Dir(path) == root && filepath.Base(path) == TemplatesDir
+}
+
+// Templates returns the names of the available templates, sorted.
+func (c *Core) Templates() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(c.root, TemplatesDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	names := []string{}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".md") {
+			names = append(names, strings.TrimSuffix(e.Name(), ".md"))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Template loads the template with the given name from .beans/templates/<name>.md.
+// Returns ErrTemplateNotFound if there is no such template.
+func (c *Core) Template(name string) (*Template, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return nil, fmt.Errorf("invalid template name: %q", name)
+	}
+
+	path := filepath.Join(c.root, TemplatesDir, name+".md")
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	defaults, err := bean.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	body, err := template.New(name).Option("missingkey=error").Parse(defaults.Body)
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	defaults.Body = ""
+
+	return &Template{Name: name, Defaults: defaults, body: body}, nil
+}
+
+// RenderBody renders the template's body with the given data.
+func (t *Template) RenderBody(data TemplateData) (string, error) {
+	var sb strings.Builder
+	if err := t.body.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("template %s: %w", t.Name, err)
+	}
+	return strings.TrimLeft(sb.String(), "\n"), nil
+}
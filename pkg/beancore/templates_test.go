@@ -0,0 +1,99 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeTemplate(t *testing.T, beansDir, name, content string) {
+	t.Helper()
+	dir := filepath.Join(beansDir, TemplatesDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	writeTemplate(t, beansDir, "bug", "---\npriority: high\ntags: [triage]\n---\n\n## Steps to reproduce\n\n{{.Title}} ({{.Type}}) by {{.User}} on {{.Date}}\n")
+
+	tmpl, err := core.Template("bug")
+	if err != nil {
+		t.Fatalf("Template() error = %v", err)
+	}
+	if tmpl.Defaults.Priority != "high" || !slices.Equal(tmpl.Defaults.Tags, []string{"triage"}) {
+		t.Errorf("Defaults = %+v", tmpl.Defaults)
+	}
+
+	body, err := tmpl.RenderBody(TemplateData{Title: "Crash", Type: "bug", User: "ann@example.com", Date: "2025-01-02"})
+	if err != nil {
+		t.Fatalf("RenderBody() error = %v", err)
+	}
+	if want := "## Steps to reproduce\n\nCrash (bug) by ann@example.com on 2025-01-02"; body != want {
+		t.Errorf("RenderBody() = %q, want %q", body, want)
+	}
+}
+
+func TestTemplate_Errors(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	writeTemplate(t, beansDir, "broken", "---\ntitle: x\n---\n{{.Title\n")
+	writeTemplate(t, beansDir, "unknown", "---\ntitle: x\n---\n{{.Nope}}\n")
+
+	if _, err := core.Template("missing"); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("Template(missing) error = %v, want ErrTemplateNotFound", err)
+	}
+	if _, err := core.Template("../secret"); err == nil || errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("Template(../secret) error = %v, want invalid name", err)
+	}
+	if _, err := core.Template("broken"); err == nil {
+		t.Error("Template(broken): expected parse error")
+	}
+	tmpl, err := core.Template("unknown")
+	if err != nil {
+		t.Fatalf("Template(unknown) error = %v", err)
+	}
+	if _, err := tmpl.RenderBody(TemplateData{}); err == nil {
+		t.Error("RenderBody() with unknown placeholder: expected error")
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	names, err := core.Templates()
+	if err != nil || len(names) != 0 {
+		t.Fatalf("Templates() without directory = %v, %v; want empty", names, err)
+	}
+
+	writeTemplate(t, beansDir, "feature", "---\ntitle: \"\"\n---\n")
+	writeTemplate(t, beansDir, "bug", "---\ntitle: \"\"\n---\n")
+	names, err = core.Templates()
+	if err != nil {
+		t.Fatalf("Templates() error = %v", err)
+	}
+	if !slices.Equal(names, []string{"bug", "feature"}) {
+		t.Errorf("Templates() = %v, want [bug feature]", names)
+	}
+}
+
+func TestLoadSkipsTemplates(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "real1", "Real Bean", "todo")
+	writeTemplate(t, beansDir, "task", "---\ntitle: Template\nstatus: todo\n---\n")
+
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if all := core.All(); len(all) != 1 || all[0].ID != "real1" {
+		t.Errorf("All() = %v, want only real1", all)
+	}
+	if n := len(core.LoadErrors()); n != 0 {
+		t.Errorf("LoadErrors() = %d, want 0", n)
+	}
+}
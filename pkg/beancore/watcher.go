@@ -131,12 +131,12 @@ func (c *Core) Watch(onChange func()) error {
 	}
 
 	// Watch all subdirectories (best effort - don't fail if any can't be watched)
-	// Skip dot-prefixed subdirectories (e.g. .worktrees/, .conversations/)
+	// Skip dot-prefixed subdirectories (e.g. .worktrees/, .conversations/) and templates/
 	_ = filepath.WalkDir(c.root, func(path string, d os.DirEntry, err error) error {
 		if err != nil || !d.IsDir() || path == c.root {
 			return nil
 		}
-		if strings.HasPrefix(d.Name(), ".") {
+		if isNonBeanDir(c.root, path) {
 			return filepath.SkipDir
 		}
 		_ = watcher.Add(path)
@@ -215,8 +215,8 @@ func (c *Core) watchLoop(watcher *fsnotify.Watcher) {
 				continue
 			}
 
-			// Skip events from dot-prefixed subdirectories (e.g. .worktrees/, .conversations/)
-			if topDir, _, ok := strings.Cut(relPath, string(filepath.Separator)); ok && strings.HasPrefix(topDir, ".") {
+			// Skip events from dot-prefixed subdirectories (e.g. .worktrees/, .conversations/) and templates/
+			if topDir, _, ok := strings.Cut(relPath, string(filepath.Separator)); ok && isNonBeanDir(c.root, filepath.Join(c.root, topDir)) {
 				continue
 			}
 
@@ -281,10 +281,13 @@ func (c *Core) handleChanges(changes map[string]fsnotify.Op) {
 
 		// Handle removes/renames (file is gone)
 		if op&fsnotify.Remove != 0 || op&fsnotify.Rename != 0 {
+			if !c.fileExists(path) {
+				c.clearLoadErrorLocked(path)
+			}
 			if _, exists := c.beans[id]; exists {
 				// Only delete if it was in our map and file is actually gone
 				if !c.fileExists(path) {
-					delete(c.beans, id)
+					c.deleteBeanLocked(id)
 
 					// Update search index
 					if c.searchIndex != nil {
@@ -312,11 +315,13 @@ func (c *Core) handleChanges(changes map[string]fsnotify.Op) {
 			newBean, err := c.loadBean(path)
 			if err != nil {
 				c.logWarn("failed to load bean from %s: %v", path, err)
+				c.setLoadErrorLocked(path, err)
 				continue
 			}
+			c.clearLoadErrorLocked(path)
 
 			_, existed := c.beans[newBean.ID]
-			c.beans[newBean.ID] = newBean
+			c.setBeanLocked(newBean)
 			delete(c.dirty, newBean.ID) // Disk is now up-to-date
 
 			// Update search index
@@ -324,6 +329,9 @@ func (c *Core) handleChanges(changes map[string]fsnotify.Op) {
 				if err := c.searchIndex.IndexBean(newBean); err != nil {
 					c.logWarn("failed to index bean %s: %v", newBean.ID, err)
 				}
+				if err := c.refreshChildrenInSearchIndexLocked(newBean.ID); err != nil {
+					c.logWarn("failed to update children of bean %s in search index: %v", newBean.ID, err)
+				}
 			}
 
 			if existed {
@@ -0,0 +1,137 @@
+package beancore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Transition rule identifiers reported in TransitionError.Rule.
+const (
+	RuleTransition       = "transition"
+	RuleChildrenResolved = string(config.GuardChildrenResolved)
+	RuleNotBlocked       = string(config.GuardNotBlocked)
+)
+
+// TransitionError is returned when a status change violates the configured workflow.
+type TransitionError struct {
+	BeanID string `json:"bean_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	// Rule is the violated rule: "transition", "children_resolved", or "not_blocked".
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+	// Allowed lists the permitted target statuses (for "transition" violations).
+	Allowed []string `json:"allowed,omitempty"`
+	// Related lists the open children or active blockers that caused a guard to fail.
+	Related []string `json:"related,omitempty"`
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot change status of %s from %s to %s: %s", e.BeanID, e.From, e.To, e.Reason)
+}
+
+// CheckTransition checks whether the bean may change to the given status
+// according to the configured workflow. Returns a *TransitionError if the
+// transition or one of its guards is violated, nil otherwise.
+func (c *Core) CheckTransition(b *bean.Bean, to string) error {
+	return c.CheckTransitionWith(b, to, nil)
+}
+
+// CheckTransitionWith is like CheckTransition, for a bean that is changed
+// together with others: pending maps the IDs of the other beans to their new
+// versions, which the guards check instead of the loaded ones.
+func (c *Core) CheckTransitionWith(b *bean.Bean, to string, pending map[string]*bean.Bean) error {
+	if c.config == nil || b.Status == to {
+		return nil
+	}
+
+	if !c.config.IsTransitionAllowed(b.Type, b.Status, to) {
+		allowed, _ := c.config.AllowedTransitions(b.Type, b.Status)
+		reason := fmt.Sprintf("%s beans cannot move from %s to %s", b.Type, b.Status, to)
+		if len(allowed) > 0 {
+			reason += fmt.Sprintf(" (allowed: %s)", strings.Join(allowed, ", "))
+		}
+		return &TransitionError{
+			BeanID:  b.ID,
+			From:    b.Status,
+			To:      to,
+			Rule:    RuleTransition,
+			Reason:  reason,
+			Allowed: allowed,
+		}
+	}
+
+	for _, g := range c.config.GuardsFor(b.Type, to) {
+		switch g.Rule {
+		case config.GuardChildrenResolved:
+			if open := c.openChildren(b.ID, pending); len(open) > 0 {
+				return &TransitionError{
+					BeanID:  b.ID,
+					From:    b.Status,
+					To:      to,
+					Rule:    RuleChildrenResolved,
+					Reason:  fmt.Sprintf("%d child bean(s) still open: %s", len(open), strings.Join(open, ", ")),
+					Related: open,
+				}
+			}
+		case config.GuardNotBlocked:
+			var ids []string
+			for _, blocker := range c.FindActiveBlockers(b.ID) {
+				if p, ok := pending[blocker.ID]; !ok || !c.isResolvedStatus(p.Status) {
+					ids = append(ids, blocker.ID)
+				}
+			}
+			if len(ids) > 0 {
+				sort.Strings(ids)
+				return &TransitionError{
+					BeanID:  b.ID,
+					From:    b.Status,
+					To:      to,
+					Rule:    RuleNotBlocked,
+					Reason:  fmt.Sprintf("blocked by %s", strings.Join(ids, ", ")),
+					Related: ids,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// TransitionErrors returns, for each configured status the bean cannot change
+// to, the error describing why. Statuses that are allowed are not included.
+func (c *Core) TransitionErrors(b *bean.Bean) map[string]*TransitionError {
+	result := make(map[string]*TransitionError)
+	if c.config == nil {
+		return result
+	}
+	for _, status := range c.config.StatusNames() {
+		if err := c.CheckTransition(b, status); err != nil {
+			result[status] = err.(*TransitionError)
+		}
+	}
+	return result
+}
+
+// openChildren returns the sorted IDs of direct children that don't have a
+// resolved (archive) status, using the pending version of children that have one.
+func (c *Core) openChildren(beanID string, pending map[string]*bean.Bean) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var open []string
+	for _, child := range c.beans {
+		if p, ok := pending[child.ID]; ok {
+			child = p
+		}
+		if child.Parent == beanID && !c.isResolvedStatus(child.Status) {
+			open = append(open, child.ID)
+		}
+	}
+	sort.Strings(open)
+	return open
+}
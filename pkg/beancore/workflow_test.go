@@ -0,0 +1,100 @@
+package beancore
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hmans/beans/pkg/config"
+)
+
+func TestCheckTransition(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.Config().Workflow = config.WorkflowConfig{
+		Transitions: map[string]map[string][]string{
+			config.WorkflowAnyType: {"draft": {"todo", "scrapped"}},
+		},
+		Guards: []config.GuardConfig{
+			{Rule: config.GuardChildrenResolved, Statuses: []string{"completed"}},
+			{Rule: config.GuardNotBlocked, Statuses: []string{"in-progress"}},
+		},
+	}
+
+	t.Run("disallowed transition", func(t *testing.T) {
+		b := createTestBean(t, core, "wf-draft", "Draft", "draft")
+		err := core.CheckTransition(b, "completed")
+		var terr *TransitionError
+		if !errors.As(err, &terr) {
+			t.Fatalf("CheckTransition() error = %v, want *TransitionError", err)
+		}
+		if terr.Rule != RuleTransition || !slices.Equal(terr.Allowed, []string{"todo", "scrapped"}) {
+			t.Errorf("TransitionError = %+v, want transition rule with allowed [todo scrapped]", terr)
+		}
+		if err := core.CheckTransition(b, "todo"); err != nil {
+			t.Errorf("CheckTransition(todo) error = %v, want nil", err)
+		}
+	})
+
+	t.Run("children_resolved guard", func(t *testing.T) {
+		parent := createTestBean(t, core, "wf-parent", "Parent", "in-progress")
+		child := createTestBean(t, core, "wf-child", "Child", "todo")
+		child.Parent = parent.ID
+		if err := core.Update(child, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		err := core.CheckTransition(parent, "completed")
+		var terr *TransitionError
+		if !errors.As(err, &terr) || terr.Rule != RuleChildrenResolved {
+			t.Fatalf("CheckTransition() error = %v, want children_resolved violation", err)
+		}
+		if !slices.Equal(terr.Related, []string{"wf-child"}) {
+			t.Errorf("Related = %v, want [wf-child]", terr.Related)
+		}
+
+		child.Status = "scrapped"
+		if err := core.Update(child, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if err := core.CheckTransition(parent, "completed"); err != nil {
+			t.Errorf("CheckTransition() error = %v, want nil once children are resolved", err)
+		}
+	})
+
+	t.Run("not_blocked guard", func(t *testing.T) {
+		blocker := createTestBean(t, core, "wf-blocker", "Blocker", "todo")
+		blocked := createTestBean(t, core, "wf-blocked", "Blocked", "todo")
+		blocked.BlockedBy = []string{blocker.ID}
+		if err := core.Update(blocked, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		err := core.CheckTransition(blocked, "in-progress")
+		var terr *TransitionError
+		if !errors.As(err, &terr) || terr.Rule != RuleNotBlocked {
+			t.Fatalf("CheckTransition() error = %v, want not_blocked violation", err)
+		}
+
+		blocker.Status = "completed"
+		if err := core.Update(blocker, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if err := core.CheckTransition(blocked, "in-progress"); err != nil {
+			t.Errorf("CheckTransition() error = %v, want nil once blocker is resolved", err)
+		}
+	})
+
+	t.Run("TransitionErrors lists disallowed statuses", func(t *testing.T) {
+		b := createTestBean(t, core, "wf-draft-2", "Draft 2", "draft")
+		errs := core.TransitionErrors(b)
+		if _, ok := errs["todo"]; ok {
+			t.Error("TransitionErrors() contains todo, want allowed")
+		}
+		if _, ok := errs["draft"]; ok {
+			t.Error("TransitionErrors() contains current status, want allowed")
+		}
+		if _, ok := errs["completed"]; !ok {
+			t.Error("TransitionErrors() missing completed, want disallowed")
+		}
+	})
+}
@@ -70,7 +70,7 @@ func (c *Core) WatchWorktreeBeans(worktreePath string) error {
 		if err != nil || !d.IsDir() || path == beansDir {
 			return nil
 		}
-		if strings.HasPrefix(d.Name(), ".") {
+		if isNonBeanDir(beansDir, path) {
 			return filepath.SkipDir
 		}
 		_ = watcher.Add(path)
@@ -120,7 +120,7 @@ func (c *Core) loadWorktreeBeansInitial(wt *worktreeWatcher) {
 			}
 		}
 
-		c.beans[newBean.ID] = newBean
+		c.setBeanLocked(newBean)
 		c.dirty[newBean.ID] = true
 		c.worktreeLinks[newBean.ID] = wt.worktreePath
 
@@ -196,8 +196,8 @@ func (c *Core) worktreeWatchLoop(wt *worktreeWatcher, watcher *fsnotify.Watcher)
 				continue
 			}
 
-			// Skip events from dot-prefixed subdirectories
-			if topDir, _, ok := strings.Cut(relPath, string(filepath.Separator)); ok && strings.HasPrefix(topDir, ".") {
+			// Skip events from dot-prefixed subdirectories and templates/
+			if topDir, _, ok := strings.Cut(relPath, string(filepath.Separator)); ok && isNonBeanDir(wt.beansDir, filepath.Join(wt.beansDir, topDir)) {
 				continue
 			}
 
@@ -269,7 +269,7 @@ func (c *Core) handleWorktreeChanges(wt *worktreeWatcher, changes map[string]fsn
 					continue
 				}
 				// Bean exists in main — revert to that version
-				c.beans[id] = mainBean
+				c.setBeanLocked(mainBean)
 				delete(c.dirty, id)
 				delete(c.worktreeLinks, id)
 
@@ -284,7 +284,7 @@ func (c *Core) handleWorktreeChanges(wt *worktreeWatcher, changes map[string]fsn
 				})
 			} else if _, existed := c.beans[id]; existed {
 				// Bean was worktree-only — remove from runtime
-				delete(c.beans, id)
+				c.deleteBeanLocked(id)
 				delete(c.dirty, id)
 				delete(c.worktreeLinks, id)
 
@@ -324,7 +324,7 @@ func (c *Core) handleWorktreeChanges(wt *worktreeWatcher, changes map[string]fsn
 			if mainErr == nil && mainBean.ETag() == newBean.ETag() {
 				// Worktree version matches main — clear any stale link
 				if _, wasLinked := c.worktreeLinks[newBean.ID]; wasLinked {
-					c.beans[newBean.ID] = mainBean
+					c.setBeanLocked(mainBean)
 					delete(c.dirty, newBean.ID)
 					delete(c.worktreeLinks, newBean.ID)
 					if c.searchIndex != nil {
@@ -341,7 +341,7 @@ func (c *Core) handleWorktreeChanges(wt *worktreeWatcher, changes map[string]fsn
 		}
 
 		_, existed := c.beans[newBean.ID]
-		c.beans[newBean.ID] = newBean
+		c.setBeanLocked(newBean)
 		c.dirty[newBean.ID] = true // Mark as dirty — came from worktree, not persisted to main
 		c.worktreeLinks[newBean.ID] = wt.worktreePath
 
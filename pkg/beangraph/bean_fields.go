@@ -2,7 +2,9 @@ package beangraph
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
+	"sync"
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
@@ -25,6 +27,24 @@ func (r *CoreResolver) BeanWorktreeID(ctx context.Context, obj *bean.Bean) (*str
 	return &id, nil
 }
 
+// BeanFields returns the bean's custom field values, sorted by name.
+func (r *CoreResolver) BeanFields(ctx context.Context, obj *bean.Bean) ([]*model.BeanField, error) {
+	fields := make([]*model.BeanField, 0, len(obj.Fields))
+	for _, name := range obj.FieldNames() {
+		fields = append(fields, &model.BeanField{Name: name, Value: obj.Fields[name]})
+	}
+	return fields, nil
+}
+
+// BeanField returns the value of a single custom field, or nil if not set.
+func (r *CoreResolver) BeanField(ctx context.Context, obj *bean.Bean, name string) (*string, error) {
+	value, ok := obj.GetField(name)
+	if !ok {
+		return nil, nil
+	}
+	return &value, nil
+}
+
 // BeanParentID returns the parent ID as a pointer, or nil if no parent.
 func (r *CoreResolver) BeanParentID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	if obj.Parent == "" {
@@ -33,6 +53,97 @@ func (r *CoreResolver) BeanParentID(ctx context.Context, obj *bean.Bean) (*strin
 	return &obj.Parent, nil
 }
 
+// BeanAssignee returns the first assignee, or nil if the bean is unassigned.
+func (r *CoreResolver) BeanAssignee(ctx context.Context, obj *bean.Bean) (*string, error) {
+	if len(obj.Assignees) == 0 {
+		return nil, nil
+	}
+	return &obj.Assignees[0], nil
+}
+
+// BeanIsOverdue returns whether the bean is past its due date and not in an archive status.
+func (r *CoreResolver) BeanIsOverdue(ctx context.Context, obj *bean.Bean) (bool, error) {
+	return r.Core.IsOverdue(obj, bean.Today()), nil
+}
+
+// BeanEstimate returns the bean's estimate, or nil if it isn't estimated.
+func (r *CoreResolver) BeanEstimate(ctx context.Context, obj *bean.Bean) (*float64, error) {
+	if obj.Estimate == 0 {
+		return nil, nil
+	}
+	return &obj.Estimate, nil
+}
+
+// BeanRecur returns the bean's recurrence rule, or nil if it doesn't recur.
+func (r *CoreResolver) BeanRecur(ctx context.Context, obj *bean.Bean) (*string, error) {
+	return nilIfEmpty(obj.Recur), nil
+}
+
+// BeanPrevious resolves the bean this one is the next occurrence of.
+func (r *CoreResolver) BeanPrevious(ctx context.Context, obj *bean.Bean) (*bean.Bean, error) {
+	if obj.Previous == "" {
+		return nil, nil
+	}
+	// Filter out broken links
+	previous, err := r.Core.Get(obj.Previous)
+	if err == beancore.ErrNotFound {
+		return nil, nil
+	}
+	return previous, err
+}
+
+// BeanNextOccurrence resolves the next occurrence of a recurring bean.
+func (r *CoreResolver) BeanNextOccurrence(ctx context.Context, obj *bean.Bean) (*bean.Bean, error) {
+	return r.Core.NextOccurrence(obj.ID), nil
+}
+
+// progressCache holds the progress of all beans, computed on first use.
+type progressCache struct {
+	once     sync.Once
+	progress map[string]beancore.Progress
+}
+
+type progressCacheKey struct{}
+
+// WithProgressCache returns a context in which the progress fields of all
+// beans are computed together on first use and then reused, instead of
+// rolling up the whole tree for every bean. Wrap a single response with it,
+// so that later changes aren't hidden.
+func WithProgressCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, progressCacheKey{}, &progressCache{})
+}
+
+// beanProgress returns the rolled-up progress of a bean. Beans that aren't in
+// the core (e.g. just deleted) report zero progress.
+func (r *CoreResolver) beanProgress(ctx context.Context, obj *bean.Bean) beancore.Progress {
+	if cache, ok := ctx.Value(progressCacheKey{}).(*progressCache); ok {
+		cache.once.Do(func() { cache.progress = r.Core.AllProgress() })
+		return cache.progress[obj.ID]
+	}
+	p, _ := r.Core.Progress(obj.ID)
+	return p
+}
+
+// BeanProgress returns the completed fraction of the bean's work, from 0 to 1.
+func (r *CoreResolver) BeanProgress(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.beanProgress(ctx, obj).Progress, nil
+}
+
+// BeanTotalChildren returns the number of descendants.
+func (r *CoreResolver) BeanTotalChildren(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.beanProgress(ctx, obj).TotalChildren, nil
+}
+
+// BeanCompletedChildren returns the number of resolved descendants.
+func (r *CoreResolver) BeanCompletedChildren(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.beanProgress(ctx, obj).CompletedChildren, nil
+}
+
+// BeanRemainingEstimate returns the estimate of unresolved work.
+func (r *CoreResolver) BeanRemainingEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.beanProgress(ctx, obj).RemainingEstimate, nil
+}
+
 // BeanBlockingIds returns the blocking IDs slice.
 func (r *CoreResolver) BeanBlockingIds(ctx context.Context, obj *bean.Bean) ([]string, error) {
 	return obj.Blocking, nil
@@ -46,7 +157,7 @@ func (r *CoreResolver) BeanBlockedByIds(ctx context.Context, obj *bean.Bean) ([]
 // BeanBlockedBy resolves the full list of beans blocking this one.
 // Combines both directions: the bean's own blocked_by field AND incoming
 // blocking links (other beans that list this bean in their blocking field).
-func (r *CoreResolver) BeanBlockedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+func (r *CoreResolver) BeanBlockedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
 	seen := make(map[string]bool)
 	var result []*bean.Bean
 
@@ -69,14 +180,11 @@ func (r *CoreResolver) BeanBlockedBy(ctx context.Context, obj *bean.Bean, filter
 		}
 	}
 
-	filtered := ApplyFilter(result, filter, r.Core)
-	cfg := r.Core.Config()
-	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
-	return filtered, nil
+	return r.orderAndLimit(ApplyFilter(result, filter, r.Core), orderBy, first)
 }
 
 // BeanBlocking resolves the beans this bean is blocking.
-func (r *CoreResolver) BeanBlocking(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+func (r *CoreResolver) BeanBlocking(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
 	var result []*bean.Bean
 	for _, targetID := range obj.Blocking {
 		// Filter out broken links
@@ -84,10 +192,7 @@ func (r *CoreResolver) BeanBlocking(ctx context.Context, obj *bean.Bean, filter
 			result = append(result, target)
 		}
 	}
-	filtered := ApplyFilter(result, filter, r.Core)
-	cfg := r.Core.Config()
-	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
-	return filtered, nil
+	return r.orderAndLimit(ApplyFilter(result, filter, r.Core), orderBy, first)
 }
 
 // BeanParent resolves the parent bean.
@@ -104,7 +209,7 @@ func (r *CoreResolver) BeanParent(ctx context.Context, obj *bean.Bean) (*bean.Be
 }
 
 // BeanChildren resolves the child beans.
-func (r *CoreResolver) BeanChildren(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+func (r *CoreResolver) BeanChildren(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
 	incoming := r.Core.FindIncomingLinks(obj.ID)
 	var result []*bean.Bean
 	for _, link := range incoming {
@@ -112,10 +217,7 @@ func (r *CoreResolver) BeanChildren(ctx context.Context, obj *bean.Bean, filter
 			result = append(result, link.FromBean)
 		}
 	}
-	filtered := ApplyFilter(result, filter, r.Core)
-	cfg := r.Core.Config()
-	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
-	return filtered, nil
+	return r.orderAndLimit(ApplyFilter(result, filter, r.Core), orderBy, first)
 }
 
 // BeanImplicitStatus returns the implicit status inherited from ancestors.
@@ -135,3 +237,66 @@ func (r *CoreResolver) BeanImplicitStatusFrom(ctx context.Context, obj *bean.Bea
 	}
 	return &fromID, nil
 }
+
+// BeanHistory returns the bean's change history from git, newest first.
+// Returns an empty list if the beans directory is not tracked in git.
+func (r *CoreResolver) BeanHistory(ctx context.Context, obj *bean.Bean, limit *int) ([]*beancore.HistoryEntry, error) {
+	entries, err := r.Core.History(obj.ID)
+	if errors.Is(err, beancore.ErrNoHistory) {
+		return []*beancore.HistoryEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil && *limit >= 0 && *limit < len(entries) {
+		entries = entries[:*limit]
+	}
+	result := make([]*beancore.HistoryEntry, len(entries))
+	for i := range entries {
+		result[i] = &entries[i]
+	}
+	return result, nil
+}
+
+// BeanChangeFrom returns the previous value of a history change, or nil if unset.
+func (r *CoreResolver) BeanChangeFrom(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return nilIfEmpty(obj.From), nil
+}
+
+// BeanChangeTo returns the new value of a history change, or nil if unset.
+func (r *CoreResolver) BeanChangeTo(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return nilIfEmpty(obj.To), nil
+}
+
+// BeanChangeDiff returns the body diff of a history change, or nil for other fields.
+func (r *CoreResolver) BeanChangeDiff(ctx context.Context, obj *beancore.FieldChange) (*string, error) {
+	return nilIfEmpty(obj.Diff), nil
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// BeanLinks returns the bean's links in both directions, optionally only those
+// with the given name.
+func (r *CoreResolver) BeanLinks(ctx context.Context, obj *bean.Bean, name *string) ([]*beancore.Link, error) {
+	result := []*beancore.Link{}
+	for _, l := range r.Core.Links(obj.ID) {
+		if name == nil || l.Type == *name {
+			result = append(result, &l)
+		}
+	}
+	return result, nil
+}
+
+// BeanLinked resolves the beans linked to this one under a link name (a link
+// type or an inverse name).
+func (r *CoreResolver) BeanLinked(ctx context.Context, obj *bean.Bean, name string, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	filtered := ApplyFilter(r.Core.Linked(obj.ID, name), filter, r.Core)
+	cfg := r.Core.Config()
+	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	return filtered, nil
+}
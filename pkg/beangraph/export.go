@@ -0,0 +1,35 @@
+package beangraph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hmans/beans/internal/exporter"
+	"github.com/hmans/beans/pkg/beangraph/model"
+)
+
+// Export renders the beans matching filter as a file in the given format.
+func (r *CoreResolver) Export(ctx context.Context, format model.ExportFormat, filter *model.BeanFilter, columns []string) (*model.ExportFile, error) {
+	beans, err := r.Beans(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ExportFormatName(format)
+	var sb strings.Builder
+	if err := exporter.Write(&sb, name, beans, exporter.Options{Columns: columns, Config: r.Core.Config()}); err != nil {
+		return nil, err
+	}
+
+	return &model.ExportFile{
+		Filename:    "beans." + exporter.Extension(name),
+		ContentType: exporter.ContentType(name),
+		Content:     sb.String(),
+	}, nil
+}
+
+// ExportFormatName converts a GraphQL export format to the exporter's format
+// name (e.g. MARKDOWN_TABLE to "markdown-table").
+func ExportFormatName(format model.ExportFormat) string {
+	return strings.ReplaceAll(strings.ToLower(string(format)), "_", "-")
+}
@@ -1,11 +1,31 @@
 package beangraph
 
 import (
+	"slices"
+
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/beancore"
 )
 
+// Matches reports whether a single bean passes the filter, including its
+// full-text search. It is used to filter bean change events.
+func Matches(b *bean.Bean, filter *model.BeanFilter, core *beancore.Core) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		results, err := core.Search(*filter.Search)
+		if err != nil {
+			return false, err
+		}
+		if !slices.ContainsFunc(results, func(r *bean.Bean) bool { return r.ID == b.ID }) {
+			return false, nil
+		}
+	}
+	return len(ApplyFilter([]*bean.Bean{b}, filter, core)) == 1, nil
+}
+
 // ApplyFilter applies BeanFilter to a slice of beans and returns filtered results.
 // This is used by both the top-level beans query and relationship field resolvers.
 func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Core) []*bean.Bean {
@@ -47,6 +67,28 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 		result = excludeByTags(result, filter.ExcludeTags)
 	}
 
+	// Assignee filters
+	if len(filter.Assignee) > 0 {
+		result = filterByAssignee(result, filter.Assignee)
+	}
+	if filter.HasAssignee != nil && *filter.HasAssignee {
+		result = filterByHasAssignee(result)
+	}
+	if filter.NoAssignee != nil && *filter.NoAssignee {
+		result = filterByNoAssignee(result)
+	}
+
+	// Date filters
+	if filter.DueBefore != nil {
+		result = filterByDue(result, func(due bean.Date) bool { return !due.After(filter.DueBefore.Time) })
+	}
+	if filter.DueAfter != nil {
+		result = filterByDue(result, func(due bean.Date) bool { return !due.Before(filter.DueAfter.Time) })
+	}
+	if filter.IsOverdue != nil {
+		result = filterByOverdue(result, core, *filter.IsOverdue)
+	}
+
 	// Parent filters
 	if filter.HasParent != nil && *filter.HasParent {
 		result = filterByHasParent(result)
@@ -93,11 +135,48 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 		result = filterByNoBlockedBy(result)
 	}
 
+	// Link filters (related, duplicates, ...)
+	if len(filter.HasLink) > 0 {
+		result = filterByLink(result, filter.HasLink, core, true)
+	}
+	if len(filter.NoLink) > 0 {
+		result = filterByLink(result, filter.NoLink, core, false)
+	}
+
 	// Implicit status filter
 	if filter.ExcludeImplicitTerminal != nil && *filter.ExcludeImplicitTerminal {
 		result = filterByNoImplicitTerminal(result, core)
 	}
 
+	// Custom field filters (AND across filters)
+	for _, f := range filter.Fields {
+		if f != nil {
+			result = filterByCustomField(result, f)
+		}
+	}
+
+	return result
+}
+
+// filterByCustomField filters beans by a custom field's presence and/or value.
+// Values use OR logic; isSet requires the field to be present (true) or absent (false).
+func filterByCustomField(beans []*bean.Bean, f *model.FieldFilter) []*bean.Bean {
+	valueSet := make(map[string]bool, len(f.Values))
+	for _, v := range f.Values {
+		valueSet[v] = true
+	}
+
+	var result []*bean.Bean
+	for _, b := range beans {
+		value, ok := b.GetField(f.Name)
+		if f.IsSet != nil && *f.IsSet != ok {
+			continue
+		}
+		if len(valueSet) > 0 && (!ok || !valueSet[value]) {
+			continue
+		}
+		result = append(result, b)
+	}
 	return result
 }
 
@@ -225,6 +304,65 @@ func filterByHasParent(beans []*bean.Bean) []*bean.Bean {
 	return result
 }
 
+// filterByAssignee filters beans assigned to any of the given users (OR logic, case-insensitive).
+func filterByAssignee(beans []*bean.Bean, users []string) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		for _, u := range users {
+			if b.IsAssignedTo(u) {
+				result = append(result, b)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// filterByHasAssignee filters beans to include only those with at least one assignee.
+func filterByHasAssignee(beans []*bean.Bean) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if b.HasAssignees() {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByNoAssignee filters beans to include only unassigned ones.
+func filterByNoAssignee(beans []*bean.Bean) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if !b.HasAssignees() {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByDue filters beans that have a due date matching the predicate.
+func filterByDue(beans []*bean.Bean, match func(due bean.Date) bool) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if b.Due != nil && match(*b.Due) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByOverdue filters beans by whether they are overdue (see Core.IsOverdue).
+func filterByOverdue(beans []*bean.Bean, core *beancore.Core, overdue bool) []*bean.Bean {
+	today := bean.Today()
+	var result []*bean.Bean
+	for _, b := range beans {
+		if core.IsOverdue(b, today) == overdue {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
 // filterByNoParent filters beans to include only those without a parent.
 func filterByNoParent(beans []*bean.Bean) []*bean.Bean {
 	var result []*bean.Bean
@@ -377,3 +515,19 @@ func filterByNoImplicitTerminal(beans []*bean.Bean, core *beancore.Core) []*bean
 	}
 	return result
 }
+
+// filterByLink filters beans by whether they have (has=true) or don't have
+// (has=false) links of any of the given names, in either direction.
+func filterByLink(beans []*bean.Bean, names []string, core *beancore.Core, has bool) []*bean.Bean {
+	linkNames := core.LinkNames()
+	var result []*bean.Bean
+	for _, b := range beans {
+		found := slices.ContainsFunc(names, func(name string) bool {
+			return linkNames[b.ID][name]
+		})
+		if found == has {
+			result = append(result, b)
+		}
+	}
+	return result
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
 )
 
 // Lightweight status for tracking which beans have running agents
@@ -117,9 +119,34 @@ type BeanChangeEvent struct {
 	BeanID string `json:"beanId"`
 }
 
+// A page of beans
+type BeanConnection struct {
+	Edges []*BeanEdge `json:"edges"`
+	// The beans on this page (the edges' nodes)
+	Nodes []*bean.Bean `json:"nodes"`
+	// Number of beans matching the filter, across all pages
+	TotalCount int       `json:"totalCount"`
+	PageInfo   *PageInfo `json:"pageInfo"`
+}
+
+// A bean on a page, with its cursor
+type BeanEdge struct {
+	// Opaque cursor; pass as after to get the beans following this one
+	Cursor string     `json:"cursor"`
+	Node   *bean.Bean `json:"node"`
+}
+
+// A custom front matter field value
+type BeanField struct {
+	// Field name
+	Name string `json:"name"`
+	// Field value in canonical string form
+	Value string `json:"value"`
+}
+
 // Filter options for querying beans
 type BeanFilter struct {
-	// Full-text search across slug, title, and body using Bleve query syntax.
+	// Full-text search across slug, title, body, comments, tags, and parent title using Bleve query syntax.
 	//
 	// Examples:
 	// - "login" - exact term match
@@ -132,6 +159,9 @@ type BeanFilter struct {
 	// - "slug:auth" - search only slug field
 	// - "title:login" - search only title field
 	// - "body:auth" - search only body field
+	// - "comments:auth" - search only comments
+	// - "tags:backend" - beans with a tag (also type:, status:, priority:, parent:)
+	// - "parent_title:auth" - search only the parent's title
 	Search *string `json:"search,omitempty"`
 	// Include only beans with these statuses (OR logic)
 	Status []string `json:"status,omitempty"`
@@ -149,6 +179,18 @@ type BeanFilter struct {
 	Tags []string `json:"tags,omitempty"`
 	// Exclude beans with any of these tags
 	ExcludeTags []string `json:"excludeTags,omitempty"`
+	// Include only beans assigned to any of these users (OR logic, case-insensitive)
+	Assignee []string `json:"assignee,omitempty"`
+	// Include only beans with at least one assignee
+	HasAssignee *bool `json:"hasAssignee,omitempty"`
+	// Include only beans with no assignees
+	NoAssignee *bool `json:"noAssignee,omitempty"`
+	// Include only beans due on or before this date
+	DueBefore *bean.Date `json:"dueBefore,omitempty"`
+	// Include only beans due on or after this date
+	DueAfter *bean.Date `json:"dueAfter,omitempty"`
+	// Include only overdue beans (true) or exclude them (false)
+	IsOverdue *bool `json:"isOverdue,omitempty"`
 	// Include only beans with a parent
 	HasParent *bool `json:"hasParent,omitempty"`
 	// Include only beans with this specific parent ID
@@ -173,8 +215,58 @@ type BeanFilter struct {
 	NoBlocking *bool `json:"noBlocking,omitempty"`
 	// Exclude beans that have explicit blocked-by entries
 	NoBlockedBy *bool `json:"noBlockedBy,omitempty"`
+	// Include only beans with links of any of these names (link types or inverse names, e.g. duplicated_by)
+	HasLink []string `json:"hasLink,omitempty"`
+	// Exclude beans with links of any of these names (link types or inverse names)
+	NoLink []string `json:"noLink,omitempty"`
 	// Exclude beans that inherit a terminal status (scrapped or completed) from an ancestor
 	ExcludeImplicitTerminal *bool `json:"excludeImplicitTerminal,omitempty"`
+	// Include only beans matching all of these custom field filters
+	Fields []*FieldFilter `json:"fields,omitempty"`
+}
+
+// A sort key. Keys are applied in turn; beans that tie on all keys keep the
+// default order (status, priority, type, title).
+type BeanOrder struct {
+	Field BeanOrderField `json:"field"`
+	// Defaults to DESC for CREATED and UPDATED (newest first) and ASC otherwise
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
+// Bean counts and estimate sums
+type BeanStats struct {
+	// Number of beans matching the filter
+	Count int `json:"count"`
+	// Summed estimate of the matching beans (null if none is estimated)
+	Estimate *float64 `json:"estimate,omitempty"`
+	// Counts per combination of groupBy values, in configured order (empty without groupBy)
+	Groups []*BeanStatsGroup `json:"groups"`
+}
+
+// Bean counts for one combination of groupBy values. Fields not grouped by are
+// null, as are those of beans without a value (e.g. no tags or parent).
+type BeanStatsGroup struct {
+	Status   *string `json:"status,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	Priority *string `json:"priority,omitempty"`
+	Tag      *string `json:"tag,omitempty"`
+	Parent   *string `json:"parent,omitempty"`
+	// Number of beans in the group
+	Count int `json:"count"`
+	// Summed estimate of the beans in the group (null if none is estimated)
+	Estimate *float64 `json:"estimate,omitempty"`
+}
+
+// The outcome of a bulk update for a single bean
+type BeanUpdateResult struct {
+	// ID of the bean
+	ID string `json:"id"`
+	// The bean after the update (as it would be, for dry runs); null if nothing was written
+	Bean *bean.Bean `json:"bean,omitempty"`
+	// Field-level changes made (or that would be made); empty if the bean was already up to date
+	Changes []*beancore.FieldChange `json:"changes"`
+	// Why the update failed for this bean, if it did
+	Error *string `json:"error,omitempty"`
 }
 
 // Structured body modifications applied atomically.
@@ -201,7 +293,7 @@ type BranchStatus struct {
 type CreateBeanInput struct {
 	// Bean title (required)
 	Title string `json:"title"`
-	// Bean type (defaults to 'task')
+	// Bean type (defaults to the configured default type, usually 'task')
 	Type *string `json:"type,omitempty"`
 	// Status (defaults to 'todo')
 	Status *string `json:"status,omitempty"`
@@ -217,8 +309,55 @@ type CreateBeanInput struct {
 	Blocking []string `json:"blocking,omitempty"`
 	// Bean IDs that are blocking this bean
 	BlockedBy []string `json:"blockedBy,omitempty"`
+	// Links to other beans (related, duplicates, supersedes, ...)
+	Links []*LinkInput `json:"links,omitempty"`
+	// Users assigned to this bean (email addresses, names, or agent identities)
+	Assignees []string `json:"assignees,omitempty"`
+	// Planned start date
+	Start *bean.Date `json:"start,omitempty"`
+	// Due date
+	Due *bean.Date `json:"due,omitempty"`
+	// Size of the work (points or hours); must not be negative
+	Estimate *float64 `json:"estimate,omitempty"`
+	// Recurrence rule (daily, weekly, monthly, yearly, every <n>d/w/m/y, or FREQ=...;INTERVAL=<n>)
+	Recur *string `json:"recur,omitempty"`
 	// Custom ID prefix (overrides config prefix for this bean)
 	Prefix *string `json:"prefix,omitempty"`
+	// Custom field values (fields must be declared in .beans.yml)
+	Fields []*FieldInput `json:"fields,omitempty"`
+	// Template to start from (.beans/templates/<name>.md). Defaults to the template
+	// named after the bean's type, if there is one. The template's front matter
+	// provides defaults for fields not given here (tags are combined), and its body
+	// is used unless a body is given.
+	Template *string `json:"template,omitempty"`
+}
+
+// An exported file
+type ExportFile struct {
+	// Suggested file name
+	Filename string `json:"filename"`
+	// MIME type of the content
+	ContentType string `json:"contentType"`
+	// The exported beans
+	Content string `json:"content"`
+}
+
+// Filter on a custom field value
+type FieldFilter struct {
+	// Field name
+	Name string `json:"name"`
+	// Include only beans whose field equals any of these values (OR logic)
+	Values []string `json:"values,omitempty"`
+	// Include only beans where the field is set (true) or not set (false)
+	IsSet *bool `json:"isSet,omitempty"`
+}
+
+// A custom field value to set on a bean.
+type FieldInput struct {
+	// Field name (must be declared in .beans.yml)
+	Name string `json:"name"`
+	// Field value (validated against the field's declared type)
+	Value string `json:"value"`
 }
 
 // Input for attaching a file or directory as context to an agent message.
@@ -255,9 +394,28 @@ type ImageInput struct {
 	MediaType string `json:"mediaType"`
 }
 
+// A link to another bean
+type LinkInput struct {
+	// Link type (as configured; defaults: related, duplicates, supersedes)
+	Type string `json:"type"`
+	// ID of the bean to link to
+	Target string `json:"target"`
+}
+
 type Mutation struct {
 }
 
+type PageInfo struct {
+	// Whether there are more beans after this page
+	HasNextPage bool `json:"hasNextPage"`
+	// Whether this page starts after a cursor
+	HasPreviousPage bool `json:"hasPreviousPage"`
+	// Cursor of the first bean on this page (null if the page is empty)
+	StartCursor *string `json:"startCursor,omitempty"`
+	// Cursor of the last bean on this page (null if the page is empty)
+	EndCursor *string `json:"endCursor,omitempty"`
+}
+
 // A blocking interaction the agent is waiting for user approval on
 type PendingInteraction struct {
 	// Type of interaction
@@ -344,12 +502,57 @@ type UpdateBeanInput struct {
 	AddBlockedBy []string `json:"addBlockedBy,omitempty"`
 	// Remove beans from blocked-by list
 	RemoveBlockedBy []string `json:"removeBlockedBy,omitempty"`
+	// Add links to other beans (validates link type, existence, and cycles)
+	AddLinks []*LinkInput `json:"addLinks,omitempty"`
+	// Remove links to other beans
+	RemoveLinks []*LinkInput `json:"removeLinks,omitempty"`
+	// Replace all assignees (nil preserves existing, mutually exclusive with addAssignees/removeAssignees)
+	Assignees []string `json:"assignees,omitempty"`
+	// Add users to the assignee list
+	AddAssignees []string `json:"addAssignees,omitempty"`
+	// Remove users from the assignee list
+	RemoveAssignees []string `json:"removeAssignees,omitempty"`
+	// Set planned start date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw; empty to clear)
+	Start *string `json:"start,omitempty"`
+	// Set due date (YYYY-MM-DD, today, tomorrow, +Nd, +Nw; empty to clear)
+	Due *string `json:"due,omitempty"`
+	// Set estimate (points or hours; 0 to clear)
+	Estimate *float64 `json:"estimate,omitempty"`
+	// Set recurrence rule (daily, weekly, monthly, yearly, every <n>d/w/m/y, or FREQ=...;INTERVAL=<n>; empty to clear)
+	Recur *string `json:"recur,omitempty"`
+	// Set custom field values (fields must be declared in .beans.yml; empty value clears)
+	SetFields []*FieldInput `json:"setFields,omitempty"`
+	// Remove custom fields by name
+	RemoveFields []string `json:"removeFields,omitempty"`
 	// Fractional index for manual ordering (used by board drag-and-drop)
 	Order *string `json:"order,omitempty"`
 	// ETag for optimistic concurrency control (optional)
 	IfMatch *string `json:"ifMatch,omitempty"`
 }
 
+// A bean event sent (or being sent) to a webhook
+type WebhookDelivery struct {
+	// Delivery ID, sent in the X-Beans-Delivery header
+	ID string `json:"id"`
+	// Name of the webhook
+	Webhook string `json:"webhook"`
+	// Bean event: created, updated, or deleted
+	Event string `json:"event"`
+	// ID of the changed bean
+	BeanID string                `json:"beanId"`
+	Status WebhookDeliveryStatus `json:"status"`
+	// Number of requests made so far
+	Attempts int `json:"attempts"`
+	// HTTP status of the last response (null if there was none)
+	StatusCode *int `json:"statusCode,omitempty"`
+	// Why the last attempt failed
+	Error         *string    `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty"`
+	// When the next retry is scheduled (pending deliveries only)
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+}
+
 // Git status for a workspace (main repo or worktree)
 type WorkspaceStatus struct {
 	// Workspace identifier (__central__ for main repo, worktree ID for worktrees)
@@ -508,6 +711,146 @@ func (e AgentSessionStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// A field to sort beans by
+type BeanOrderField string
+
+const (
+	// Status, in configured order
+	BeanOrderFieldStatus BeanOrderField = "STATUS"
+	// Priority, in configured order (beans without priority count as normal)
+	BeanOrderFieldPriority BeanOrderField = "PRIORITY"
+	// Type, in configured order
+	BeanOrderFieldType BeanOrderField = "TYPE"
+	// Creation time
+	BeanOrderFieldCreated BeanOrderField = "CREATED"
+	// Last update time
+	BeanOrderFieldUpdated BeanOrderField = "UPDATED"
+	// Manual order (fractional index); beans without one come last
+	BeanOrderFieldOrder BeanOrderField = "ORDER"
+	// Due date; beans without one come last
+	BeanOrderFieldDue BeanOrderField = "DUE"
+	// Bean ID
+	BeanOrderFieldID BeanOrderField = "ID"
+)
+
+var AllBeanOrderField = []BeanOrderField{
+	BeanOrderFieldStatus,
+	BeanOrderFieldPriority,
+	BeanOrderFieldType,
+	BeanOrderFieldCreated,
+	BeanOrderFieldUpdated,
+	BeanOrderFieldOrder,
+	BeanOrderFieldDue,
+	BeanOrderFieldID,
+}
+
+func (e BeanOrderField) IsValid() bool {
+	switch e {
+	case BeanOrderFieldStatus, BeanOrderFieldPriority, BeanOrderFieldType, BeanOrderFieldCreated, BeanOrderFieldUpdated, BeanOrderFieldOrder, BeanOrderFieldDue, BeanOrderFieldID:
+		return true
+	}
+	return false
+}
+
+func (e BeanOrderField) String() string {
+	return string(e)
+}
+
+func (e *BeanOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BeanOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BeanOrderField", str)
+	}
+	return nil
+}
+
+func (e BeanOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BeanOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BeanOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// A field to group bean statistics by
+type BeanStatsGroupBy string
+
+const (
+	BeanStatsGroupByStatus   BeanStatsGroupBy = "STATUS"
+	BeanStatsGroupByType     BeanStatsGroupBy = "TYPE"
+	BeanStatsGroupByPriority BeanStatsGroupBy = "PRIORITY"
+	// Beans with several tags count toward each of them
+	BeanStatsGroupByTag BeanStatsGroupBy = "TAG"
+	// Parent bean ID
+	BeanStatsGroupByParent BeanStatsGroupBy = "PARENT"
+)
+
+var AllBeanStatsGroupBy = []BeanStatsGroupBy{
+	BeanStatsGroupByStatus,
+	BeanStatsGroupByType,
+	BeanStatsGroupByPriority,
+	BeanStatsGroupByTag,
+	BeanStatsGroupByParent,
+}
+
+func (e BeanStatsGroupBy) IsValid() bool {
+	switch e {
+	case BeanStatsGroupByStatus, BeanStatsGroupByType, BeanStatsGroupByPriority, BeanStatsGroupByTag, BeanStatsGroupByParent:
+		return true
+	}
+	return false
+}
+
+func (e BeanStatsGroupBy) String() string {
+	return string(e)
+}
+
+func (e *BeanStatsGroupBy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BeanStatsGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BeanStatsGroupBy", str)
+	}
+	return nil
+}
+
+func (e BeanStatsGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BeanStatsGroupBy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BeanStatsGroupBy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Type of change that occurred to a bean
 type ChangeType string
 
@@ -569,6 +912,70 @@ func (e ChangeType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Formats for exporting beans
+type ExportFormat string
+
+const (
+	// Comma-separated values with a header row
+	ExportFormatCSV ExportFormat = "CSV"
+	// One JSON bean per line
+	ExportFormatJSONL ExportFormat = "JSONL"
+	// iCalendar with one VTODO per bean
+	ExportFormatIcs ExportFormat = "ICS"
+	// Markdown table
+	ExportFormatMarkdownTable ExportFormat = "MARKDOWN_TABLE"
+)
+
+var AllExportFormat = []ExportFormat{
+	ExportFormatCSV,
+	ExportFormatJSONL,
+	ExportFormatIcs,
+	ExportFormatMarkdownTable,
+}
+
+func (e ExportFormat) IsValid() bool {
+	switch e {
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatIcs, ExportFormatMarkdownTable:
+		return true
+	}
+	return false
+}
+
+func (e ExportFormat) String() string {
+	return string(e)
+}
+
+func (e *ExportFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExportFormat", str)
+	}
+	return nil
+}
+
+func (e ExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ExportFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ExportFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Type of blocking interaction
 type InteractionType string
 
@@ -627,6 +1034,122 @@ func (e InteractionType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Status of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	// Not delivered yet (queued or waiting for a retry)
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// The receiver accepted the payload
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// All attempts failed, or the receiver rejected the payload
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Status of a worktree's post-creation setup command
 type WorktreeSetupStatus string
 
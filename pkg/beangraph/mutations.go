@@ -2,7 +2,11 @@ package beangraph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
@@ -11,6 +15,10 @@ import (
 
 // CreateBean creates a new bean from the given input.
 func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInput) (*bean.Bean, error) {
+	if err := r.applyTemplate(&input); err != nil {
+		return nil, err
+	}
+
 	b := &bean.Bean{
 		Slug:     bean.Slugify(input.Title),
 		Title:    input.Title,
@@ -19,11 +27,16 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 	}
 
 	// Optional fields with defaults documented in schema
+	if cfg := r.Core.Config(); cfg != nil && cfg.GetDefaultType() != "" {
+		b.Type = cfg.GetDefaultType()
+	}
 	if input.Type != nil {
 		b.Type = *input.Type
 	}
 	if input.Status != nil {
 		b.Status = *input.Status
+	} else if cfg := r.Core.Config(); cfg != nil {
+		b.Status = cfg.GetDefaultStatus()
 	}
 	if input.Priority != nil {
 		b.Priority = *input.Priority
@@ -34,6 +47,33 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 	if len(input.Tags) > 0 {
 		b.Tags = input.Tags
 	}
+	for _, a := range input.Assignees {
+		b.AddAssignee(a)
+	}
+	b.Start = input.Start
+	b.Due = input.Due
+	if input.Estimate != nil {
+		if err := setEstimate(b, *input.Estimate); err != nil {
+			return nil, err
+		}
+	}
+	if input.Recur != nil {
+		if err := setRecur(b, *input.Recur); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateDateRange(b); err != nil {
+		return nil, err
+	}
+	if len(input.Fields) > 0 {
+		if err := r.ValidateAndSetFields(b, input.Fields); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.ValidateStatusTypePriority(input.Status, input.Type, input.Priority); err != nil {
+		return nil, err
+	}
 
 	// Handle parent (with validation)
 	if input.Parent != nil && *input.Parent != "" {
@@ -82,6 +122,18 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 		b.BlockedBy = normalizedBlockedBy
 	}
 
+	// Handle links (with validation); a link type may imply a status (e.g.
+	// a duplicate is scrapped) unless one was given
+	if len(input.Links) > 0 {
+		impliedStatus, err := r.ValidateAndAddLinks(b, input.Links)
+		if err != nil {
+			return nil, err
+		}
+		if impliedStatus != "" && input.Status == nil && r.config().IsValidStatus(impliedStatus) {
+			b.Status = impliedStatus
+		}
+	}
+
 	// Handle custom prefix - pre-generate ID if prefix is provided
 	if input.Prefix != nil && *input.Prefix != "" {
 		idLength := 4 // default
@@ -102,21 +154,279 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 	return b, nil
 }
 
+// applyTemplate fills in the inputs of a new bean from its template: the one
+// named by input.Template, or else the template for the bean's type, if any.
+func (r *CoreResolver) applyTemplate(input *model.CreateBeanInput) error {
+	name := ""
+	if input.Template != nil {
+		name = *input.Template
+	} else if input.Type != nil {
+		name = *input.Type
+	} else if cfg := r.Core.Config(); cfg != nil {
+		name = cfg.GetDefaultType()
+	}
+	if name == "" {
+		return nil
+	}
+
+	tmpl, err := r.Core.Template(name)
+	if err != nil {
+		if errors.Is(err, beancore.ErrTemplateNotFound) && input.Template == nil {
+			return nil
+		}
+		return err
+	}
+
+	d := tmpl.Defaults
+	if input.Type == nil && d.Type != "" {
+		input.Type = &d.Type
+	}
+	if input.Status == nil && d.Status != "" {
+		input.Status = &d.Status
+	}
+	if input.Priority == nil && d.Priority != "" {
+		input.Priority = &d.Priority
+	}
+	for _, tag := range d.Tags {
+		if !slices.Contains(input.Tags, tag) {
+			input.Tags = append(input.Tags, tag)
+		}
+	}
+	if len(input.Assignees) == 0 {
+		input.Assignees = d.Assignees
+	}
+	if input.Estimate == nil && d.Estimate != 0 {
+		input.Estimate = &d.Estimate
+	}
+	if input.Recur == nil && d.Recur != "" {
+		input.Recur = &d.Recur
+	}
+	for name, value := range d.Fields {
+		if !slices.ContainsFunc(input.Fields, func(f *model.FieldInput) bool { return f.Name == name }) {
+			input.Fields = append(input.Fields, &model.FieldInput{Name: name, Value: value})
+		}
+	}
+
+	if input.Body == nil {
+		beanType := ""
+		if input.Type != nil {
+			beanType = *input.Type
+		} else if cfg := r.Core.Config(); cfg != nil {
+			beanType = cfg.GetDefaultType()
+		}
+		body, err := tmpl.RenderBody(beancore.TemplateData{
+			Title: input.Title,
+			Type:  beanType,
+			Date:  bean.Today().String(),
+			User:  r.Core.CurrentUser(),
+		})
+		if err != nil {
+			return err
+		}
+		input.Body = &body
+	}
+	return nil
+}
+
 // UpdateBean updates an existing bean.
 func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.UpdateBeanInput, opts ...beancore.UpdateOption) (*bean.Bean, error) {
 	b, err := r.Core.Get(id)
 	if err != nil {
 		return nil, err
 	}
+	previousStatus := b.Status
+
+	if err := r.applyUpdate(b, input, nil); err != nil {
+		return nil, err
+	}
+
+	// ETag validation now happens inside Update() under write lock.
+	// If the bean is linked to a worktree, Core auto-routes the write there.
+	if err := r.Core.Update(b, input.IfMatch, opts...); err != nil {
+		return nil, err
+	}
+
+	if err := r.recur(b, previousStatus); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// recur creates the next occurrence of a recurring bean that was just
+// completed (moved to an archive status that isn't cancelled, e.g. scrapped).
+func (r *CoreResolver) recur(b *bean.Bean, previousStatus string) error {
+	cfg := r.config()
+	if b.Recur == "" || cfg.IsCompletedStatus(previousStatus) || !cfg.IsCompletedStatus(b.Status) {
+		return nil
+	}
+	if _, err := r.Core.Recur(b.ID, bean.Today()); err != nil {
+		return fmt.Errorf("creating next occurrence of %s: %w", b.ID, err)
+	}
+	return nil
+}
+
+// UpdateBeans applies the same update to several beans, selected by IDs and/or
+// a filter (beans must match both when both are given). All updates are
+// validated against copies of the beans, with workflow guards seeing the other
+// selected beans as updated, and nothing is written if any bean fails. The
+// changed beans are then written together with Core.UpdateMany: if a bean
+// changed in the meantime or a write fails, none is updated and an error is
+// returned. With dryRun, the computed changes are returned without writing.
+// Beans the update wouldn't change are not written.
+func (r *CoreResolver) UpdateBeans(ctx context.Context, ids []string, filter *model.BeanFilter, input model.UpdateBeanInput, dryRun bool) ([]*model.BeanUpdateResult, error) {
+	if len(ids) == 0 && filter == nil {
+		return nil, fmt.Errorf("specify ids or a filter to select beans")
+	}
+	if input.IfMatch != nil {
+		return nil, fmt.Errorf("ifMatch is not supported for bulk updates")
+	}
+	if cfg := r.Core.Config(); cfg != nil && cfg.Beans.RequireIfMatch {
+		return nil, fmt.Errorf("bulk updates are not available when require_if_match is enabled")
+	}
+
+	// Select beans: by ID (reporting unknown IDs), narrowed by the filter if given
+	var results []*model.BeanUpdateResult
+	var targets []*bean.Bean
+	seen := make(map[string]bool)
+	add := func(b *bean.Bean) {
+		if !seen[b.ID] {
+			seen[b.ID] = true
+			targets = append(targets, b)
+		}
+	}
+	var matched map[string]bool
+	if filter != nil {
+		beans, err := r.Beans(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		matched = make(map[string]bool, len(beans))
+		for _, b := range beans {
+			matched[b.ID] = true
+			if len(ids) == 0 {
+				add(b)
+			}
+		}
+	}
+	for _, id := range ids {
+		b, err := r.Core.Get(id)
+		if err != nil {
+			msg := "bean not found"
+			results = append(results, &model.BeanUpdateResult{ID: id, Changes: []*beancore.FieldChange{}, Error: &msg})
+			continue
+		}
+		if matched != nil && !matched[b.ID] {
+			msg := "bean does not match the filter"
+			results = append(results, &model.BeanUpdateResult{ID: id, Changes: []*beancore.FieldChange{}, Error: &msg})
+			continue
+		}
+		add(b)
+	}
+
+	// Guards check the other selected beans with their new status, so that
+	// e.g. a parent can be completed together with its children
+	var pending map[string]*bean.Bean
+	if input.Status != nil {
+		pending = make(map[string]*bean.Bean, len(targets))
+		for _, b := range targets {
+			c := b.Clone()
+			c.Status = *input.Status
+			pending[b.ID] = c
+		}
+	}
+
+	// Validate and apply to copies
+	updated := make([]*bean.Bean, len(targets))
+	etags := make([]string, len(targets))
+	failed := len(results)
+	for i, b := range targets {
+		result := &model.BeanUpdateResult{ID: b.ID, Changes: []*beancore.FieldChange{}}
+		results = append(results, result)
+
+		etags[i] = b.ETag()
+		c := b.Clone()
+		if err := r.applyUpdate(c, input, pending); err != nil {
+			msg := err.Error()
+			result.Error = &msg
+			failed++
+			continue
+		}
+		for _, ch := range beancore.Diff(b, c) {
+			result.Changes = append(result.Changes, &ch)
+		}
+		result.Bean = c
+		updated[i] = c
+	}
+
+	if dryRun {
+		return results, nil
+	}
+	if failed > 0 {
+		// Nothing is written if any bean failed validation
+		for _, result := range results {
+			result.Bean = nil
+		}
+		return results, nil
+	}
+
+	// Write all changed beans at once
+	offset := len(results) - len(targets)
+	var writes []beancore.BeanUpdate
+	for i, c := range updated {
+		if len(results[offset+i].Changes) == 0 {
+			results[offset+i].Bean = targets[i]
+			continue
+		}
+		writes = append(writes, beancore.BeanUpdate{Bean: c, ETag: etags[i]})
+	}
+	if err := r.Core.UpdateMany(writes); err != nil {
+		return nil, err
+	}
+
+	for i, c := range updated {
+		result := results[offset+i]
+		if len(result.Changes) == 0 {
+			continue
+		}
+		if err := r.recur(c, targets[i].Status); err != nil {
+			msg := err.Error()
+			result.Error = &msg
+		}
+	}
+
+	return results, nil
+}
 
+// applyUpdate validates the input and applies it to b in memory, without
+// writing. Returns an error if any part of the input is invalid for this bean.
+// pending holds the new versions of other beans updated along with b, for the
+// workflow guards (nil when b is updated alone).
+func (r *CoreResolver) applyUpdate(b *bean.Bean, input model.UpdateBeanInput, pending map[string]*bean.Bean) error {
 	// Validate body and bodyMod are mutually exclusive
 	if input.Body != nil && input.BodyMod != nil {
-		return nil, fmt.Errorf("cannot specify both body and bodyMod")
+		return fmt.Errorf("cannot specify both body and bodyMod")
 	}
 
 	// Validate tags and addTags/removeTags are mutually exclusive
 	if input.Tags != nil && (input.AddTags != nil || input.RemoveTags != nil) {
-		return nil, fmt.Errorf("cannot specify both tags and addTags/removeTags")
+		return fmt.Errorf("cannot specify both tags and addTags/removeTags")
+	}
+
+	// Validate assignees and addAssignees/removeAssignees are mutually exclusive
+	if input.Assignees != nil && (input.AddAssignees != nil || input.RemoveAssignees != nil) {
+		return fmt.Errorf("cannot specify both assignees and addAssignees/removeAssignees")
+	}
+
+	if err := r.ValidateStatusTypePriority(input.Status, input.Type, input.Priority); err != nil {
+		return err
+	}
+
+	// Enforce workflow transitions and guards
+	if input.Status != nil {
+		if err := r.Core.CheckTransitionWith(b, *input.Status, pending); err != nil {
+			return err
+		}
 	}
 
 	// Update fields if provided
@@ -146,7 +456,7 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 			for i, replaceOp := range input.BodyMod.Replace {
 				newBody, err := bean.ReplaceOnce(workingBody, replaceOp.Old, replaceOp.New)
 				if err != nil {
-					return nil, fmt.Errorf("replacement %d failed: %w", i, err)
+					return fmt.Errorf("replacement %d failed: %w", i, err)
 				}
 				workingBody = newBody
 			}
@@ -191,17 +501,74 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 		b.Tags = newTags
 	}
 
+	// Handle assignees
+	if input.Assignees != nil {
+		b.Assignees = nil
+		for _, a := range input.Assignees {
+			b.AddAssignee(a)
+		}
+	}
+	for _, a := range input.AddAssignees {
+		b.AddAssignee(a)
+	}
+	for _, a := range input.RemoveAssignees {
+		b.RemoveAssignee(a)
+	}
+
+	// Handle dates
+	if input.Start != nil {
+		d, err := parseOptionalDate(*input.Start)
+		if err != nil {
+			return fmt.Errorf("start: %w", err)
+		}
+		b.Start = d
+	}
+	if input.Due != nil {
+		d, err := parseOptionalDate(*input.Due)
+		if err != nil {
+			return fmt.Errorf("due: %w", err)
+		}
+		b.Due = d
+	}
+	if err := validateDateRange(b); err != nil {
+		return err
+	}
+
+	// Handle estimate
+	if input.Estimate != nil {
+		if err := setEstimate(b, *input.Estimate); err != nil {
+			return err
+		}
+	}
+
+	// Handle recurrence rule
+	if input.Recur != nil {
+		if err := setRecur(b, *input.Recur); err != nil {
+			return err
+		}
+	}
+
+	// Handle custom fields
+	if input.SetFields != nil {
+		if err := r.ValidateAndSetFields(b, input.SetFields); err != nil {
+			return err
+		}
+	}
+	if input.RemoveFields != nil {
+		r.RemoveFields(b, input.RemoveFields)
+	}
+
 	// Handle parent relationship
 	if input.Parent != nil {
 		if err := r.ValidateAndSetParent(b, *input.Parent); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Handle blocking relationships
 	if input.AddBlocking != nil {
 		if err := r.ValidateAndAddBlocking(b, input.AddBlocking); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	if input.RemoveBlocking != nil {
@@ -211,20 +578,35 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 	// Handle blocked-by relationships
 	if input.AddBlockedBy != nil {
 		if err := r.ValidateAndAddBlockedBy(b, input.AddBlockedBy); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	if input.RemoveBlockedBy != nil {
 		r.RemoveBlockedByRelationships(b, input.RemoveBlockedBy)
 	}
 
-	// ETag validation now happens inside Update() under write lock.
-	// If the bean is linked to a worktree, Core auto-routes the write there.
-	if err := r.Core.Update(b, input.IfMatch, opts...); err != nil {
-		return nil, err
+	// Handle links; a link type may imply a status (e.g. a duplicate is
+	// scrapped) unless a status was given or the bean is already archived
+	if input.AddLinks != nil {
+		impliedStatus, err := r.ValidateAndAddLinks(b, input.AddLinks)
+		if err != nil {
+			return err
+		}
+		cfg := r.config()
+		if impliedStatus != "" && input.Status == nil && cfg.IsValidStatus(impliedStatus) && !cfg.IsArchiveStatus(b.Status) {
+			if err := r.Core.CheckTransition(b, impliedStatus); err != nil {
+				return err
+			}
+			b.Status = impliedStatus
+		}
+	}
+	if input.RemoveLinks != nil {
+		if err := r.RemoveLinkRelationships(b, input.RemoveLinks); err != nil {
+			return err
+		}
 	}
 
-	return b, nil
+	return nil
 }
 
 // DeleteBean removes a bean and its incoming links.
@@ -379,6 +761,34 @@ func (r *CoreResolver) RemoveBlockedBy(ctx context.Context, id string, targetID
 	return b, nil
 }
 
+// AddComment appends a comment to a bean's thread. If author is nil or empty,
+// the current user is used.
+func (r *CoreResolver) AddComment(ctx context.Context, id string, body string, author *string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ""
+	if author != nil {
+		name = strings.TrimSpace(*author)
+	}
+	if name == "" {
+		name = r.Core.CurrentUser()
+	}
+	if name == "" {
+		return nil, fmt.Errorf("cannot determine comment author: pass an author, set %s, or configure git user.email", beancore.UserEnvVar)
+	}
+
+	if _, err := b.AddComment(name, body, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
 // ArchiveBean archives a bean.
 func (r *CoreResolver) ArchiveBean(ctx context.Context, id string) (bool, error) {
 	if err := r.Core.Archive(id); err != nil {
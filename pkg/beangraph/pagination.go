@@ -0,0 +1,156 @@
+package beangraph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+)
+
+// SortKeys converts GraphQL sort keys to bean.SortKeys. A key without a
+// direction gets the same default as the CLI's --sort flag.
+func SortKeys(orderBy []*model.BeanOrder) []bean.SortKey {
+	keys := make([]bean.SortKey, 0, len(orderBy))
+	for _, o := range orderBy {
+		// Enum values are the upper-cased names of bean.SortOrders
+		key, _ := bean.ParseSort(strings.ToLower(string(o.Field)))
+		if o.Direction != nil {
+			key.Descending = *o.Direction == model.SortDirectionDesc
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// sortBeans sorts beans by orderBy, or in the default order if it is empty.
+func (r *CoreResolver) sortBeans(beans []*bean.Bean, orderBy []*model.BeanOrder) {
+	cfg := r.config()
+	bean.SortByKeys(beans, SortKeys(orderBy), cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+}
+
+// limitBeans returns at most first beans (all of them if first is nil).
+func limitBeans(beans []*bean.Bean, first *int) ([]*bean.Bean, error) {
+	if first == nil {
+		return beans, nil
+	}
+	if *first < 0 {
+		return nil, fmt.Errorf("first must not be negative")
+	}
+	return beans[:min(*first, len(beans))], nil
+}
+
+// orderAndLimit sorts beans by orderBy and keeps at most first of them.
+func (r *CoreResolver) orderAndLimit(beans []*bean.Bean, orderBy []*model.BeanOrder, first *int) ([]*bean.Bean, error) {
+	r.sortBeans(beans, orderBy)
+	return limitBeans(beans, first)
+}
+
+// BeansPage returns the page of beans matching the filter selected by
+// orderBy, first, and after.
+func (r *CoreResolver) BeansPage(ctx context.Context, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int, after *string) ([]*bean.Bean, error) {
+	conn, err := r.BeansConnection(ctx, filter, orderBy, first, after)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Nodes, nil
+}
+
+// BeansConnection returns a page of the beans matching the filter, sorted by
+// orderBy: at most first beans (all if nil), starting after the position of
+// the cursor after. The position doesn't depend on the cursor's bean still
+// being there, so paging carries on if it was changed or deleted meanwhile.
+func (r *CoreResolver) BeansConnection(ctx context.Context, filter *model.BeanFilter, orderBy []*model.BeanOrder, first *int, after *string) (*model.BeanConnection, error) {
+	beans, err := r.Beans(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	r.sortBeans(beans, orderBy)
+
+	start := 0
+	if after != nil && *after != "" {
+		pos, err := decodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+		cfg := r.config()
+		start = bean.SearchAfter(beans, pos, SortKeys(orderBy), cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	}
+	page, err := limitBeans(beans[start:], first)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &model.BeanConnection{
+		Edges:      make([]*model.BeanEdge, len(page)),
+		Nodes:      page,
+		TotalCount: len(beans),
+		PageInfo: &model.PageInfo{
+			HasNextPage:     start+len(page) < len(beans),
+			HasPreviousPage: start > 0,
+		},
+	}
+	for i, b := range page {
+		conn.Edges[i] = &model.BeanEdge{Cursor: encodeCursor(b), Node: b}
+	}
+	if len(page) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(page)-1].Cursor
+	}
+	return conn, nil
+}
+
+// cursor is the position of a bean in the sort order: the fields any order
+// can look at, including the ID that breaks ties.
+type cursor struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status,omitempty"`
+	Order     string     `json:"order,omitempty"`
+	Priority  string     `json:"priority,omitempty"`
+	Type      string     `json:"type,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Due       *bean.Date `json:"due,omitempty"`
+}
+
+// encodeCursor returns the opaque pagination cursor for a bean.
+func encodeCursor(b *bean.Bean) string {
+	data, _ := json.Marshal(cursor{
+		ID:        b.ID,
+		Status:    b.Status,
+		Order:     b.Order,
+		Priority:  b.Priority,
+		Type:      b.Type,
+		Title:     b.Title,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+		Due:       b.Due,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns the position a pagination cursor stands for, as a bean
+// with only the sort fields set.
+func decodeCursor(s string) (*bean.Bean, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || json.Unmarshal(data, &c) != nil || c.ID == "" {
+		return nil, fmt.Errorf("invalid cursor %q", s)
+	}
+	return &bean.Bean{
+		ID:        c.ID,
+		Status:    c.Status,
+		Order:     c.Order,
+		Priority:  c.Priority,
+		Type:      c.Type,
+		Title:     c.Title,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		Due:       c.Due,
+	}, nil
+}
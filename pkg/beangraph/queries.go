@@ -18,6 +18,26 @@ func (r *CoreResolver) Bean(ctx context.Context, id string) (*bean.Bean, error)
 	return b, err
 }
 
+// LoadErrors returns the bean files that could not be loaded.
+func (r *CoreResolver) LoadErrors(ctx context.Context) ([]*beancore.LoadError, error) {
+	errs := r.Core.LoadErrors()
+	result := make([]*beancore.LoadError, len(errs))
+	for i := range errs {
+		result[i] = &errs[i]
+	}
+	return result, nil
+}
+
+// SearchBeans runs a full-text search and returns scored hits with snippets
+// and facet counts.
+func (r *CoreResolver) SearchBeans(ctx context.Context, query string, limit *int) (*beancore.SearchResults, error) {
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+	return r.Core.SearchResults(query, n)
+}
+
 // Beans returns a filtered, sorted list of beans.
 func (r *CoreResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]*bean.Bean, error) {
 	var beans []*bean.Bean
@@ -5,6 +5,8 @@ import (
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/hmans/beans/pkg/config"
 )
 
 // CoreResolver implements the core bean GraphQL operations (CRUD, relationships,
@@ -154,3 +156,178 @@ func (r *CoreResolver) RemoveBlockedByRelationships(b *bean.Bean, targetIDs []st
 		b.RemoveBlockedBy(normalizedTargetID)
 	}
 }
+
+// ValidateAndAddLinks validates and adds links of the configured link types.
+// Returns the status implied by the added links (e.g. scrapped for a
+// duplicates link), or "" if none.
+func (r *CoreResolver) ValidateAndAddLinks(b *bean.Bean, links []*model.LinkInput) (string, error) {
+	cfg := r.config()
+	impliedStatus := ""
+	for _, l := range links {
+		lt, inverse := cfg.ResolveLinkName(l.Type)
+		if lt == nil {
+			return "", fmt.Errorf("invalid link type: %s (must be %s)", l.Type, cfg.LinkTypeList())
+		}
+
+		// Normalise short ID to full ID
+		targetID, _ := r.Core.NormalizeID(l.Target)
+
+		// Inverse links are stored on the other bean
+		if inverse {
+			return "", fmt.Errorf("%s links are stored on the other bean: add a %s link to %s instead", l.Type, lt.Name, targetID)
+		}
+
+		// Validate: cannot link to itself
+		if targetID == b.ID {
+			return "", fmt.Errorf("bean cannot link to itself")
+		}
+
+		// Validate: target must exist
+		target, err := r.Core.Get(targetID)
+		if err != nil {
+			return "", fmt.Errorf("link target bean not found: %s", l.Target)
+		}
+
+		if lt.Symmetric {
+			// Already linked from the other side
+			if target.HasRelation(lt.Name, b.ID) {
+				continue
+			}
+		} else if cycle := r.Core.DetectCycle(b.ID, lt.Name, targetID); cycle != nil {
+			return "", fmt.Errorf("adding %s link would create cycle: %v", lt.Name, cycle)
+		}
+
+		if b.AddRelation(lt.Name, targetID) && lt.Status != "" {
+			impliedStatus = lt.Status
+		}
+	}
+	return impliedStatus, nil
+}
+
+// RemoveLinkRelationships removes links from the bean. Links of types that
+// are no longer configured can be removed by their stored name.
+func (r *CoreResolver) RemoveLinkRelationships(b *bean.Bean, links []*model.LinkInput) error {
+	cfg := r.config()
+	for _, l := range links {
+		targetID, _ := r.Core.NormalizeID(l.Target)
+		if b.RemoveRelation(l.Type, targetID) {
+			continue
+		}
+
+		lt, inverse := cfg.ResolveLinkName(l.Type)
+		switch {
+		case lt == nil:
+			return fmt.Errorf("invalid link type: %s (must be %s)", l.Type, cfg.LinkTypeList())
+		case inverse:
+			return fmt.Errorf("%s links are stored on the other bean: remove the %s link from %s instead", l.Type, lt.Name, targetID)
+		case lt.Symmetric:
+			// The link may be stored on the other bean
+			if target, err := r.Core.Get(targetID); err == nil && target.HasRelation(lt.Name, b.ID) {
+				return fmt.Errorf("the %s link is stored on %s: remove it from there instead", lt.Name, targetID)
+			}
+		}
+	}
+	return nil
+}
+
+// config returns the project config, or the defaults if the core has none.
+func (r *CoreResolver) config() *config.Config {
+	if cfg := r.Core.Config(); cfg != nil {
+		return cfg
+	}
+	return config.Default()
+}
+
+// ValidateAndSetFields validates custom field values against the config and sets
+// them on the bean in canonical form. An empty value removes the field.
+func (r *CoreResolver) ValidateAndSetFields(b *bean.Bean, fields []*model.FieldInput) error {
+	cfg := r.Core.Config()
+	if cfg == nil {
+		return fmt.Errorf("custom fields require a configuration")
+	}
+	for _, f := range fields {
+		if f.Value == "" {
+			if cfg.GetField(f.Name) == nil {
+				return fmt.Errorf("unknown field: %s", f.Name)
+			}
+			b.RemoveField(f.Name)
+			continue
+		}
+		value, err := cfg.NormalizeFieldValue(f.Name, f.Value)
+		if err != nil {
+			return err
+		}
+		b.SetField(f.Name, value)
+	}
+	return nil
+}
+
+// RemoveFields removes custom fields from the bean by name.
+func (r *CoreResolver) RemoveFields(b *bean.Bean, names []string) {
+	for _, name := range names {
+		b.RemoveField(name)
+	}
+}
+
+// ValidateStatusTypePriority checks status, type and priority values against the
+// configured statuses, types and priorities. Nil values are not checked.
+func (r *CoreResolver) ValidateStatusTypePriority(status, beanType, priority *string) error {
+	cfg := r.Core.Config()
+	if cfg == nil {
+		return nil
+	}
+	if status != nil && !cfg.IsValidStatus(*status) {
+		return fmt.Errorf("invalid status: %s (must be %s)", *status, cfg.StatusList())
+	}
+	if beanType != nil && !cfg.IsValidType(*beanType) {
+		return fmt.Errorf("invalid type: %s (must be %s)", *beanType, cfg.TypeList())
+	}
+	if priority != nil && !cfg.IsValidPriority(*priority) {
+		return fmt.Errorf("invalid priority: %s (must be %s)", *priority, cfg.PriorityList())
+	}
+	return nil
+}
+
+// parseOptionalDate parses a start or due date input. An empty value clears the
+// date (returns nil).
+func parseOptionalDate(value string) (*bean.Date, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := bean.ParseDate(value, bean.Today())
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// validateDateRange checks that the bean's start date is not after its due date.
+func validateDateRange(b *bean.Bean) error {
+	if b.Start != nil && b.Due != nil && b.Start.After(b.Due.Time) {
+		return fmt.Errorf("start date %s is after due date %s", b.Start, b.Due)
+	}
+	return nil
+}
+
+// setEstimate sets the bean's estimate, rejecting negative values. Zero clears it.
+func setEstimate(b *bean.Bean, estimate float64) error {
+	if estimate < 0 {
+		return fmt.Errorf("estimate must not be negative: %v", estimate)
+	}
+	b.Estimate = estimate
+	return nil
+}
+
+// setRecur sets the bean's recurrence rule in canonical form. Empty clears it.
+func setRecur(b *bean.Bean, recur string) error {
+	if recur == "" {
+		b.Recur = ""
+		return nil
+	}
+	rule, err := bean.ParseRecurrence(recur)
+	if err != nil {
+		return err
+	}
+	b.Recur = rule.String()
+	return nil
+}
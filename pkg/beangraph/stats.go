@@ -0,0 +1,174 @@
+package beangraph
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+)
+
+// statsGroup accumulates the counts for one combination of groupBy values.
+type statsGroup struct {
+	values    []string
+	count     int
+	estimate  float64
+	estimated bool
+}
+
+func (g *statsGroup) add(b *bean.Bean) {
+	g.count++
+	if b.Estimate > 0 {
+		g.estimate += b.Estimate
+		g.estimated = true
+	}
+}
+
+func (g *statsGroup) estimatePtr() *float64 {
+	if !g.estimated {
+		return nil
+	}
+	return &g.estimate
+}
+
+// BeanStats counts the beans matching the filter, in total and per
+// combination of groupBy values.
+func (r *CoreResolver) BeanStats(ctx context.Context, filter *model.BeanFilter, groupBy []model.BeanStatsGroupBy) (*model.BeanStats, error) {
+	for i, g := range groupBy {
+		if slices.Contains(groupBy[:i], g) {
+			return nil, fmt.Errorf("duplicate groupBy %s", g)
+		}
+	}
+
+	beans, err := r.Beans(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var total statsGroup
+	groups := make(map[string]*statsGroup)
+	for _, b := range beans {
+		total.add(b)
+		if len(groupBy) == 0 {
+			continue
+		}
+		for _, values := range statsGroupValues(b, groupBy) {
+			key := strings.Join(values, "\x00")
+			g, ok := groups[key]
+			if !ok {
+				g = &statsGroup{values: values}
+				groups[key] = g
+			}
+			g.add(b)
+		}
+	}
+
+	sorted := make([]*statsGroup, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	r.sortStatsGroups(sorted, groupBy)
+
+	stats := &model.BeanStats{
+		Count:    total.count,
+		Estimate: total.estimatePtr(),
+		Groups:   make([]*model.BeanStatsGroup, len(sorted)),
+	}
+	for i, g := range sorted {
+		group := &model.BeanStatsGroup{Count: g.count, Estimate: g.estimatePtr()}
+		for j, by := range groupBy {
+			value := nilIfEmpty(g.values[j])
+			switch by {
+			case model.BeanStatsGroupByStatus:
+				group.Status = value
+			case model.BeanStatsGroupByType:
+				group.Type = value
+			case model.BeanStatsGroupByPriority:
+				group.Priority = value
+			case model.BeanStatsGroupByTag:
+				group.Tag = value
+			case model.BeanStatsGroupByParent:
+				group.Parent = value
+			}
+		}
+		stats.Groups[i] = group
+	}
+	return stats, nil
+}
+
+// statsGroupValues returns the combinations of groupBy values a bean counts
+// toward: one, unless it has several tags and is grouped by tag. Missing
+// values are empty strings.
+func statsGroupValues(b *bean.Bean, groupBy []model.BeanStatsGroupBy) [][]string {
+	combinations := [][]string{{}}
+	for _, by := range groupBy {
+		var values []string
+		switch by {
+		case model.BeanStatsGroupByStatus:
+			values = []string{b.Status}
+		case model.BeanStatsGroupByType:
+			values = []string{b.Type}
+		case model.BeanStatsGroupByPriority:
+			values = []string{b.Priority}
+		case model.BeanStatsGroupByTag:
+			values = b.Tags
+		case model.BeanStatsGroupByParent:
+			values = []string{b.Parent}
+		}
+		if len(values) == 0 {
+			values = []string{""}
+		}
+
+		next := make([][]string, 0, len(combinations)*len(values))
+		for _, c := range combinations {
+			for _, v := range values {
+				next = append(next, append(slices.Clone(c), v))
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// sortStatsGroups sorts groups by each groupBy field in turn: statuses, types,
+// and priorities in configured order, tags and parents alphabetically, and
+// missing values last.
+func (r *CoreResolver) sortStatsGroups(groups []*statsGroup, groupBy []model.BeanStatsGroupBy) {
+	cfg := r.config()
+	orders := make([][]string, len(groupBy))
+	for i, by := range groupBy {
+		switch by {
+		case model.BeanStatsGroupByStatus:
+			orders[i] = cfg.StatusNames()
+		case model.BeanStatsGroupByType:
+			orders[i] = cfg.TypeNames()
+		case model.BeanStatsGroupByPriority:
+			orders[i] = cfg.PriorityNames()
+		}
+	}
+	rank := func(order []string, value string) int {
+		if value == "" {
+			return len(order) + 1
+		}
+		if i := slices.Index(order, value); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+
+	slices.SortFunc(groups, func(a, b *statsGroup) int {
+		for i := range groupBy {
+			av, bv := a.values[i], b.values[i]
+			if c := cmp.Compare(rank(orders[i], av), rank(orders[i], bv)); c != 0 {
+				return c
+			}
+			if c := strings.Compare(av, bv); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
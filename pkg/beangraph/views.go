@@ -0,0 +1,194 @@
+package beangraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// ParseFieldFilters parses custom field filters as used by `beans list --field`
+// and views. "key=value" matches beans where the field equals value (repeat the
+// key to match any of several values), and a bare "key" matches beans where the
+// field is set.
+func ParseFieldFilters(values []string) ([]*model.FieldFilter, error) {
+	var filters []*model.FieldFilter
+	byName := make(map[string]*model.FieldFilter)
+	for _, v := range values {
+		name, value, hasValue := strings.Cut(v, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("invalid field filter %q (expected key or key=value)", v)
+		}
+		f, ok := byName[name]
+		if !ok {
+			f = &model.FieldFilter{Name: name}
+			byName[name] = f
+			filters = append(filters, f)
+		}
+		if hasValue {
+			f.Values = append(f.Values, value)
+		} else {
+			isSet := true
+			f.IsSet = &isSet
+		}
+	}
+	return filters, nil
+}
+
+// ApplyReadyFilter narrows a filter to beans that are available to start: not
+// blocked, not in-progress, draft, or in an archive status, and without an
+// implicit terminal status inherited from a completed or scrapped ancestor.
+func ApplyReadyFilter(filter *model.BeanFilter, cfg *config.Config) {
+	isBlocked := false
+	excludeImplicitTerminal := true
+	filter.IsBlocked = &isBlocked
+	filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "draft")
+	filter.ExcludeStatus = append(filter.ExcludeStatus, cfg.ArchiveStatusNames()...)
+	filter.ExcludeImplicitTerminal = &excludeImplicitTerminal
+}
+
+// ViewFilter builds the BeanFilter for a configured view. currentUser is used
+// for views with me: true, and today anchors relative due dates.
+func ViewFilter(cfg *config.Config, view *config.ViewConfig, currentUser string, today bean.Date) (*model.BeanFilter, error) {
+	if _, err := bean.ParseSort(view.Sort); err != nil {
+		return nil, fmt.Errorf("view %s: %w", view.Name, err)
+	}
+	filter, err := ConfigFilter(cfg, &view.Filter, currentUser, today)
+	if err != nil {
+		return nil, fmt.Errorf("view %s: %w", view.Name, err)
+	}
+	return filter, nil
+}
+
+// ConfigFilter builds the BeanFilter for a filter declared in .beans.yml (by a
+// view or a webhook). currentUser is used for me: true, and today anchors
+// relative due dates.
+func ConfigFilter(cfg *config.Config, f *config.ViewFilter, currentUser string, today bean.Date) (*model.BeanFilter, error) {
+	filter := &model.BeanFilter{
+		Status:          slices.Clone(f.Status),
+		ExcludeStatus:   slices.Clone(f.ExcludeStatus),
+		Type:            slices.Clone(f.Type),
+		ExcludeType:     slices.Clone(f.ExcludeType),
+		Priority:        slices.Clone(f.Priority),
+		ExcludePriority: slices.Clone(f.ExcludePriority),
+		Tags:            slices.Clone(f.Tags),
+		ExcludeTags:     slices.Clone(f.ExcludeTags),
+		Assignee:        slices.Clone(f.Assignee),
+	}
+
+	if f.Search != "" {
+		filter.Search = &f.Search
+	}
+	if f.Me {
+		if currentUser == "" {
+			return nil, errors.New("cannot determine current user for me: true (set BEANS_USER or git user.email)")
+		}
+		filter.Assignee = append(filter.Assignee, currentUser)
+	}
+	if f.Unassigned {
+		filter.NoAssignee = &f.Unassigned
+	}
+
+	if f.DueBefore != "" {
+		d, err := bean.ParseDate(f.DueBefore, today)
+		if err != nil {
+			return nil, fmt.Errorf("due_before: %w", err)
+		}
+		filter.DueBefore = &d
+	}
+	if f.DueAfter != "" {
+		d, err := bean.ParseDate(f.DueAfter, today)
+		if err != nil {
+			return nil, fmt.Errorf("due_after: %w", err)
+		}
+		filter.DueAfter = &d
+	}
+	if f.Overdue {
+		filter.IsOverdue = &f.Overdue
+	}
+
+	if len(f.Fields) > 0 {
+		fields, err := ParseFieldFilters(f.Fields)
+		if err != nil {
+			return nil, err
+		}
+		filter.Fields = fields
+	}
+
+	if f.HasParent {
+		filter.HasParent = &f.HasParent
+	}
+	if f.NoParent {
+		filter.NoParent = &f.NoParent
+	}
+	if f.Parent != "" {
+		filter.ParentID = &f.Parent
+	}
+	if f.HasBlocking {
+		filter.HasBlocking = &f.HasBlocking
+	}
+	if f.NoBlocking {
+		filter.NoBlocking = &f.NoBlocking
+	}
+	filter.HasLink = f.HasLink
+	filter.NoLink = f.NoLink
+	if f.IsBlocked {
+		filter.IsBlocked = &f.IsBlocked
+	}
+	if f.Ready {
+		ApplyReadyFilter(filter, cfg)
+	}
+
+	return filter, nil
+}
+
+// Views returns all views declared in the configuration.
+func (r *CoreResolver) Views(ctx context.Context) ([]*config.ViewConfig, error) {
+	cfg := r.Core.Config()
+	views := make([]*config.ViewConfig, len(cfg.Views))
+	for i := range cfg.Views {
+		views[i] = &cfg.Views[i]
+	}
+	return views, nil
+}
+
+// View returns a declared view by name, or nil if not found.
+func (r *CoreResolver) View(ctx context.Context, name string) (*config.ViewConfig, error) {
+	return r.Core.Config().GetView(name), nil
+}
+
+// ViewDescription returns the view's description, or nil if unset.
+func (r *CoreResolver) ViewDescription(ctx context.Context, obj *config.ViewConfig) (*string, error) {
+	return nilIfEmpty(obj.Description), nil
+}
+
+// ViewSort returns the view's sort order, or nil for the default order.
+func (r *CoreResolver) ViewSort(ctx context.Context, obj *config.ViewConfig) (*string, error) {
+	return nilIfEmpty(obj.Sort), nil
+}
+
+// ViewFormat returns the view's output format, defaulting to tree.
+func (r *CoreResolver) ViewFormat(ctx context.Context, obj *config.ViewConfig) (string, error) {
+	return obj.GetFormat(), nil
+}
+
+// ViewBeans returns the beans matched by a view, in the view's sort order.
+func (r *CoreResolver) ViewBeans(ctx context.Context, obj *config.ViewConfig) ([]*bean.Bean, error) {
+	cfg := r.Core.Config()
+	filter, err := ViewFilter(cfg, obj, r.Core.CurrentUser(), bean.Today())
+	if err != nil {
+		return nil, err
+	}
+	beans, err := r.Beans(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	bean.SortBy(beans, obj.Sort, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	return beans, nil
+}
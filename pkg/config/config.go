@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,29 +22,31 @@ const (
 	LegacyConfigFile = "config.yaml"
 	// DefaultServerPort is the default port for the web server
 	DefaultServerPort = 8080
+	// FormatVersion is the version of the beans data format written by this
+	// version of beans. Older data is upgraded by `beans migrate`.
+	FormatVersion = 2
 )
 
-// DefaultStatuses defines the hardcoded status configuration.
-// Statuses are not configurable - they are hardcoded like types.
+// DefaultStatuses defines the status configuration used when .beans.yml doesn't declare any.
 // Order determines sort priority: in-progress first (active work), then todo, draft, and done states last.
 var DefaultStatuses = []StatusConfig{
-	{Name: "in-progress", Color: "yellow", Description: "Currently being worked on"},
+	{Name: "in-progress", Color: "yellow", Active: true, Description: "Currently being worked on"},
 	{Name: "todo", Color: "green", Description: "Ready to be worked on"},
 	{Name: "draft", Color: "blue", Description: "Needs refinement before it can be worked on"},
 	{Name: "completed", Color: "gray", Archive: true, Description: "Finished successfully"},
-	{Name: "scrapped", Color: "gray", Archive: true, Description: "Will not be done"},
+	{Name: "scrapped", Color: "gray", Archive: true, Cancelled: true, Description: "Will not be done"},
 }
 
-// DefaultTypes defines the default type configuration.
+// DefaultTypes defines the type configuration used when .beans.yml doesn't declare any.
 var DefaultTypes = []TypeConfig{
-	{Name: "milestone", Color: "cyan", Description: "A target release or checkpoint; group work that should ship together"},
-	{Name: "epic", Color: "purple", Description: "A thematic container for related work; should have child beans, not be worked on directly"},
-	{Name: "bug", Color: "red", Description: "Something that is broken and needs fixing"},
-	{Name: "feature", Color: "green", Description: "A user-facing capability or enhancement"},
-	{Name: "task", Color: "blue", Description: "A concrete piece of work to complete (eg. a chore, or a sub-task for a feature)"},
+	{Name: "milestone", Color: "cyan", NoParent: true, Description: "A target release or checkpoint; group work that should ship together"},
+	{Name: "epic", Color: "purple", Parents: []string{"milestone"}, Description: "A thematic container for related work; should have child beans, not be worked on directly"},
+	{Name: "bug", Color: "red", Parents: []string{"milestone", "epic", "feature"}, Description: "Something that is broken and needs fixing"},
+	{Name: "feature", Color: "green", Parents: []string{"milestone", "epic"}, Description: "A user-facing capability or enhancement"},
+	{Name: "task", Color: "blue", Parents: []string{"milestone", "epic", "feature"}, Description: "A concrete piece of work to complete (eg. a chore, or a sub-task for a feature)"},
 }
 
-// DefaultPriorities defines the hardcoded priority configuration.
+// DefaultPriorities defines the priority configuration used when .beans.yml doesn't declare any.
 // Priorities are ordered from highest to lowest urgency.
 var DefaultPriorities = []PriorityConfig{
 	{Name: "critical", Color: "red", Description: "Urgent, blocking work. When possible, address immediately"},
@@ -58,12 +62,22 @@ type StatusConfig struct {
 	Color       string `yaml:"color"`
 	Archive     bool   `yaml:"archive,omitempty"`
 	Description string `yaml:"description,omitempty"`
+	// Cancelled marks an archive status for work that won't be done (e.g.
+	// scrapped), as opposed to work that was completed.
+	Cancelled bool `yaml:"cancelled,omitempty"`
+	// Active marks a status for work that is underway (e.g. in-progress).
+	Active bool `yaml:"active,omitempty"`
 }
 
-// TypeConfig defines a single bean type with its display color.
+// TypeConfig defines a single bean type with its display color and parent rules.
 type TypeConfig struct {
-	Name        string `yaml:"name"`
-	Color       string `yaml:"color"`
+	Name  string `yaml:"name"`
+	Color string `yaml:"color"`
+	// Parents lists the types a bean of this type may have as parent.
+	// Empty means any type is allowed.
+	Parents []string `yaml:"parents,omitempty"`
+	// NoParent forbids beans of this type from having a parent at all.
+	NoParent    bool   `yaml:"no_parent,omitempty"`
 	Description string `yaml:"description,omitempty"`
 }
 
@@ -136,6 +150,15 @@ type AgentConfig struct {
 	// Valid values: "low", "medium", "high", "max".
 	// When omitted, new sessions start with no effort override (uses CLI default).
 	DefaultEffort string `yaml:"default_effort,omitempty"`
+
+	// AutoAssign controls whether beans are assigned to the agent when an agent
+	// session starts working on them.
+	// Default: true
+	AutoAssign *bool `yaml:"auto_assign,omitempty"`
+
+	// Assignee is the identity recorded as assignee for agent-worked beans.
+	// Default: "agent"
+	Assignee string `yaml:"assignee,omitempty"`
 }
 
 // ProjectConfig defines project-level settings.
@@ -157,7 +180,6 @@ type ServerConfig struct {
 }
 
 // Config holds the beans configuration.
-// Note: Statuses are no longer stored in config - they are hardcoded like types.
 type Config struct {
 	Project  ProjectConfig  `yaml:"project,omitempty"`
 	Beans    BeansConfig    `yaml:"beans"`
@@ -165,13 +187,41 @@ type Config struct {
 	Agent    AgentConfig    `yaml:"agent,omitempty"`
 	Server   ServerConfig   `yaml:"server,omitempty"`
 
+	// Statuses, Types and Priorities replace the built-in defaults when set.
+	// Order matters: it determines sort order and picker order.
+	Statuses   []StatusConfig   `yaml:"statuses,omitempty"`
+	Types      []TypeConfig     `yaml:"types,omitempty"`
+	Priorities []PriorityConfig `yaml:"priorities,omitempty"`
+
+	// Workflow restricts status transitions and declares guard rules.
+	Workflow WorkflowConfig `yaml:"workflow,omitempty"`
+
+	// Fields declares user-defined custom front matter fields for beans.
+	Fields []FieldConfig `yaml:"fields,omitempty"`
+
+	// Views declares named, saved bean queries.
+	Views []ViewConfig `yaml:"views,omitempty"`
+
+	// LinkTypes replaces the built-in link types (related, duplicates,
+	// supersedes) when set.
+	LinkTypes []LinkTypeConfig `yaml:"link_types,omitempty"`
+
+	// Webhooks declares HTTP endpoints notified of bean changes by `beans serve`.
+	Webhooks []WebhookConfig `yaml:"webhooks,omitempty"`
+
 	// configDir is the directory containing the config file (not serialized)
 	// Used to resolve relative paths
 	configDir string `yaml:"-"`
+
+	// fromFile is set when the config was read from a config file
+	fromFile bool `yaml:"-"`
 }
 
 // BeansConfig defines settings for bean creation.
 type BeansConfig struct {
+	// FormatVersion is the version of the data format (0 for data that
+	// predates format versioning)
+	FormatVersion int `yaml:"format_version,omitempty"`
 	// Path is the path to the beans directory (relative to config file location)
 	Path           string `yaml:"path,omitempty"`
 	Prefix         string `yaml:"prefix"`
@@ -185,6 +235,7 @@ type BeansConfig struct {
 func Default() *Config {
 	return &Config{
 		Beans: BeansConfig{
+			FormatVersion: FormatVersion,
 			Path:          DefaultBeansPath,
 			Prefix:        "",
 			IDLength:      4,
@@ -273,6 +324,7 @@ func Load(configPath string) (*Config, error) {
 
 	// Store the config directory for resolving relative paths
 	cfg.configDir = filepath.Dir(configPath)
+	cfg.fromFile = true
 
 	// Apply defaults for missing values
 	if cfg.Beans.Path == "" {
@@ -283,9 +335,12 @@ func Load(configPath string) (*Config, error) {
 	}
 	if cfg.Beans.DefaultStatus == "" {
 		cfg.Beans.DefaultStatus = "todo"
+		if !cfg.IsValidStatus("todo") {
+			cfg.Beans.DefaultStatus = cfg.GetStatuses()[0].Name
+		}
 	}
 	if cfg.Beans.DefaultType == "" {
-		cfg.Beans.DefaultType = DefaultTypes[0].Name
+		cfg.Beans.DefaultType = cfg.GetTypes()[0].Name
 	}
 
 	return &cfg, nil
@@ -333,6 +388,12 @@ func (c *Config) ConfigDir() string {
 	return c.configDir
 }
 
+// FromFile reports whether the config was read from a config file, rather
+// than being the defaults used when there is none.
+func (c *Config) FromFile() bool {
+	return c.fromFile
+}
+
 // SetConfigDir sets the config directory (for testing or when creating new configs).
 func (c *Config) SetConfigDir(dir string) {
 	c.configDir = dir
@@ -362,6 +423,94 @@ func (c *Config) Save(dir string) error {
 	return os.WriteFile(path, buf.Bytes(), 0644)
 }
 
+// SaveFormatVersion records the data format version in the config file,
+// leaving the rest of the file (including comments and indentation) as it is:
+// only the beans.format_version line is updated or inserted.
+func (c *Config) SaveFormatVersion(version int) error {
+	if c.configDir == "" {
+		return fmt.Errorf("no config file to record the format version in")
+	}
+	path := filepath.Join(c.configDir, ConfigFileName)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	// mappingValue returns the value node of a key, or nil if it's missing
+	mappingValue := func(m *yaml.Node, key string) *yaml.Node {
+		for i := 0; i+1 < len(m.Content); i += 2 {
+			if m.Content[i].Value == key {
+				return m.Content[i+1]
+			}
+		}
+		return nil
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	if n := len(lines); lines[n-1] == "" {
+		lines = lines[:n-1]
+	}
+	value := strconv.Itoa(version)
+
+	var beansNode *yaml.Node
+	if doc.Kind != 0 {
+		top := doc.Content[0]
+		if top.Kind != yaml.MappingNode {
+			return fmt.Errorf("parsing %s: expected a mapping", path)
+		}
+		beansNode = mappingValue(top, "beans")
+	}
+
+	switch {
+	case beansNode == nil:
+		// No beans section yet: append one, indented like the rest of the file
+		if n := len(lines); n > 0 && !strings.HasSuffix(lines[n-1], "\n") {
+			lines[n-1] += "\n"
+		}
+		lines = append(lines, "beans:\n", strings.Repeat(" ", yamlIndent(lines))+"format_version: "+value+"\n")
+	case beansNode.Kind != yaml.MappingNode || beansNode.Style&yaml.FlowStyle != 0 || len(beansNode.Content) == 0:
+		return fmt.Errorf("parsing %s: expected beans to be a block mapping", path)
+	default:
+		if versionNode := mappingValue(beansNode, "format_version"); versionNode != nil {
+			// Replace the value, keeping anything after it (e.g. a comment)
+			i := versionNode.Line - 1
+			line := lines[i]
+			start := versionNode.Column - 1
+			end := start + strings.IndexAny(line[start:]+" ", " \t\r\n#")
+			lines[i] = line[:start] + value + line[end:]
+		} else {
+			// Insert the key above the first one in the section, at its indent
+			first := beansNode.Content[0]
+			line := strings.Repeat(" ", first.Column-1) + "format_version: " + value + "\n"
+			lines = slices.Insert(lines, first.Line-1, line)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "")), 0644); err != nil {
+		return err
+	}
+
+	c.Beans.FormatVersion = version
+	return nil
+}
+
+// yamlIndent returns the indentation used by the first indented line of a
+// YAML file, or 4 (the indentation Save uses) if there is none.
+func yamlIndent(lines []string) int {
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if n := len(line) - len(trimmed); n > 0 && strings.TrimSpace(trimmed) != "" && !strings.HasPrefix(trimmed, "#") {
+			return n
+		}
+	}
+	return 4
+}
+
 // toYAMLNode builds a yaml.Node document tree with inline comments.
 func (c *Config) toYAMLNode() *yaml.Node {
 	// Helper to create a scalar node
@@ -386,6 +535,12 @@ func (c *Config) toYAMLNode() *yaml.Node {
 	// Build the beans mapping
 	beansMapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
 
+	if c.Beans.FormatVersion != 0 {
+		key := strNode("format_version")
+		key.HeadComment = "Version of the data format (upgraded by `beans migrate`)"
+		beansMapping.Content = append(beansMapping.Content, key, intNode(c.Beans.FormatVersion))
+	}
+
 	if c.Beans.Path != "" {
 		key := strNode("path")
 		key.HeadComment = "Directory where bean files are stored"
@@ -454,6 +609,16 @@ func (c *Config) toYAMLNode() *yaml.Node {
 		key.HeadComment = "Default mode for agent sessions (act, plan)"
 		agentMapping.Content = append(agentMapping.Content, key, strNode(string(c.Agent.DefaultMode)))
 	}
+	if c.Agent.AutoAssign != nil {
+		key := strNode("auto_assign")
+		key.HeadComment = "Assign beans to the agent when an agent session starts on them (true, false)"
+		agentMapping.Content = append(agentMapping.Content, key, scalar(fmt.Sprintf("%t", *c.Agent.AutoAssign), "!!bool"))
+	}
+	if c.Agent.Assignee != "" {
+		key := strNode("assignee")
+		key.HeadComment = "Assignee recorded for beans worked on by agents"
+		agentMapping.Content = append(agentMapping.Content, key, strNode(c.Agent.Assignee))
+	}
 	// Build the server mapping
 	serverMapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
 	if c.Server.Port != 0 {
@@ -486,6 +651,50 @@ func (c *Config) toYAMLNode() *yaml.Node {
 		topMapping.Content = append(topMapping.Content, strNode("server"), serverMapping)
 	}
 
+	// Custom statuses, types and priorities (only written when declared)
+	appendList := func(name, comment string, value any) {
+		node := &yaml.Node{}
+		if err := node.Encode(value); err == nil {
+			key := strNode(name)
+			key.HeadComment = comment
+			topMapping.Content = append(topMapping.Content, key, node)
+		}
+	}
+	if len(c.Statuses) > 0 {
+		appendList("statuses", "Statuses in sort order (archive: true marks a status as done, cancelled: true as dropped, active: true as underway)", c.Statuses)
+	}
+	if len(c.Types) > 0 {
+		appendList("types", "Bean types (parents: allowed parent types, no_parent: top-level only)", c.Types)
+	}
+	if len(c.Priorities) > 0 {
+		appendList("priorities", "Priorities from highest to lowest", c.Priorities)
+	}
+
+	if !c.Workflow.IsEmpty() {
+		appendList("workflow", "Allowed status transitions per type (\"*\" for all types) and guard rules", c.Workflow)
+	}
+
+	if len(c.Fields) > 0 {
+		fieldsNode := &yaml.Node{}
+		if err := fieldsNode.Encode(c.Fields); err == nil {
+			key := strNode("fields")
+			key.HeadComment = "Custom front matter fields (type: string, int, date, or enum)"
+			topMapping.Content = append(topMapping.Content, key, fieldsNode)
+		}
+	}
+
+	if len(c.Views) > 0 {
+		appendList("views", "Named views for 'beans list --view <name>' (filter, sort, format)", c.Views)
+	}
+
+	if len(c.LinkTypes) > 0 {
+		appendList("link_types", "Link types between beans (inverse: name seen from the target, status: set on the linking bean)", c.LinkTypes)
+	}
+
+	if len(c.Webhooks) > 0 {
+		appendList("webhooks", "Webhooks notified of bean changes by 'beans serve' (events, filter, secret)", c.Webhooks)
+	}
+
 	// Wrap in a document node
 	return &yaml.Node{
 		Kind:    yaml.DocumentNode,
@@ -493,42 +702,56 @@ func (c *Config) toYAMLNode() *yaml.Node {
 	}
 }
 
-// IsValidStatus returns true if the status is a valid hardcoded status.
-func (c *Config) IsValidStatus(status string) bool {
-	for _, s := range DefaultStatuses {
-		if s.Name == status {
-			return true
-		}
+// GetStatuses returns the configured statuses, or DefaultStatuses if none are declared.
+func (c *Config) GetStatuses() []StatusConfig {
+	if len(c.Statuses) > 0 {
+		return c.Statuses
 	}
-	return false
+	return DefaultStatuses
+}
+
+// GetTypes returns the configured types, or DefaultTypes if none are declared.
+func (c *Config) GetTypes() []TypeConfig {
+	if len(c.Types) > 0 {
+		return c.Types
+	}
+	return DefaultTypes
+}
+
+// GetPriorities returns the configured priorities, or DefaultPriorities if none are declared.
+func (c *Config) GetPriorities() []PriorityConfig {
+	if len(c.Priorities) > 0 {
+		return c.Priorities
+	}
+	return DefaultPriorities
+}
+
+// IsValidStatus returns true if the status is a configured status.
+func (c *Config) IsValidStatus(status string) bool {
+	return c.GetStatus(status) != nil
 }
 
 // StatusList returns a comma-separated list of valid statuses.
-// Statuses are hardcoded and not configurable.
 func (c *Config) StatusList() string {
-	names := make([]string, len(DefaultStatuses))
-	for i, s := range DefaultStatuses {
-		names[i] = s.Name
-	}
-	return strings.Join(names, ", ")
+	return strings.Join(c.StatusNames(), ", ")
 }
 
-// StatusNames returns a slice of valid status names.
-// Statuses are hardcoded and not configurable.
+// StatusNames returns a slice of valid status names in configured order.
 func (c *Config) StatusNames() []string {
-	names := make([]string, len(DefaultStatuses))
-	for i, s := range DefaultStatuses {
+	statuses := c.GetStatuses()
+	names := make([]string, len(statuses))
+	for i, s := range statuses {
 		names[i] = s.Name
 	}
 	return names
 }
 
 // GetStatus returns the StatusConfig for a given status name, or nil if not found.
-// Statuses are hardcoded and not configurable.
 func (c *Config) GetStatus(name string) *StatusConfig {
-	for i := range DefaultStatuses {
-		if DefaultStatuses[i].Name == name {
-			return &DefaultStatuses[i]
+	statuses := c.GetStatuses()
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
 		}
 	}
 	return nil
@@ -548,7 +771,8 @@ func (c *Config) GetDefaultType() string {
 }
 
 // IsArchiveStatus returns true if the given status is marked for archiving.
-// Statuses are hardcoded and not configurable.
+// Archive statuses are also treated as resolved (done) for blocking and
+// implicit status purposes.
 func (c *Config) IsArchiveStatus(name string) bool {
 	if s := c.GetStatus(name); s != nil {
 		return s.Archive
@@ -556,44 +780,74 @@ func (c *Config) IsArchiveStatus(name string) bool {
 	return false
 }
 
+// IsCompletedStatus returns true if the given status is an archive status
+// for completed work, i.e. one that isn't cancelled.
+func (c *Config) IsCompletedStatus(name string) bool {
+	s := c.GetStatus(name)
+	return s != nil && s.Archive && !s.Cancelled
+}
+
+// IsActiveStatus returns true if the given status is marked as work that is
+// underway.
+func (c *Config) IsActiveStatus(name string) bool {
+	s := c.GetStatus(name)
+	return s != nil && s.Active
+}
+
+// ArchiveStatusNames returns the names of all statuses marked for archiving.
+func (c *Config) ArchiveStatusNames() []string {
+	var names []string
+	for _, s := range c.GetStatuses() {
+		if s.Archive {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
 // GetType returns the TypeConfig for a given type name, or nil if not found.
-// Types are hardcoded and not configurable.
 func (c *Config) GetType(name string) *TypeConfig {
-	for i := range DefaultTypes {
-		if DefaultTypes[i].Name == name {
-			return &DefaultTypes[i]
+	types := c.GetTypes()
+	for i := range types {
+		if types[i].Name == name {
+			return &types[i]
 		}
 	}
 	return nil
 }
 
-// TypeNames returns a slice of valid type names.
-// Types are hardcoded and not configurable.
+// TypeNames returns a slice of valid type names in configured order.
 func (c *Config) TypeNames() []string {
-	names := make([]string, len(DefaultTypes))
-	for i, t := range DefaultTypes {
+	types := c.GetTypes()
+	names := make([]string, len(types))
+	for i, t := range types {
 		names[i] = t.Name
 	}
 	return names
 }
 
-// IsValidType returns true if the type is a valid hardcoded type.
+// IsValidType returns true if the type is a configured type.
 func (c *Config) IsValidType(typeName string) bool {
-	for _, t := range DefaultTypes {
-		if t.Name == typeName {
-			return true
-		}
-	}
-	return false
+	return c.GetType(typeName) != nil
 }
 
 // TypeList returns a comma-separated list of valid types.
 func (c *Config) TypeList() string {
-	names := make([]string, len(DefaultTypes))
-	for i, t := range DefaultTypes {
-		names[i] = t.Name
+	return strings.Join(c.TypeNames(), ", ")
+}
+
+// ValidParentTypes returns the types a bean of the given type may have as parent.
+// Returns nil if the type cannot have a parent. Types without explicit parent
+// rules (including unknown types) may have any configured type as parent.
+func (c *Config) ValidParentTypes(typeName string) []string {
+	t := c.GetType(typeName)
+	if t != nil && t.NoParent {
+		return nil
 	}
-	return strings.Join(names, ", ")
+	if t != nil && len(t.Parents) > 0 {
+		return t.Parents
+	}
+	return c.TypeNames()
 }
 
 // BeanColors holds resolved color information for rendering a bean
@@ -631,9 +885,10 @@ func (c *Config) GetBeanColors(status, typeName, priority string) BeanColors {
 
 // GetPriority returns the PriorityConfig for a given priority name, or nil if not found.
 func (c *Config) GetPriority(name string) *PriorityConfig {
-	for i := range DefaultPriorities {
-		if DefaultPriorities[i].Name == name {
-			return &DefaultPriorities[i]
+	priorities := c.GetPriorities()
+	for i := range priorities {
+		if priorities[i].Name == name {
+			return &priorities[i]
 		}
 	}
 	return nil
@@ -641,34 +896,80 @@ func (c *Config) GetPriority(name string) *PriorityConfig {
 
 // PriorityNames returns a slice of valid priority names in order from highest to lowest.
 func (c *Config) PriorityNames() []string {
-	names := make([]string, len(DefaultPriorities))
-	for i, p := range DefaultPriorities {
+	priorities := c.GetPriorities()
+	names := make([]string, len(priorities))
+	for i, p := range priorities {
 		names[i] = p.Name
 	}
 	return names
 }
 
-// IsValidPriority returns true if the priority is a valid hardcoded priority.
+// IsValidPriority returns true if the priority is a configured priority.
 // Empty string is valid (means no priority set).
 func (c *Config) IsValidPriority(priority string) bool {
 	if priority == "" {
 		return true
 	}
-	for _, p := range DefaultPriorities {
-		if p.Name == priority {
-			return true
-		}
-	}
-	return false
+	return c.GetPriority(priority) != nil
 }
 
 // PriorityList returns a comma-separated list of valid priorities.
 func (c *Config) PriorityList() string {
-	names := make([]string, len(DefaultPriorities))
-	for i, p := range DefaultPriorities {
-		names[i] = p.Name
+	return strings.Join(c.PriorityNames(), ", ")
+}
+
+// ValidateDefinitions checks the configured statuses, types and priorities
+// (including parent rules and defaults) and returns a list of human-readable
+// problems. Colors are validated separately by the caller.
+func (c *Config) ValidateDefinitions() []string {
+	var errs []string
+
+	checkNames := func(kind string, names []string) {
+		seen := make(map[string]bool)
+		for _, name := range names {
+			switch {
+			case name == "":
+				errs = append(errs, fmt.Sprintf("%s with empty name", kind))
+			case strings.ContainsAny(name, " \t,"):
+				errs = append(errs, fmt.Sprintf("invalid %s name %q: must not contain spaces or commas", kind, name))
+			case seen[name]:
+				errs = append(errs, fmt.Sprintf("%s %q is declared more than once", kind, name))
+			}
+			seen[name] = true
+		}
+	}
+	checkNames("status", c.StatusNames())
+	checkNames("type", c.TypeNames())
+	checkNames("priority", c.PriorityNames())
+
+	for _, s := range c.GetStatuses() {
+		if s.Cancelled && !s.Archive {
+			errs = append(errs, fmt.Sprintf("status %q is cancelled but not an archive status", s.Name))
+		}
+		if s.Active && s.Archive {
+			errs = append(errs, fmt.Sprintf("status %q cannot be both active and an archive status", s.Name))
+		}
+	}
+
+	for _, t := range c.GetTypes() {
+		if t.NoParent && len(t.Parents) > 0 {
+			errs = append(errs, fmt.Sprintf("type %q cannot set both parents and no_parent", t.Name))
+		}
+		for _, p := range t.Parents {
+			if !c.IsValidType(p) {
+				errs = append(errs, fmt.Sprintf("type %q lists unknown parent type %q", t.Name, p))
+			}
+		}
+	}
+
+	if !c.IsValidStatus(c.GetDefaultStatus()) {
+		errs = append(errs, fmt.Sprintf("default_status '%s' is not a valid status", c.GetDefaultStatus()))
+	}
+	if c.GetDefaultType() != "" && !c.IsValidType(c.GetDefaultType()) {
+		errs = append(errs, fmt.Sprintf("default_type '%s' is not a valid type", c.GetDefaultType()))
 	}
-	return strings.Join(names, ", ")
+
+	return errs
 }
 
 // boolPtr returns a pointer to the given bool value.
@@ -759,6 +1060,28 @@ func (c *Config) IsAgentEnabled() bool {
 	return *c.Agent.Enabled
 }
 
+// DefaultAgentAssignee is the assignee recorded for agent-worked beans when
+// agent.assignee is not configured.
+const DefaultAgentAssignee = "agent"
+
+// IsAgentAutoAssignEnabled returns whether beans are assigned to the agent when
+// an agent session starts on them. Returns true if not explicitly set.
+func (c *Config) IsAgentAutoAssignEnabled() bool {
+	if c.Agent.AutoAssign == nil {
+		return true
+	}
+	return *c.Agent.AutoAssign
+}
+
+// GetAgentAssignee returns the assignee recorded for agent-worked beans.
+// Returns DefaultAgentAssignee if not set.
+func (c *Config) GetAgentAssignee() string {
+	if c.Agent.Assignee == "" {
+		return DefaultAgentAssignee
+	}
+	return c.Agent.Assignee
+}
+
 // GetDefaultMode returns the configured default permission mode for agent sessions.
 // Returns "act" if not set or invalid. Also accepts "yolo" as a backwards-compatible alias.
 func (c *Config) GetDefaultMode() PermissionMode {
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -282,12 +283,11 @@ func TestLoadAppliesDefaults(t *testing.T) {
 	}
 }
 
-func TestStatusesAreHardcoded(t *testing.T) {
-	// Statuses are hardcoded and not configurable (like types)
-	// Verify that any config only uses hardcoded statuses
+func TestDefaultStatuses(t *testing.T) {
+	// Without declared statuses, the default statuses are used
 	cfg := Default()
 
-	// All hardcoded statuses should be valid
+	// All default statuses should be valid
 	hardcodedStatuses := []string{"draft", "todo", "in-progress", "completed", "scrapped"}
 	for _, status := range hardcodedStatuses {
 		if !cfg.IsValidStatus(status) {
@@ -379,9 +379,9 @@ func TestGetType(t *testing.T) {
 	})
 }
 
-func TestTypesAreHardcoded(t *testing.T) {
-	// Types are hardcoded and not stored in config
-	// Verify that saving and loading a config doesn't affect types
+func TestTypesDefaultWhenNotConfigured(t *testing.T) {
+	// Default types are not written to config
+	// Verify that saving and loading a config without declared types keeps the defaults
 
 	tmpDir := t.TempDir()
 
@@ -407,7 +407,7 @@ func TestTypesAreHardcoded(t *testing.T) {
 		t.Fatalf("Load() error = %v", err)
 	}
 
-	// Types should always come from DefaultTypes, not config
+	// Types should come from DefaultTypes
 	if len(loaded.TypeNames()) != 5 {
 		t.Errorf("len(TypeNames()) = %d, want 5", len(loaded.TypeNames()))
 	}
@@ -419,7 +419,7 @@ func TestTypesAreHardcoded(t *testing.T) {
 		}
 	}
 
-	// Statuses should also be hardcoded
+	// Statuses should also use the defaults
 	if len(loaded.StatusNames()) != 5 {
 		t.Errorf("len(StatusNames()) = %d, want 5", len(loaded.StatusNames()))
 	}
@@ -449,13 +449,10 @@ func TestTypeDescriptions(t *testing.T) {
 		}
 	})
 
-	t.Run("types in config file are ignored", func(t *testing.T) {
-		// Even if a config file has custom types, they should be ignored
-		// and hardcoded types should be used instead
+	t.Run("types in config file replace defaults", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		configPath := filepath.Join(tmpDir, ConfigFileName)
 
-		// Config with custom types (should be ignored)
 		configYAML := `beans:
   prefix: "test-"
   id_length: 4
@@ -466,7 +463,7 @@ statuses:
 types:
   - name: custom-type
     color: pink
-    description: "This should be ignored"
+    description: "A custom type"
 `
 		if err := os.WriteFile(configPath, []byte(configYAML), 0644); err != nil {
 			t.Fatalf("WriteFile error = %v", err)
@@ -477,14 +474,22 @@ types:
 			t.Fatalf("Load() error = %v", err)
 		}
 
-		// Custom type should not be valid
-		if loaded.IsValidType("custom-type") {
-			t.Error("IsValidType(\"custom-type\") = true, want false (custom types should be ignored)")
+		// Custom type should be valid
+		if !loaded.IsValidType("custom-type") {
+			t.Error("IsValidType(\"custom-type\") = false, want true")
+		}
+		if typ := loaded.GetType("custom-type"); typ == nil || typ.Description != "A custom type" {
+			t.Errorf("GetType(\"custom-type\") = %+v, want description \"A custom type\"", typ)
+		}
+
+		// Default types are replaced
+		if loaded.IsValidType("bug") {
+			t.Error("IsValidType(\"bug\") = true, want false")
 		}
 
-		// Hardcoded types should still work
-		if !loaded.IsValidType("bug") {
-			t.Error("IsValidType(\"bug\") = false, want true")
+		// Default type falls back to the first declared type
+		if loaded.GetDefaultType() != "custom-type" {
+			t.Errorf("GetDefaultType() = %q, want \"custom-type\"", loaded.GetDefaultType())
 		}
 	})
 }
@@ -513,20 +518,20 @@ func TestStatusDescriptions(t *testing.T) {
 		}
 	})
 
-	t.Run("statuses in config file are ignored", func(t *testing.T) {
-		// Even if a config file has custom statuses, they should be ignored
-		// and hardcoded statuses should be used instead
+	t.Run("statuses in config file replace defaults", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		configPath := filepath.Join(tmpDir, ConfigFileName)
 
-		// Config with custom statuses (should be ignored)
 		configYAML := `beans:
   prefix: "test-"
   id_length: 4
 statuses:
   - name: custom-status
     color: pink
-    description: "This should be ignored"
+    description: "A custom status"
+  - name: done
+    color: gray
+    archive: true
 `
 		if err := os.WriteFile(configPath, []byte(configYAML), 0644); err != nil {
 			t.Fatalf("WriteFile error = %v", err)
@@ -537,14 +542,22 @@ statuses:
 			t.Fatalf("Load() error = %v", err)
 		}
 
-		// Custom status should not be valid
-		if loaded.IsValidStatus("custom-status") {
-			t.Error("IsValidStatus(\"custom-status\") = true, want false (custom statuses should be ignored)")
+		// Custom statuses should be valid
+		if !loaded.IsValidStatus("custom-status") {
+			t.Error("IsValidStatus(\"custom-status\") = false, want true")
+		}
+		if !loaded.IsArchiveStatus("done") {
+			t.Error("IsArchiveStatus(\"done\") = false, want true")
+		}
+
+		// Default statuses are replaced
+		if loaded.IsValidStatus("todo") {
+			t.Error("IsValidStatus(\"todo\") = true, want false")
 		}
 
-		// Hardcoded statuses should still work
-		if !loaded.IsValidStatus("todo") {
-			t.Error("IsValidStatus(\"todo\") = false, want true")
+		// Without "todo", the default status falls back to the first declared status
+		if loaded.GetDefaultStatus() != "custom-status" {
+			t.Errorf("GetDefaultStatus() = %q, want \"custom-status\"", loaded.GetDefaultStatus())
 		}
 	})
 }
@@ -1079,6 +1092,26 @@ func TestIsAgentEnabled(t *testing.T) {
 	}
 }
 
+func TestAgentAssignee(t *testing.T) {
+	cfg := Default()
+	if !cfg.IsAgentAutoAssignEnabled() {
+		t.Error("expected auto-assign to be enabled by default")
+	}
+	if got := cfg.GetAgentAssignee(); got != DefaultAgentAssignee {
+		t.Errorf("GetAgentAssignee() = %q, want %q", got, DefaultAgentAssignee)
+	}
+
+	f := false
+	cfg.Agent.AutoAssign = &f
+	cfg.Agent.Assignee = "claude"
+	if cfg.IsAgentAutoAssignEnabled() {
+		t.Error("expected auto-assign to be disabled when set to false")
+	}
+	if got := cfg.GetAgentAssignee(); got != "claude" {
+		t.Errorf("GetAgentAssignee() = %q, want %q", got, "claude")
+	}
+}
+
 func TestLoadAgentEnabled(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -1617,3 +1650,204 @@ server:
 		}
 	})
 }
+
+func TestValidParentTypes(t *testing.T) {
+	t.Run("default rules", func(t *testing.T) {
+		cfg := Default()
+		tests := []struct {
+			typeName string
+			want     []string
+		}{
+			{"milestone", nil},
+			{"epic", []string{"milestone"}},
+			{"feature", []string{"milestone", "epic"}},
+			{"task", []string{"milestone", "epic", "feature"}},
+			{"bug", []string{"milestone", "epic", "feature"}},
+		}
+		for _, tt := range tests {
+			got := cfg.ValidParentTypes(tt.typeName)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") || (got == nil) != (tt.want == nil) {
+				t.Errorf("ValidParentTypes(%q) = %v, want %v", tt.typeName, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("configured rules", func(t *testing.T) {
+		cfg := Default()
+		cfg.Types = []TypeConfig{
+			{Name: "initiative", NoParent: true},
+			{Name: "spike", Parents: []string{"initiative"}},
+			{Name: "chore"},
+		}
+		if got := cfg.ValidParentTypes("initiative"); got != nil {
+			t.Errorf("ValidParentTypes(initiative) = %v, want nil", got)
+		}
+		if got := cfg.ValidParentTypes("spike"); len(got) != 1 || got[0] != "initiative" {
+			t.Errorf("ValidParentTypes(spike) = %v, want [initiative]", got)
+		}
+		if got := cfg.ValidParentTypes("chore"); len(got) != 3 {
+			t.Errorf("ValidParentTypes(chore) = %v, want all types", got)
+		}
+	})
+}
+
+func TestValidateDefinitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		wantErrs int
+	}{
+		{"defaults are valid", func(c *Config) {}, 0},
+		{"duplicate status", func(c *Config) {
+			c.Statuses = []StatusConfig{{Name: "todo"}, {Name: "todo"}}
+		}, 1},
+		{"status name with space", func(c *Config) {
+			c.Statuses = []StatusConfig{{Name: "todo"}, {Name: "in review"}}
+		}, 1},
+		{"cancelled status that isn't archived", func(c *Config) {
+			c.Statuses = []StatusConfig{{Name: "todo"}, {Name: "dropped", Cancelled: true}}
+		}, 1},
+		{"active archive status", func(c *Config) {
+			c.Statuses = []StatusConfig{{Name: "todo"}, {Name: "done", Archive: true, Active: true}}
+		}, 1},
+		{"unknown parent type", func(c *Config) {
+			c.Types = []TypeConfig{{Name: "task", Parents: []string{"epic"}}}
+			c.Beans.DefaultType = "task"
+		}, 1},
+		{"parents and no_parent", func(c *Config) {
+			c.Types = []TypeConfig{{Name: "task", Parents: []string{"task"}, NoParent: true}}
+			c.Beans.DefaultType = "task"
+		}, 1},
+		{"default status missing", func(c *Config) {
+			c.Statuses = []StatusConfig{{Name: "open"}}
+		}, 1},
+		{"default type missing", func(c *Config) {
+			c.Types = []TypeConfig{{Name: "chore"}}
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+			if errs := cfg.ValidateDefinitions(); len(errs) != tt.wantErrs {
+				t.Errorf("ValidateDefinitions() = %v, want %d error(s)", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestSaveIncludesDeclaredDefinitions(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := Default()
+	cfg.Statuses = []StatusConfig{{Name: "todo", Color: "green"}, {Name: "in-review", Color: "purple"}, {Name: "done", Color: "gray", Archive: true}}
+	cfg.Types = []TypeConfig{{Name: "task", Color: "blue"}, {Name: "spike", Color: "yellow", Parents: []string{"task"}}}
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := loaded.StatusList(); got != "todo, in-review, done" {
+		t.Errorf("StatusList() = %q, want \"todo, in-review, done\"", got)
+	}
+	if got := loaded.ValidParentTypes("spike"); len(got) != 1 || got[0] != "task" {
+		t.Errorf("ValidParentTypes(spike) = %v, want [task]", got)
+	}
+	if got := loaded.PriorityList(); got != "critical, high, normal, low, deferred" {
+		t.Errorf("PriorityList() = %q, want defaults", got)
+	}
+}
+
+func TestSaveFormatVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"adds key", "# keep me\nbeans:\n    prefix: app-\n"},
+		{"updates key", "beans:\n    format_version: 1\n    prefix: app-\n"},
+		{"adds beans section", "project:\n    name: App\n"},
+		{"empty file", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			configPath := filepath.Join(tmpDir, ConfigFileName)
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("WriteFile error = %v", err)
+			}
+			cfg, err := Load(configPath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if err := cfg.SaveFormatVersion(FormatVersion); err != nil {
+				t.Fatalf("SaveFormatVersion() error = %v", err)
+			}
+			if cfg.Beans.FormatVersion != FormatVersion {
+				t.Errorf("Beans.FormatVersion = %d, want %d", cfg.Beans.FormatVersion, FormatVersion)
+			}
+
+			reloaded, err := Load(configPath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if reloaded.Beans.FormatVersion != FormatVersion {
+				t.Errorf("reloaded FormatVersion = %d, want %d", reloaded.Beans.FormatVersion, FormatVersion)
+			}
+			data, _ := os.ReadFile(configPath)
+			if strings.Contains(tt.content, "# keep me") && !strings.Contains(string(data), "# keep me") {
+				t.Errorf("comment lost:\n%s", data)
+			}
+			if strings.Contains(tt.content, "prefix: app-") && reloaded.Beans.Prefix != "app-" {
+				t.Errorf("Prefix = %q, want app-", reloaded.Beans.Prefix)
+			}
+		})
+	}
+}
+
+func TestSaveFormatVersionKeepsFile(t *testing.T) {
+	v := strconv.Itoa(FormatVersion)
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			"two-space indent",
+			"# Project config\nbeans:\n  path: .beans\n  prefix: app-   # short\n\nproject:\n  name: App\n",
+			"# Project config\nbeans:\n  format_version: " + v + "\n  path: .beans\n  prefix: app-   # short\n\nproject:\n  name: App\n",
+		},
+		{
+			"existing key",
+			"beans:\n  prefix: app-\n  format_version: 1 # upgraded\n",
+			"beans:\n  prefix: app-\n  format_version: " + v + " # upgraded\n",
+		},
+		{
+			"missing section",
+			"project:\n  name: App",
+			"project:\n  name: App\nbeans:\n  format_version: " + v + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			configPath := filepath.Join(tmpDir, ConfigFileName)
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("WriteFile error = %v", err)
+			}
+			cfg, err := Load(configPath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if err := cfg.SaveFormatVersion(FormatVersion); err != nil {
+				t.Fatalf("SaveFormatVersion() error = %v", err)
+			}
+			data, _ := os.ReadFile(configPath)
+			if string(data) != tt.want {
+				t.Errorf("config after SaveFormatVersion:\n%s\nwant:\n%s", data, tt.want)
+			}
+		})
+	}
+}
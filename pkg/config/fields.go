@@ -0,0 +1,151 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldType identifies the value type of a user-defined custom field.
+type FieldType string
+
+const (
+	FieldTypeString FieldType = "string"
+	FieldTypeInt    FieldType = "int"
+	FieldTypeDate   FieldType = "date"
+	FieldTypeEnum   FieldType = "enum"
+)
+
+// DateLayout is the canonical layout for date-typed field values.
+const DateLayout = "2006-01-02"
+
+// fieldNamePattern matches valid custom field names: lowercase letters, numbers,
+// underscores, and hyphens, starting with a letter.
+var fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// reservedFieldNames are front matter keys owned by beans itself.
+// Custom fields cannot use these names.
+var reservedFieldNames = []string{
+	"title", "status", "type", "priority", "tags", "created_at", "updated_at",
+	"order", "parent", "blocking", "blocked_by", "relations", "start", "due", "estimate", "recur", "previous", "assignee", "assignees", "comments",
+}
+
+// FieldConfig defines a user-defined custom front matter field.
+type FieldConfig struct {
+	Name string `yaml:"name"`
+	// Type is one of string (default), int, date, or enum.
+	Type FieldType `yaml:"type,omitempty"`
+	// Values lists the allowed values for enum fields.
+	Values      []string `yaml:"values,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+}
+
+// GetType returns the field's type, defaulting to string.
+func (f *FieldConfig) GetType() FieldType {
+	if f.Type == "" {
+		return FieldTypeString
+	}
+	return f.Type
+}
+
+// Normalize validates a raw value against the field's type and returns its
+// canonical string form (e.g. "007" becomes "7" for int fields).
+func (f *FieldConfig) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch f.GetType() {
+	case FieldTypeInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid value %q for field %s: must be an integer", value, f.Name)
+		}
+		return strconv.Itoa(n), nil
+	case FieldTypeDate:
+		d, err := time.Parse(DateLayout, value)
+		if err != nil {
+			return "", fmt.Errorf("invalid value %q for field %s: must be a date (YYYY-MM-DD)", value, f.Name)
+		}
+		return d.Format(DateLayout), nil
+	case FieldTypeEnum:
+		if !slices.Contains(f.Values, value) {
+			return "", fmt.Errorf("invalid value %q for field %s: must be %s", value, f.Name, strings.Join(f.Values, ", "))
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// IsReservedFieldName returns true if name is a built-in front matter key.
+func IsReservedFieldName(name string) bool {
+	return slices.Contains(reservedFieldNames, name)
+}
+
+// GetField returns the FieldConfig for a given custom field name, or nil if not declared.
+func (c *Config) GetField(name string) *FieldConfig {
+	for i := range c.Fields {
+		if c.Fields[i].Name == name {
+			return &c.Fields[i]
+		}
+	}
+	return nil
+}
+
+// FieldNames returns the names of all declared custom fields.
+func (c *Config) FieldNames() []string {
+	names := make([]string, len(c.Fields))
+	for i, f := range c.Fields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// NormalizeFieldValue validates a value for the named custom field and returns
+// its canonical form. Returns an error if the field is not declared in config.
+func (c *Config) NormalizeFieldValue(name, value string) (string, error) {
+	f := c.GetField(name)
+	if f == nil {
+		if len(c.Fields) == 0 {
+			return "", fmt.Errorf("unknown field: %s (no custom fields are declared in %s)", name, ConfigFileName)
+		}
+		return "", fmt.Errorf("unknown field: %s (must be %s)", name, strings.Join(c.FieldNames(), ", "))
+	}
+	return f.Normalize(value)
+}
+
+// ValidateFields checks the custom field declarations and returns a list of
+// human-readable problems (empty if all declarations are valid).
+func (c *Config) ValidateFields() []string {
+	var errs []string
+	seen := make(map[string]bool)
+	for _, f := range c.Fields {
+		switch {
+		case !fieldNamePattern.MatchString(f.Name):
+			errs = append(errs, fmt.Sprintf("invalid field name %q: must be lowercase, start with a letter, and contain only letters, numbers, underscores, and hyphens", f.Name))
+			continue
+		case IsReservedFieldName(f.Name):
+			errs = append(errs, fmt.Sprintf("field name %q is reserved", f.Name))
+			continue
+		case seen[f.Name]:
+			errs = append(errs, fmt.Sprintf("field %q is declared more than once", f.Name))
+			continue
+		}
+		seen[f.Name] = true
+
+		switch f.GetType() {
+		case FieldTypeString, FieldTypeInt, FieldTypeDate:
+			if len(f.Values) > 0 {
+				errs = append(errs, fmt.Sprintf("field %q: values are only allowed for enum fields", f.Name))
+			}
+		case FieldTypeEnum:
+			if len(f.Values) == 0 {
+				errs = append(errs, fmt.Sprintf("field %q: enum fields must list allowed values", f.Name))
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("field %q has invalid type %q (must be string, int, date, or enum)", f.Name, f.Type))
+		}
+	}
+	return errs
+}
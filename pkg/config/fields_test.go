@@ -0,0 +1,115 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFieldNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   FieldConfig
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"string passthrough", FieldConfig{Name: "owner"}, "alice", "alice", false},
+		{"string trims space", FieldConfig{Name: "owner"}, "  alice ", "alice", false},
+		{"int valid", FieldConfig{Name: "points", Type: FieldTypeInt}, "5", "5", false},
+		{"int canonical", FieldConfig{Name: "points", Type: FieldTypeInt}, "007", "7", false},
+		{"int negative", FieldConfig{Name: "points", Type: FieldTypeInt}, "-3", "-3", false},
+		{"int invalid", FieldConfig{Name: "points", Type: FieldTypeInt}, "five", "", true},
+		{"date valid", FieldConfig{Name: "review_on", Type: FieldTypeDate}, "2024-03-01", "2024-03-01", false},
+		{"date invalid", FieldConfig{Name: "review_on", Type: FieldTypeDate}, "2024-13-01", "", true},
+		{"date wrong format", FieldConfig{Name: "review_on", Type: FieldTypeDate}, "03/01/2024", "", true},
+		{"enum valid", FieldConfig{Name: "area", Type: FieldTypeEnum, Values: []string{"ui", "api"}}, "api", "api", false},
+		{"enum invalid", FieldConfig{Name: "area", Type: FieldTypeEnum, Values: []string{"ui", "api"}}, "db", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Normalize(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeFieldValueUnknown(t *testing.T) {
+	cfg := Default()
+	if _, err := cfg.NormalizeFieldValue("points", "3"); err == nil {
+		t.Error("NormalizeFieldValue() expected error when no fields are declared")
+	}
+
+	cfg.Fields = []FieldConfig{{Name: "points", Type: FieldTypeInt}}
+	if _, err := cfg.NormalizeFieldValue("area", "ui"); err == nil || !strings.Contains(err.Error(), "points") {
+		t.Errorf("NormalizeFieldValue() error = %v, want error listing declared fields", err)
+	}
+	if got, err := cfg.NormalizeFieldValue("points", "03"); err != nil || got != "3" {
+		t.Errorf("NormalizeFieldValue(points, 03) = %q, %v; want \"3\", nil", got, err)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []FieldConfig
+		wantErrs int
+	}{
+		{"no fields", nil, 0},
+		{"valid fields", []FieldConfig{
+			{Name: "points", Type: FieldTypeInt},
+			{Name: "area", Type: FieldTypeEnum, Values: []string{"ui", "api"}},
+			{Name: "customer"},
+		}, 0},
+		{"invalid name", []FieldConfig{{Name: "Bad Name"}}, 1},
+		{"reserved name", []FieldConfig{{Name: "status"}}, 1},
+		{"duplicate", []FieldConfig{{Name: "area"}, {Name: "area"}}, 1},
+		{"enum without values", []FieldConfig{{Name: "area", Type: FieldTypeEnum}}, 1},
+		{"values on non-enum", []FieldConfig{{Name: "points", Type: FieldTypeInt, Values: []string{"1"}}}, 1},
+		{"invalid type", []FieldConfig{{Name: "points", Type: "float"}}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Fields: tt.fields}
+			errs := cfg.ValidateFields()
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateFields() = %v, want %d error(s)", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestFieldsLoadAndSave(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := Default()
+	cfg.Fields = []FieldConfig{
+		{Name: "points", Type: FieldTypeInt, Description: "Story points"},
+		{Name: "area", Type: FieldTypeEnum, Values: []string{"ui", "api"}},
+	}
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(loaded.Fields) != 2 {
+		t.Fatalf("Fields count = %d, want 2", len(loaded.Fields))
+	}
+	if f := loaded.GetField("points"); f == nil || f.GetType() != FieldTypeInt || f.Description != "Story points" {
+		t.Errorf("GetField(points) = %+v, want int field with description", f)
+	}
+	if f := loaded.GetField("area"); f == nil || len(f.Values) != 2 {
+		t.Errorf("GetField(area) = %+v, want enum field with 2 values", f)
+	}
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LinkTypeConfig defines a kind of link between beans, stored in the linking
+// bean's front matter under relations.<name>.
+type LinkTypeConfig struct {
+	Name string `yaml:"name"`
+	// Inverse is the name the link has when seen from the target bean
+	// (e.g. duplicated_by for duplicates). Not used for symmetric links.
+	Inverse string `yaml:"inverse,omitempty"`
+	// Symmetric links read the same in both directions (e.g. related).
+	Symmetric bool `yaml:"symmetric,omitempty"`
+	// Status is set on the linking bean when the link is added (e.g. a bean
+	// that duplicates another is scrapped).
+	Status      string `yaml:"status,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// DefaultLinkTypes defines the link types used when .beans.yml doesn't declare any.
+var DefaultLinkTypes = []LinkTypeConfig{
+	{Name: "related", Symmetric: true, Description: "Related to another bean"},
+	{Name: "duplicates", Inverse: "duplicated_by", Status: "scrapped", Description: "Duplicate of another bean"},
+	{Name: "supersedes", Inverse: "superseded_by", Description: "Replaces another bean"},
+}
+
+// reservedLinkNames are relationships built into beans that link types cannot use.
+var reservedLinkNames = []string{"parent", "children", "blocking", "blocked_by"}
+
+// GetLinkTypes returns the configured link types, or DefaultLinkTypes if none are declared.
+func (c *Config) GetLinkTypes() []LinkTypeConfig {
+	if len(c.LinkTypes) > 0 {
+		return c.LinkTypes
+	}
+	return DefaultLinkTypes
+}
+
+// GetLinkType returns the LinkTypeConfig for a given link type name, or nil if not found.
+func (c *Config) GetLinkType(name string) *LinkTypeConfig {
+	types := c.GetLinkTypes()
+	for i := range types {
+		if types[i].Name == name {
+			return &types[i]
+		}
+	}
+	return nil
+}
+
+// LinkTypeNames returns the names of all link types in configured order.
+func (c *Config) LinkTypeNames() []string {
+	types := c.GetLinkTypes()
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// LinkNames returns the names links can be queried by: each link type's name
+// followed by its inverse name, if any.
+func (c *Config) LinkNames() []string {
+	var names []string
+	for _, t := range c.GetLinkTypes() {
+		names = append(names, t.Name)
+		if t.Inverse != "" && !t.Symmetric {
+			names = append(names, t.Inverse)
+		}
+	}
+	return names
+}
+
+// ResolveLinkName looks up a link type by its name or its inverse name.
+// inverse is true if name is the link type's inverse name. Returns nil if no
+// link type has that name.
+func (c *Config) ResolveLinkName(name string) (lt *LinkTypeConfig, inverse bool) {
+	types := c.GetLinkTypes()
+	for i := range types {
+		switch {
+		case types[i].Name == name:
+			return &types[i], false
+		case types[i].Inverse == name && !types[i].Symmetric:
+			return &types[i], true
+		}
+	}
+	return nil, false
+}
+
+// LinkTypeList returns a comma-separated list of valid link type names.
+func (c *Config) LinkTypeList() string {
+	return strings.Join(c.LinkTypeNames(), ", ")
+}
+
+// ValidateLinkTypes checks the link type declarations and returns a list of
+// human-readable problems (empty if all declarations are valid).
+func (c *Config) ValidateLinkTypes() []string {
+	var errs []string
+	seen := make(map[string]bool)
+	checkName := func(name string) bool {
+		switch {
+		case !fieldNamePattern.MatchString(name):
+			errs = append(errs, fmt.Sprintf("invalid link type name %q: must be lowercase, start with a letter, and contain only letters, numbers, underscores, and hyphens", name))
+		case slices.Contains(reservedLinkNames, name):
+			errs = append(errs, fmt.Sprintf("link type name %q is reserved", name))
+		case seen[name]:
+			errs = append(errs, fmt.Sprintf("link type %q is declared more than once", name))
+		default:
+			seen[name] = true
+			return true
+		}
+		return false
+	}
+
+	for _, t := range c.GetLinkTypes() {
+		if !checkName(t.Name) {
+			continue
+		}
+		switch {
+		case t.Symmetric && t.Inverse != "":
+			errs = append(errs, fmt.Sprintf("link type %q cannot set both symmetric and inverse", t.Name))
+		case t.Inverse != "":
+			checkName(t.Inverse)
+		}
+		if t.Status != "" && !c.IsValidStatus(t.Status) {
+			errs = append(errs, fmt.Sprintf("link type %q sets unknown status %q", t.Name, t.Status))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLinkTypes(t *testing.T) {
+	cfg := Default()
+
+	if want := []string{"related", "duplicates", "supersedes"}; !slices.Equal(cfg.LinkTypeNames(), want) {
+		t.Errorf("LinkTypeNames() = %v, want %v", cfg.LinkTypeNames(), want)
+	}
+	if want := []string{"related", "duplicates", "duplicated_by", "supersedes", "superseded_by"}; !slices.Equal(cfg.LinkNames(), want) {
+		t.Errorf("LinkNames() = %v, want %v", cfg.LinkNames(), want)
+	}
+
+	tests := []struct {
+		name        string
+		wantType    string
+		wantInverse bool
+	}{
+		{"related", "related", false},
+		{"duplicates", "duplicates", false},
+		{"duplicated_by", "duplicates", true},
+		{"superseded_by", "supersedes", true},
+		{"blocking", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt, inverse := cfg.ResolveLinkName(tt.name)
+			got := ""
+			if lt != nil {
+				got = lt.Name
+			}
+			if got != tt.wantType || inverse != tt.wantInverse {
+				t.Errorf("ResolveLinkName(%q) = %q, %v, want %q, %v", tt.name, got, inverse, tt.wantType, tt.wantInverse)
+			}
+		})
+	}
+
+	if lt := cfg.GetLinkType("duplicates"); lt == nil || lt.Status != "scrapped" {
+		t.Errorf("GetLinkType(duplicates) = %+v, want status scrapped", lt)
+	}
+}
+
+func TestValidateLinkTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		linkType LinkTypeConfig
+		wantErr  string
+	}{
+		{"valid", LinkTypeConfig{Name: "implements", Inverse: "implemented_by"}, ""},
+		{"invalid name", LinkTypeConfig{Name: "Implements"}, "invalid link type name"},
+		{"reserved name", LinkTypeConfig{Name: "blocking"}, "is reserved"},
+		{"reserved inverse", LinkTypeConfig{Name: "contains", Inverse: "parent"}, "is reserved"},
+		{"inverse collides", LinkTypeConfig{Name: "copies", Inverse: "duplicated_by"}, "declared more than once"},
+		{"symmetric with inverse", LinkTypeConfig{Name: "pairs", Symmetric: true, Inverse: "paired"}, "cannot set both"},
+		{"unknown status", LinkTypeConfig{Name: "obsoletes", Status: "obsolete"}, `unknown status "obsolete"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.LinkTypes = append(slices.Clone(DefaultLinkTypes), tt.linkType)
+			errs := cfg.ValidateLinkTypes()
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("ValidateLinkTypes() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0], tt.wantErr) {
+				t.Errorf("ValidateLinkTypes() = %v, want error containing %q", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLinkTypesLoadAndSave(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := Default()
+	cfg.LinkTypes = []LinkTypeConfig{
+		{Name: "implements", Inverse: "implemented_by", Description: "Implements a spec"},
+		{Name: "pairs", Symmetric: true},
+	}
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if want := []string{"implements", "pairs"}; !slices.Equal(loaded.LinkTypeNames(), want) {
+		t.Errorf("LinkTypeNames() = %v, want %v", loaded.LinkTypeNames(), want)
+	}
+	if lt := loaded.GetLinkType("implements"); lt == nil || lt.Inverse != "implemented_by" || lt.Description != "Implements a spec" {
+		t.Errorf("GetLinkType(implements) = %+v", lt)
+	}
+	if lt := loaded.GetLinkType("pairs"); lt == nil || !lt.Symmetric {
+		t.Errorf("GetLinkType(pairs) = %+v, want symmetric", lt)
+	}
+	if loaded.GetLinkType("related") != nil {
+		t.Error("declared link types should replace the defaults")
+	}
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// View output formats for `beans list --view`.
+const (
+	ViewFormatTree = "tree"
+	ViewFormatJSON = "json"
+	ViewFormatIDs  = "ids"
+)
+
+// ViewConfig defines a named, saved bean query that can be shared by the CLI
+// (`beans list --view <name>`), the GraphQL API, and the TUI.
+type ViewConfig struct {
+	Name        string     `yaml:"name"`
+	Description string     `yaml:"description,omitempty"`
+	Filter      ViewFilter `yaml:"filter,omitempty"`
+	// Sort is one of created, updated, due, status, priority, type, order, or
+	// id, optionally followed by :asc or :desc. Empty uses the default order
+	// (status, priority, type, title).
+	Sort string `yaml:"sort,omitempty"`
+	// Format is the default output format for `beans list`: tree (default), json, or ids.
+	Format string `yaml:"format,omitempty"`
+}
+
+// ViewFilter mirrors the filter flags of `beans list`.
+type ViewFilter struct {
+	Search          string   `yaml:"search,omitempty"`
+	Status          []string `yaml:"status,omitempty"`
+	ExcludeStatus   []string `yaml:"exclude_status,omitempty"`
+	Type            []string `yaml:"type,omitempty"`
+	ExcludeType     []string `yaml:"exclude_type,omitempty"`
+	Priority        []string `yaml:"priority,omitempty"`
+	ExcludePriority []string `yaml:"exclude_priority,omitempty"`
+	Tags            []string `yaml:"tags,omitempty"`
+	ExcludeTags     []string `yaml:"exclude_tags,omitempty"`
+	Assignee        []string `yaml:"assignee,omitempty"`
+	// Me includes beans assigned to the current user ($BEANS_USER or git user.email).
+	Me         bool `yaml:"me,omitempty"`
+	Unassigned bool `yaml:"unassigned,omitempty"`
+	// DueBefore and DueAfter accept the same formats as --due-before/--due-after,
+	// including relative dates like "today" or "+7d".
+	DueBefore string `yaml:"due_before,omitempty"`
+	DueAfter  string `yaml:"due_after,omitempty"`
+	Overdue   bool   `yaml:"overdue,omitempty"`
+	// Fields filters by custom field, as key=value or key (field is set).
+	Fields      []string `yaml:"fields,omitempty"`
+	HasParent   bool     `yaml:"has_parent,omitempty"`
+	NoParent    bool     `yaml:"no_parent,omitempty"`
+	Parent      string   `yaml:"parent,omitempty"`
+	HasBlocking bool     `yaml:"has_blocking,omitempty"`
+	NoBlocking  bool     `yaml:"no_blocking,omitempty"`
+	// HasLink and NoLink take link types or inverse names (e.g. duplicated_by).
+	HasLink   []string `yaml:"has_link,omitempty"`
+	NoLink    []string `yaml:"no_link,omitempty"`
+	IsBlocked bool     `yaml:"is_blocked,omitempty"`
+	// Ready includes only beans available to start, like `beans list --ready`.
+	Ready bool `yaml:"ready,omitempty"`
+}
+
+// GetFormat returns the view's output format, defaulting to tree.
+func (v *ViewConfig) GetFormat() string {
+	if v.Format == "" {
+		return ViewFormatTree
+	}
+	return v.Format
+}
+
+// GetView returns the ViewConfig with the given name, or nil if not declared.
+func (c *Config) GetView(name string) *ViewConfig {
+	for i := range c.Views {
+		if c.Views[i].Name == name {
+			return &c.Views[i]
+		}
+	}
+	return nil
+}
+
+// ViewNames returns the names of all declared views.
+func (c *Config) ViewNames() []string {
+	names := make([]string, len(c.Views))
+	for i, v := range c.Views {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// ViewList returns a comma-separated list of declared view names.
+func (c *Config) ViewList() string {
+	return strings.Join(c.ViewNames(), ", ")
+}
+
+// ValidateViews checks the view declarations and returns a list of
+// human-readable problems (empty if all declarations are valid). Sort orders
+// and dates are validated when a view is resolved.
+func (c *Config) ValidateViews() []string {
+	var errs []string
+	seen := make(map[string]bool)
+	for _, v := range c.Views {
+		switch {
+		case !fieldNamePattern.MatchString(v.Name):
+			errs = append(errs, fmt.Sprintf("invalid view name %q: must be lowercase, start with a letter, and contain only letters, numbers, underscores, and hyphens", v.Name))
+			continue
+		case seen[v.Name]:
+			errs = append(errs, fmt.Sprintf("view %q is declared more than once", v.Name))
+			continue
+		}
+		seen[v.Name] = true
+
+		errs = append(errs, c.validateFilter(fmt.Sprintf("view %q", v.Name), v.Filter)...)
+		if !slices.Contains([]string{ViewFormatTree, ViewFormatJSON, ViewFormatIDs}, v.GetFormat()) {
+			errs = append(errs, fmt.Sprintf("view %q has invalid format %q (must be tree, json, or ids)", v.Name, v.Format))
+		}
+	}
+	return errs
+}
+
+// validateFilter checks a filter's statuses, types, priorities, and link
+// types against the configuration, and flags contradictory options. owner
+// prefixes each problem (e.g. `view "triage"`).
+func (c *Config) validateFilter(owner string, f ViewFilter) []string {
+	var errs []string
+	check := func(kind string, values []string, valid func(string) bool) {
+		for _, value := range values {
+			if !valid(value) {
+				errs = append(errs, fmt.Sprintf("%s: unknown %s %q", owner, kind, value))
+			}
+		}
+	}
+	check("status", slices.Concat(f.Status, f.ExcludeStatus), c.IsValidStatus)
+	check("type", slices.Concat(f.Type, f.ExcludeType), c.IsValidType)
+	check("priority", slices.Concat(f.Priority, f.ExcludePriority), c.IsValidPriority)
+	check("link type", slices.Concat(f.HasLink, f.NoLink), func(name string) bool {
+		lt, _ := c.ResolveLinkName(name)
+		return lt != nil
+	})
+
+	if f.Ready && f.IsBlocked {
+		errs = append(errs, fmt.Sprintf("%s: ready and is_blocked are mutually exclusive", owner))
+	}
+	if f.Unassigned && (f.Me || len(f.Assignee) > 0) {
+		errs = append(errs, fmt.Sprintf("%s: unassigned cannot be combined with assignee or me", owner))
+	}
+	return errs
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateViews(t *testing.T) {
+	tests := []struct {
+		name    string
+		view    ViewConfig
+		wantErr string
+	}{
+		{"valid", ViewConfig{Name: "triage", Filter: ViewFilter{Status: []string{"todo"}, Ready: true}, Format: ViewFormatJSON}, ""},
+		{"invalid name", ViewConfig{Name: "My View"}, "invalid view name"},
+		{"unknown status", ViewConfig{Name: "v", Filter: ViewFilter{ExcludeStatus: []string{"nope"}}}, `unknown status "nope"`},
+		{"unknown type", ViewConfig{Name: "v", Filter: ViewFilter{Type: []string{"nope"}}}, `unknown type "nope"`},
+		{"unknown priority", ViewConfig{Name: "v", Filter: ViewFilter{Priority: []string{"nope"}}}, `unknown priority "nope"`},
+		{"unknown link type", ViewConfig{Name: "v", Filter: ViewFilter{HasLink: []string{"duplicated_by", "nope"}}}, `unknown link type "nope"`},
+		{"ready and blocked", ViewConfig{Name: "v", Filter: ViewFilter{Ready: true, IsBlocked: true}}, "mutually exclusive"},
+		{"unassigned and me", ViewConfig{Name: "v", Filter: ViewFilter{Unassigned: true, Me: true}}, "unassigned cannot be combined"},
+		{"invalid format", ViewConfig{Name: "v", Format: "table"}, "invalid format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Views = []ViewConfig{tt.view}
+			errs := cfg.ValidateViews()
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("ValidateViews() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0], tt.wantErr) {
+				t.Errorf("ValidateViews() = %v, want error containing %q", errs, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("duplicate names", func(t *testing.T) {
+		cfg := Default()
+		cfg.Views = []ViewConfig{{Name: "triage"}, {Name: "triage"}}
+		if errs := cfg.ValidateViews(); len(errs) != 1 || !strings.Contains(errs[0], "more than once") {
+			t.Errorf("ValidateViews() = %v, want duplicate error", errs)
+		}
+	})
+}
+
+func TestViewsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Default()
+	cfg.Views = []ViewConfig{{
+		Name:        "triage",
+		Description: "Daily triage queue",
+		Filter:      ViewFilter{Status: []string{"todo"}, ExcludeTags: []string{"later"}, Ready: true},
+		Sort:        "priority",
+	}}
+	path := filepath.Join(dir, ConfigFileName)
+	cfg.SetConfigDir(dir)
+	if err := cfg.Save(dir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, _ := os.ReadFile(path)
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	v := loaded.GetView("triage")
+	if v == nil {
+		t.Fatalf("GetView(triage) = nil, config:\n%s", data)
+	}
+	if v.Sort != "priority" || !v.Filter.Ready || v.GetFormat() != ViewFormatTree ||
+		len(v.Filter.ExcludeTags) != 1 || v.Filter.ExcludeTags[0] != "later" {
+		t.Errorf("loaded view = %+v", v)
+	}
+	if loaded.GetView("missing") != nil {
+		t.Error("GetView(missing) should be nil")
+	}
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+)
+
+// WebhookEvents lists the bean events a webhook can subscribe to.
+var WebhookEvents = []string{"created", "updated", "deleted"}
+
+// DefaultWebhookMaxAttempts is how often a delivery is tried when a webhook
+// doesn't set max_attempts.
+const DefaultWebhookMaxAttempts = 5
+
+// WebhookConfig defines an HTTP endpoint that `beans serve` notifies when
+// beans change.
+type WebhookConfig struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Events lists the bean events to deliver (created, updated, deleted).
+	// Empty delivers all events.
+	Events []string `yaml:"events,omitempty"`
+	// Filter restricts deliveries to beans matching it, using the same keys
+	// as view filters. Deleted beans are matched as they were before deletion.
+	Filter ViewFilter `yaml:"filter,omitempty"`
+	// OnEnter delivers updated events only when the update makes the bean
+	// match the filter (e.g. its status became completed), rather than for
+	// every update of a matching bean.
+	OnEnter bool `yaml:"on_enter,omitempty"`
+	// Secret signs each payload with HMAC-SHA256. $VAR and ${VAR} are
+	// expanded from the environment, so the secret needn't be committed.
+	Secret string `yaml:"secret,omitempty"`
+	// MaxAttempts is how often a delivery is tried before it is marked as
+	// failed (default 5).
+	MaxAttempts int `yaml:"max_attempts,omitempty"`
+}
+
+// WantsEvent reports whether the webhook subscribes to the named event.
+func (w *WebhookConfig) WantsEvent(event string) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, event)
+}
+
+// GetSecret returns the signing secret with environment variables expanded.
+func (w *WebhookConfig) GetSecret() string {
+	return os.ExpandEnv(w.Secret)
+}
+
+// GetMaxAttempts returns the number of delivery attempts, defaulting to
+// DefaultWebhookMaxAttempts.
+func (w *WebhookConfig) GetMaxAttempts() int {
+	if w.MaxAttempts <= 0 {
+		return DefaultWebhookMaxAttempts
+	}
+	return w.MaxAttempts
+}
+
+// ValidateWebhooks checks the webhook declarations and returns a list of
+// human-readable problems (empty if all declarations are valid). Dates in
+// filters are validated when a webhook's filter is resolved.
+func (c *Config) ValidateWebhooks() []string {
+	var errs []string
+	seen := make(map[string]bool)
+	for _, w := range c.Webhooks {
+		switch {
+		case !fieldNamePattern.MatchString(w.Name):
+			errs = append(errs, fmt.Sprintf("invalid webhook name %q: must be lowercase, start with a letter, and contain only letters, numbers, underscores, and hyphens", w.Name))
+			continue
+		case seen[w.Name]:
+			errs = append(errs, fmt.Sprintf("webhook %q is declared more than once", w.Name))
+			continue
+		}
+		seen[w.Name] = true
+
+		if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("webhook %q has invalid url %q (must be an http or https URL)", w.Name, w.URL))
+		}
+		for _, event := range w.Events {
+			if !slices.Contains(WebhookEvents, event) {
+				errs = append(errs, fmt.Sprintf("webhook %q: unknown event %q (must be created, updated, or deleted)", w.Name, event))
+			}
+		}
+		if w.MaxAttempts < 0 {
+			errs = append(errs, fmt.Sprintf("webhook %q: max_attempts must not be negative", w.Name))
+		}
+		errs = append(errs, c.validateFilter(fmt.Sprintf("webhook %q", w.Name), w.Filter)...)
+	}
+	return errs
+}
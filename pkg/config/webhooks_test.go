@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateWebhooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook WebhookConfig
+		wantErr string
+	}{
+		{"valid", WebhookConfig{Name: "ci", URL: "https://ci.example.com/hook", Events: []string{"updated"}, Filter: ViewFilter{Status: []string{"completed"}}}, ""},
+		{"invalid name", WebhookConfig{Name: "My Hook", URL: "https://example.com"}, "invalid webhook name"},
+		{"missing url", WebhookConfig{Name: "ci"}, "invalid url"},
+		{"non-http url", WebhookConfig{Name: "ci", URL: "ftp://example.com"}, "invalid url"},
+		{"unknown event", WebhookConfig{Name: "ci", URL: "http://localhost:9000", Events: []string{"archived"}}, `unknown event "archived"`},
+		{"negative attempts", WebhookConfig{Name: "ci", URL: "http://localhost:9000", MaxAttempts: -1}, "max_attempts"},
+		{"unknown status", WebhookConfig{Name: "ci", URL: "http://localhost:9000", Filter: ViewFilter{Status: []string{"nope"}}}, `webhook "ci": unknown status "nope"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Webhooks = []WebhookConfig{tt.webhook}
+			errs := cfg.ValidateWebhooks()
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("ValidateWebhooks() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0], tt.wantErr) {
+				t.Errorf("ValidateWebhooks() = %v, want error containing %q", errs, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("duplicate names", func(t *testing.T) {
+		cfg := Default()
+		cfg.Webhooks = []WebhookConfig{{Name: "ci", URL: "https://example.com"}, {Name: "ci", URL: "https://example.com"}}
+		if errs := cfg.ValidateWebhooks(); len(errs) != 1 || !strings.Contains(errs[0], "more than once") {
+			t.Errorf("ValidateWebhooks() = %v, want duplicate error", errs)
+		}
+	})
+}
+
+func TestWebhookConfig(t *testing.T) {
+	t.Setenv("BEANS_TEST_WEBHOOK_SECRET", "s3cret")
+	w := WebhookConfig{Events: []string{"created"}, Secret: "${BEANS_TEST_WEBHOOK_SECRET}"}
+	if !w.WantsEvent("created") || w.WantsEvent("deleted") {
+		t.Errorf("WantsEvent() with events %v is wrong", w.Events)
+	}
+	if got := w.GetSecret(); got != "s3cret" {
+		t.Errorf("GetSecret() = %q, want expanded secret", got)
+	}
+	if got := w.GetMaxAttempts(); got != DefaultWebhookMaxAttempts {
+		t.Errorf("GetMaxAttempts() = %d, want default", got)
+	}
+	if all := (WebhookConfig{}); !all.WantsEvent("deleted") {
+		t.Error("webhook without events should want all events")
+	}
+}
+
+func TestWebhooksRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Default()
+	cfg.Webhooks = []WebhookConfig{{
+		Name:    "chat",
+		URL:     "https://chat.example.com/hook",
+		Events:  []string{"updated"},
+		Filter:  ViewFilter{Status: []string{"completed"}},
+		OnEnter: true,
+		Secret:  "$CHAT_SECRET",
+	}}
+	path := filepath.Join(dir, ConfigFileName)
+	cfg.SetConfigDir(dir)
+	if err := cfg.Save(dir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, _ := os.ReadFile(path)
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Webhooks) != 1 {
+		t.Fatalf("loaded %d webhooks, config:\n%s", len(loaded.Webhooks), data)
+	}
+	w := loaded.Webhooks[0]
+	if w.URL != "https://chat.example.com/hook" || !w.OnEnter || w.Secret != "$CHAT_SECRET" ||
+		len(w.Filter.Status) != 1 || w.Filter.Status[0] != "completed" {
+		t.Errorf("loaded webhook = %+v", w)
+	}
+}
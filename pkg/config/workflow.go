@@ -0,0 +1,148 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// WorkflowAnyType is the transitions key that applies to all types without
+// their own entry.
+const WorkflowAnyType = "*"
+
+// GuardRule identifies a workflow guard condition.
+type GuardRule string
+
+const (
+	// GuardChildrenResolved prevents entering a status while any child bean
+	// still has a non-archive status.
+	GuardChildrenResolved GuardRule = "children_resolved"
+	// GuardNotBlocked prevents entering a status while the bean has direct
+	// active blockers.
+	GuardNotBlocked GuardRule = "not_blocked"
+)
+
+// WorkflowConfig declares allowed status transitions and guard rules.
+// An empty workflow allows any status change.
+type WorkflowConfig struct {
+	// Transitions maps a type name (or "*" for all other types) to a map of
+	// from-status to the list of statuses it may change to. Statuses without
+	// an entry may change to any status.
+	Transitions map[string]map[string][]string `yaml:"transitions,omitempty"`
+	// Guards are conditions checked when a bean enters one of the guarded statuses.
+	Guards []GuardConfig `yaml:"guards,omitempty"`
+}
+
+// GuardConfig applies a guard rule to changes into the given statuses.
+type GuardConfig struct {
+	Rule     GuardRule `yaml:"rule"`
+	Statuses []string  `yaml:"statuses"`
+	// Types limits the guard to beans of these types. Empty means all types.
+	Types []string `yaml:"types,omitempty"`
+}
+
+// IsEmpty returns true if no transitions or guards are declared.
+func (w *WorkflowConfig) IsEmpty() bool {
+	return len(w.Transitions) == 0 && len(w.Guards) == 0
+}
+
+// AllowedTransitions returns the statuses a bean of the given type may change
+// to from the given status. The second return value is false if the workflow
+// doesn't restrict this transition (any status is allowed).
+func (c *Config) AllowedTransitions(typeName, from string) ([]string, bool) {
+	transitions, ok := c.Workflow.Transitions[typeName]
+	if !ok {
+		transitions, ok = c.Workflow.Transitions[WorkflowAnyType]
+	}
+	if !ok {
+		return nil, false
+	}
+	targets, ok := transitions[from]
+	if !ok {
+		return nil, false
+	}
+	return targets, true
+}
+
+// IsTransitionAllowed returns true if a bean of the given type may change
+// from one status to another. Keeping the same status is always allowed.
+func (c *Config) IsTransitionAllowed(typeName, from, to string) bool {
+	if from == to {
+		return true
+	}
+	targets, restricted := c.AllowedTransitions(typeName, from)
+	return !restricted || slices.Contains(targets, to)
+}
+
+// GuardsFor returns the guards that apply when a bean of the given type
+// enters the given status.
+func (c *Config) GuardsFor(typeName, to string) []GuardConfig {
+	var guards []GuardConfig
+	for _, g := range c.Workflow.Guards {
+		if !slices.Contains(g.Statuses, to) {
+			continue
+		}
+		if len(g.Types) > 0 && !slices.Contains(g.Types, typeName) {
+			continue
+		}
+		guards = append(guards, g)
+	}
+	return guards
+}
+
+// ValidateWorkflow checks the workflow declarations against the configured
+// statuses and types and returns a list of human-readable problems.
+func (c *Config) ValidateWorkflow() []string {
+	var errs []string
+
+	typeNames := make([]string, 0, len(c.Workflow.Transitions))
+	for typeName := range c.Workflow.Transitions {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		if typeName != WorkflowAnyType && !c.IsValidType(typeName) {
+			errs = append(errs, fmt.Sprintf("workflow.transitions: unknown type %q", typeName))
+		}
+		transitions := c.Workflow.Transitions[typeName]
+		froms := make([]string, 0, len(transitions))
+		for from := range transitions {
+			froms = append(froms, from)
+		}
+		sort.Strings(froms)
+		for _, from := range froms {
+			if !c.IsValidStatus(from) {
+				errs = append(errs, fmt.Sprintf("workflow.transitions.%s: unknown status %q", typeName, from))
+			}
+			for _, to := range transitions[from] {
+				if !c.IsValidStatus(to) {
+					errs = append(errs, fmt.Sprintf("workflow.transitions.%s.%s: unknown status %q", typeName, from, to))
+				}
+			}
+		}
+	}
+
+	for i, g := range c.Workflow.Guards {
+		switch g.Rule {
+		case GuardChildrenResolved, GuardNotBlocked:
+		default:
+			errs = append(errs, fmt.Sprintf("workflow.guards[%d]: invalid rule %q (must be %s or %s)", i, g.Rule, GuardChildrenResolved, GuardNotBlocked))
+		}
+		if len(g.Statuses) == 0 {
+			errs = append(errs, fmt.Sprintf("workflow.guards[%d]: statuses must not be empty", i))
+		}
+		for _, s := range g.Statuses {
+			if !c.IsValidStatus(s) {
+				errs = append(errs, fmt.Sprintf("workflow.guards[%d]: unknown status %q", i, s))
+			}
+		}
+		for _, t := range g.Types {
+			if !c.IsValidType(t) {
+				errs = append(errs, fmt.Sprintf("workflow.guards[%d]: unknown type %q", i, t))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func workflowTestConfig() *Config {
+	cfg := Default()
+	cfg.Workflow = WorkflowConfig{
+		Transitions: map[string]map[string][]string{
+			WorkflowAnyType: {
+				"draft": {"todo", "scrapped"},
+				"todo":  {"in-progress", "scrapped"},
+			},
+			"bug": {
+				"todo": {"in-progress"},
+			},
+		},
+		Guards: []GuardConfig{
+			{Rule: GuardChildrenResolved, Statuses: []string{"completed"}},
+			{Rule: GuardNotBlocked, Statuses: []string{"in-progress"}, Types: []string{"task"}},
+		},
+	}
+	return cfg
+}
+
+func TestIsTransitionAllowed(t *testing.T) {
+	cfg := workflowTestConfig()
+
+	tests := []struct {
+		name     string
+		typeName string
+		from     string
+		to       string
+		want     bool
+	}{
+		{"listed transition", "task", "draft", "todo", true},
+		{"unlisted transition", "task", "draft", "completed", false},
+		{"same status", "task", "draft", "draft", true},
+		{"unrestricted from-status", "task", "in-progress", "draft", true},
+		{"type entry overrides wildcard", "bug", "todo", "scrapped", false},
+		{"type entry allows", "bug", "todo", "in-progress", true},
+		{"type entry without from-status", "bug", "draft", "completed", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.IsTransitionAllowed(tt.typeName, tt.from, tt.to); got != tt.want {
+				t.Errorf("IsTransitionAllowed(%q, %q, %q) = %v, want %v", tt.typeName, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("empty workflow allows everything", func(t *testing.T) {
+		if !Default().IsTransitionAllowed("task", "completed", "draft") {
+			t.Error("IsTransitionAllowed() = false, want true without workflow")
+		}
+	})
+}
+
+func TestAllowedTransitions(t *testing.T) {
+	cfg := workflowTestConfig()
+
+	got, restricted := cfg.AllowedTransitions("task", "todo")
+	if !restricted || !slices.Equal(got, []string{"in-progress", "scrapped"}) {
+		t.Errorf("AllowedTransitions(task, todo) = %v, %v; want [in-progress scrapped], true", got, restricted)
+	}
+	if _, restricted := cfg.AllowedTransitions("task", "completed"); restricted {
+		t.Error("AllowedTransitions(task, completed) restricted = true, want false")
+	}
+}
+
+func TestGuardsFor(t *testing.T) {
+	cfg := workflowTestConfig()
+
+	if got := cfg.GuardsFor("epic", "completed"); len(got) != 1 || got[0].Rule != GuardChildrenResolved {
+		t.Errorf("GuardsFor(epic, completed) = %+v, want children_resolved guard", got)
+	}
+	if got := cfg.GuardsFor("task", "in-progress"); len(got) != 1 || got[0].Rule != GuardNotBlocked {
+		t.Errorf("GuardsFor(task, in-progress) = %+v, want not_blocked guard", got)
+	}
+	if got := cfg.GuardsFor("bug", "in-progress"); len(got) != 0 {
+		t.Errorf("GuardsFor(bug, in-progress) = %+v, want none (guard limited to tasks)", got)
+	}
+}
+
+func TestValidateWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow WorkflowConfig
+		wantErrs int
+	}{
+		{"empty", WorkflowConfig{}, 0},
+		{"valid", workflowTestConfig().Workflow, 0},
+		{"unknown type", WorkflowConfig{Transitions: map[string]map[string][]string{
+			"story": {"todo": {"completed"}},
+		}}, 1},
+		{"unknown statuses", WorkflowConfig{Transitions: map[string]map[string][]string{
+			WorkflowAnyType: {"open": {"closed"}},
+		}}, 2},
+		{"invalid guard rule", WorkflowConfig{Guards: []GuardConfig{
+			{Rule: "approved", Statuses: []string{"completed"}},
+		}}, 1},
+		{"guard without statuses", WorkflowConfig{Guards: []GuardConfig{
+			{Rule: GuardNotBlocked},
+		}}, 1},
+		{"guard with unknown status and type", WorkflowConfig{Guards: []GuardConfig{
+			{Rule: GuardNotBlocked, Statuses: []string{"done"}, Types: []string{"story"}},
+		}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Workflow = tt.workflow
+			errs := cfg.ValidateWorkflow()
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateWorkflow() = %v, want %d error(s)", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestWorkflowLoadAndSave(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := workflowTestConfig()
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if loaded.IsTransitionAllowed("task", "draft", "completed") {
+		t.Error("loaded config allows draft -> completed, want restricted")
+	}
+	if !loaded.IsTransitionAllowed("bug", "todo", "in-progress") {
+		t.Error("loaded config rejects bug todo -> in-progress, want allowed")
+	}
+	if len(loaded.Workflow.Guards) != 2 || !slices.Equal(loaded.Workflow.Guards[1].Types, []string{"task"}) {
+		t.Errorf("loaded guards = %+v, want 2 guards with types preserved", loaded.Workflow.Guards)
+	}
+}